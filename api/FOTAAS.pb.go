@@ -101,7 +101,7 @@ func (x Track) String() string {
 	return proto.EnumName(Track_name, int32(x))
 }
 func (Track) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{0}
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{0}
 }
 
 type GranPrix int32
@@ -181,7 +181,7 @@ func (x GranPrix) String() string {
 	return proto.EnumName(GranPrix_name, int32(x))
 }
 func (GranPrix) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{1}
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{1}
 }
 
 type Constructor int32
@@ -225,7 +225,7 @@ func (x Constructor) String() string {
 	return proto.EnumName(Constructor_name, int32(x))
 }
 func (Constructor) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{2}
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{2}
 }
 
 type TelemetryDatumUnit int32
@@ -278,7 +278,7 @@ func (x TelemetryDatumUnit) String() string {
 	return proto.EnumName(TelemetryDatumUnit_name, int32(x))
 }
 func (TelemetryDatumUnit) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{3}
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{3}
 }
 
 type TelemetryDatumDescription int32
@@ -370,16 +370,61 @@ func (x TelemetryDatumDescription) String() string {
 	return proto.EnumName(TelemetryDatumDescription_name, int32(x))
 }
 func (TelemetryDatumDescription) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{4}
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{4}
+}
+
+type ChannelCategory int32
+
+const (
+	ChannelCategory_UNCATEGORIZED   ChannelCategory = 0
+	ChannelCategory_CHASSIS         ChannelCategory = 1
+	ChannelCategory_POWER_UNIT      ChannelCategory = 2
+	ChannelCategory_ENERGY_RECOVERY ChannelCategory = 3
+	ChannelCategory_FUEL            ChannelCategory = 4
+	ChannelCategory_BRAKES          ChannelCategory = 5
+	ChannelCategory_TIRES           ChannelCategory = 6
+)
+
+var ChannelCategory_name = map[int32]string{
+	0: "UNCATEGORIZED",
+	1: "CHASSIS",
+	2: "POWER_UNIT",
+	3: "ENERGY_RECOVERY",
+	4: "FUEL",
+	5: "BRAKES",
+	6: "TIRES",
+}
+var ChannelCategory_value = map[string]int32{
+	"UNCATEGORIZED":   0,
+	"CHASSIS":         1,
+	"POWER_UNIT":      2,
+	"ENERGY_RECOVERY": 3,
+	"FUEL":            4,
+	"BRAKES":          5,
+	"TIRES":           6,
+}
+
+func (x ChannelCategory) String() string {
+	return proto.EnumName(ChannelCategory_name, int32(x))
+}
+func (ChannelCategory) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{5}
 }
 
 type ResponseCode int32
 
 const (
-	ResponseCode_OK    ResponseCode = 0
-	ResponseCode_ERROR ResponseCode = 1
-	ResponseCode_INFO  ResponseCode = 2
-	ResponseCode_WARN  ResponseCode = 3
+	ResponseCode_OK        ResponseCode = 0
+	ResponseCode_ERROR     ResponseCode = 1
+	ResponseCode_INFO      ResponseCode = 2
+	ResponseCode_WARN      ResponseCode = 3
+	ResponseCode_DUPLICATE ResponseCode = 4
+	ResponseCode_CONFLICT  ResponseCode = 5
+	ResponseCode_NOT_FOUND ResponseCode = 6
+	// The telemetry ingest queue is full, the telemetry data was not accepted and should be retried.
+	ResponseCode_BACKPRESSURE ResponseCode = 7
+	// The telemetry data was queued but has not yet been written.
+	ResponseCode_ACCEPTED ResponseCode = 8
 )
 
 var ResponseCode_name = map[int32]string{
@@ -387,19 +432,29 @@ var ResponseCode_name = map[int32]string{
 	1: "ERROR",
 	2: "INFO",
 	3: "WARN",
+	4: "DUPLICATE",
+	5: "CONFLICT",
+	6: "NOT_FOUND",
+	7: "BACKPRESSURE",
+	8: "ACCEPTED",
 }
 var ResponseCode_value = map[string]int32{
-	"OK":    0,
-	"ERROR": 1,
-	"INFO":  2,
-	"WARN":  3,
+	"OK":           0,
+	"ERROR":        1,
+	"INFO":         2,
+	"WARN":         3,
+	"DUPLICATE":    4,
+	"CONFLICT":     5,
+	"NOT_FOUND":    6,
+	"BACKPRESSURE": 7,
+	"ACCEPTED":     8,
 }
 
 func (x ResponseCode) String() string {
 	return proto.EnumName(ResponseCode_name, int32(x))
 }
 func (ResponseCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{5}
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{6}
 }
 
 type TestResult int32
@@ -425,7 +480,7 @@ func (x TestResult) String() string {
 	return proto.EnumName(TestResult_name, int32(x))
 }
 func (TestResult) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{6}
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{7}
 }
 
 type SimulationRateMultiplier int32
@@ -460,7 +515,7 @@ func (x SimulationRateMultiplier) String() string {
 	return proto.EnumName(SimulationRateMultiplier_name, int32(x))
 }
 func (SimulationRateMultiplier) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{7}
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{8}
 }
 
 type SampleRate int32
@@ -489,7 +544,7 @@ func (x SampleRate) String() string {
 	return proto.EnumName(SampleRate_name, int32(x))
 }
 func (SampleRate) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{8}
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{9}
 }
 
 type SimulationState int32
@@ -500,6 +555,10 @@ const (
 	SimulationState_COMPLETED       SimulationState = 2
 	SimulationState_FAILED_TO_START SimulationState = 3
 	SimulationState_FAILED          SimulationState = 4
+	// The simulation was cancelled before it completed.
+	SimulationState_CANCELLED SimulationState = 5
+	// The simulation is paused, it stops transmitting telemetry until it is resumed.
+	SimulationState_PAUSED SimulationState = 6
 )
 
 var SimulationState_name = map[int32]string{
@@ -508,6 +567,8 @@ var SimulationState_name = map[int32]string{
 	2: "COMPLETED",
 	3: "FAILED_TO_START",
 	4: "FAILED",
+	5: "CANCELLED",
+	6: "PAUSED",
 }
 var SimulationState_value = map[string]int32{
 	"INITIALIZING":    0,
@@ -515,13 +576,153 @@ var SimulationState_value = map[string]int32{
 	"COMPLETED":       2,
 	"FAILED_TO_START": 3,
 	"FAILED":          4,
+	"CANCELLED":       5,
+	"PAUSED":          6,
 }
 
 func (x SimulationState) String() string {
 	return proto.EnumName(SimulationState_name, int32(x))
 }
 func (SimulationState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{9}
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{10}
+}
+
+type SimulationSortField int32
+
+const (
+	SimulationSortField_START_TIMESTAMP     SimulationSortField = 0
+	SimulationSortField_END_TIMESTAMP       SimulationSortField = 1
+	SimulationSortField_DURATION_IN_MINUTES SimulationSortField = 2
+	SimulationSortField_PERCENT_COMPLETE    SimulationSortField = 3
+)
+
+var SimulationSortField_name = map[int32]string{
+	0: "START_TIMESTAMP",
+	1: "END_TIMESTAMP",
+	2: "DURATION_IN_MINUTES",
+	3: "PERCENT_COMPLETE",
+}
+var SimulationSortField_value = map[string]int32{
+	"START_TIMESTAMP":     0,
+	"END_TIMESTAMP":       1,
+	"DURATION_IN_MINUTES": 2,
+	"PERCENT_COMPLETE":    3,
+}
+
+func (x SimulationSortField) String() string {
+	return proto.EnumName(SimulationSortField_name, int32(x))
+}
+func (SimulationSortField) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{11}
+}
+
+type AggregateFunction int32
+
+const (
+	AggregateFunction_MIN   AggregateFunction = 0
+	AggregateFunction_MAX   AggregateFunction = 1
+	AggregateFunction_MEAN  AggregateFunction = 2
+	AggregateFunction_LAST  AggregateFunction = 3
+	AggregateFunction_COUNT AggregateFunction = 4
+)
+
+var AggregateFunction_name = map[int32]string{
+	0: "MIN",
+	1: "MAX",
+	2: "MEAN",
+	3: "LAST",
+	4: "COUNT",
+}
+var AggregateFunction_value = map[string]int32{
+	"MIN":   0,
+	"MAX":   1,
+	"MEAN":  2,
+	"LAST":  3,
+	"COUNT": 4,
+}
+
+func (x AggregateFunction) String() string {
+	return proto.EnumName(AggregateFunction_name, int32(x))
+}
+func (AggregateFunction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{12}
+}
+
+type ExportFormat int32
+
+const (
+	ExportFormat_CSV        ExportFormat = 0
+	ExportFormat_JSON_LINES ExportFormat = 1
+	ExportFormat_PARQUET    ExportFormat = 2
+)
+
+var ExportFormat_name = map[int32]string{
+	0: "CSV",
+	1: "JSON_LINES",
+	2: "PARQUET",
+}
+var ExportFormat_value = map[string]int32{
+	"CSV":        0,
+	"JSON_LINES": 1,
+	"PARQUET":    2,
+}
+
+func (x ExportFormat) String() string {
+	return proto.EnumName(ExportFormat_name, int32(x))
+}
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{13}
+}
+
+type ExportLayout int32
+
+const (
+	// One row per telemetry datum.
+	ExportLayout_LONG ExportLayout = 0
+	// One row per car and timestamp, with a value column per telemetry datum description and per
+	// channel registered when the export starts.
+	ExportLayout_WIDE ExportLayout = 1
+)
+
+var ExportLayout_name = map[int32]string{
+	0: "LONG",
+	1: "WIDE",
+}
+var ExportLayout_value = map[string]int32{
+	"LONG": 0,
+	"WIDE": 1,
+}
+
+func (x ExportLayout) String() string {
+	return proto.EnumName(ExportLayout_name, int32(x))
+}
+func (ExportLayout) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{14}
+}
+
+type AckMode int32
+
+const (
+	// Respond once the telemetry data has been written.
+	AckMode_ACK_DURABLE AckMode = 0
+	// Respond once the telemetry data has been queued, write failures are only logged.
+	AckMode_ACK_ACCEPTED AckMode = 1
+)
+
+var AckMode_name = map[int32]string{
+	0: "ACK_DURABLE",
+	1: "ACK_ACCEPTED",
+}
+var AckMode_value = map[string]int32{
+	"ACK_DURABLE":  0,
+	"ACK_ACCEPTED": 1,
+}
+
+func (x AckMode) String() string {
+	return proto.EnumName(AckMode_name, int32(x))
+}
+func (AckMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{15}
 }
 
 type ResponseDetails struct {
@@ -536,7 +737,7 @@ func (m *ResponseDetails) Reset()         { *m = ResponseDetails{} }
 func (m *ResponseDetails) String() string { return proto.CompactTextString(m) }
 func (*ResponseDetails) ProtoMessage()    {}
 func (*ResponseDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{0}
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{0}
 }
 func (m *ResponseDetails) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ResponseDetails.Unmarshal(m, b)
@@ -588,16 +789,26 @@ type TelemetryDatum struct {
 	Track                            Track                     `protobuf:"varint,15,opt,name=track,proto3,enum=api.Track" json:"track,omitempty"`
 	Constructor                      Constructor               `protobuf:"varint,16,opt,name=constructor,proto3,enum=api.Constructor" json:"constructor,omitempty"`
 	CarNumber                        int32                     `protobuf:"varint,17,opt,name=car_number,json=carNumber,proto3" json:"car_number,omitempty"`
-	XXX_NoUnkeyedLiteral             struct{}                  `json:"-"`
-	XXX_unrecognized                 []byte                    `json:"-"`
-	XXX_sizecache                    int32                     `json:"-"`
+	// lap is the lap number (from 1) and sector the sector of the lap (1 to 3) at the time of the
+	// datum, 0 when not known. lap_distance is the distance into the lap in meters.
+	Lap         int32   `protobuf:"varint,18,opt,name=lap,proto3" json:"lap,omitempty"`
+	Sector      int32   `protobuf:"varint,19,opt,name=sector,proto3" json:"sector,omitempty"`
+	LapDistance float64 `protobuf:"fixed64,20,opt,name=lap_distance,json=lapDistance,proto3" json:"lap_distance,omitempty"`
+	// channel is the name of a channel of the channel registry, when set it takes the place of
+	// description and unit. The telemetry service sets channel_unit to the unit of the channel.
+	// The built in channels are stored and retrieved as description and unit.
+	Channel              string   `protobuf:"bytes,21,opt,name=channel,proto3" json:"channel,omitempty"`
+	ChannelUnit          string   `protobuf:"bytes,22,opt,name=channel_unit,json=channelUnit,proto3" json:"channel_unit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *TelemetryDatum) Reset()         { *m = TelemetryDatum{} }
 func (m *TelemetryDatum) String() string { return proto.CompactTextString(m) }
 func (*TelemetryDatum) ProtoMessage()    {}
 func (*TelemetryDatum) Descriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{1}
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{1}
 }
 func (m *TelemetryDatum) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TelemetryDatum.Unmarshal(m, b)
@@ -736,6 +947,41 @@ func (m *TelemetryDatum) GetCarNumber() int32 {
 	return 0
 }
 
+func (m *TelemetryDatum) GetLap() int32 {
+	if m != nil {
+		return m.Lap
+	}
+	return 0
+}
+
+func (m *TelemetryDatum) GetSector() int32 {
+	if m != nil {
+		return m.Sector
+	}
+	return 0
+}
+
+func (m *TelemetryDatum) GetLapDistance() float64 {
+	if m != nil {
+		return m.LapDistance
+	}
+	return 0
+}
+
+func (m *TelemetryDatum) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *TelemetryDatum) GetChannelUnit() string {
+	if m != nil {
+		return m.ChannelUnit
+	}
+	return ""
+}
+
 type TelemetryData struct {
 	TelemetryDatumMap    map[string]*TelemetryDatum `protobuf:"bytes,5,rep,name=telemetry_datum_map,json=telemetryDatumMap,proto3" json:"telemetry_datum_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
@@ -747,7 +993,7 @@ func (m *TelemetryData) Reset()         { *m = TelemetryData{} }
 func (m *TelemetryData) String() string { return proto.CompactTextString(m) }
 func (*TelemetryData) ProtoMessage()    {}
 func (*TelemetryData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{2}
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{2}
 }
 func (m *TelemetryData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TelemetryData.Unmarshal(m, b)
@@ -774,6 +1020,252 @@ func (m *TelemetryData) GetTelemetryDatumMap() map[string]*TelemetryDatum {
 	return nil
 }
 
+type AlarmThreshold struct {
+	Uuid                 string                     `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	DatumDescription     TelemetryDatumDescription  `protobuf:"varint,2,opt,name=datum_description,json=datumDescription,proto3,enum=api.TelemetryDatumDescription" json:"datum_description,omitempty"`
+	OverrideBy           *AlarmThreshold_OverrideBy `protobuf:"bytes,3,opt,name=override_by,json=overrideBy,proto3" json:"override_by,omitempty"`
+	Constructor          Constructor                `protobuf:"varint,4,opt,name=constructor,proto3,enum=api.Constructor" json:"constructor,omitempty"`
+	CarNumber            int32                      `protobuf:"varint,5,opt,name=car_number,json=carNumber,proto3" json:"car_number,omitempty"`
+	Track                Track                      `protobuf:"varint,6,opt,name=track,proto3,enum=api.Track" json:"track,omitempty"`
+	HighAlarmEnabled     bool                       `protobuf:"varint,7,opt,name=high_alarm_enabled,json=highAlarmEnabled,proto3" json:"high_alarm_enabled,omitempty"`
+	HighAlarmValue       float64                    `protobuf:"fixed64,8,opt,name=high_alarm_value,json=highAlarmValue,proto3" json:"high_alarm_value,omitempty"`
+	LowAlarmEnabled      bool                       `protobuf:"varint,9,opt,name=low_alarm_enabled,json=lowAlarmEnabled,proto3" json:"low_alarm_enabled,omitempty"`
+	LowAlarmValue        float64                    `protobuf:"fixed64,10,opt,name=low_alarm_value,json=lowAlarmValue,proto3" json:"low_alarm_value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *AlarmThreshold) Reset()         { *m = AlarmThreshold{} }
+func (m *AlarmThreshold) String() string { return proto.CompactTextString(m) }
+func (*AlarmThreshold) ProtoMessage()    {}
+func (*AlarmThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{3}
+}
+func (m *AlarmThreshold) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlarmThreshold.Unmarshal(m, b)
+}
+func (m *AlarmThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlarmThreshold.Marshal(b, m, deterministic)
+}
+func (dst *AlarmThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlarmThreshold.Merge(dst, src)
+}
+func (m *AlarmThreshold) XXX_Size() int {
+	return xxx_messageInfo_AlarmThreshold.Size(m)
+}
+func (m *AlarmThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlarmThreshold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlarmThreshold proto.InternalMessageInfo
+
+func (m *AlarmThreshold) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *AlarmThreshold) GetDatumDescription() TelemetryDatumDescription {
+	if m != nil {
+		return m.DatumDescription
+	}
+	return TelemetryDatumDescription_G_FORCE
+}
+
+func (m *AlarmThreshold) GetOverrideBy() *AlarmThreshold_OverrideBy {
+	if m != nil {
+		return m.OverrideBy
+	}
+	return nil
+}
+
+func (m *AlarmThreshold) GetConstructor() Constructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return Constructor_ALPHA_ROMEO
+}
+
+func (m *AlarmThreshold) GetCarNumber() int32 {
+	if m != nil {
+		return m.CarNumber
+	}
+	return 0
+}
+
+func (m *AlarmThreshold) GetTrack() Track {
+	if m != nil {
+		return m.Track
+	}
+	return Track_AUSTIN
+}
+
+func (m *AlarmThreshold) GetHighAlarmEnabled() bool {
+	if m != nil {
+		return m.HighAlarmEnabled
+	}
+	return false
+}
+
+func (m *AlarmThreshold) GetHighAlarmValue() float64 {
+	if m != nil {
+		return m.HighAlarmValue
+	}
+	return 0
+}
+
+func (m *AlarmThreshold) GetLowAlarmEnabled() bool {
+	if m != nil {
+		return m.LowAlarmEnabled
+	}
+	return false
+}
+
+func (m *AlarmThreshold) GetLowAlarmValue() float64 {
+	if m != nil {
+		return m.LowAlarmValue
+	}
+	return 0
+}
+
+type AlarmThreshold_OverrideBy struct {
+	Constructor          bool     `protobuf:"varint,1,opt,name=constructor,proto3" json:"constructor,omitempty"`
+	CarNumber            bool     `protobuf:"varint,2,opt,name=car_number,json=carNumber,proto3" json:"car_number,omitempty"`
+	Track                bool     `protobuf:"varint,3,opt,name=track,proto3" json:"track,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlarmThreshold_OverrideBy) Reset()         { *m = AlarmThreshold_OverrideBy{} }
+func (m *AlarmThreshold_OverrideBy) String() string { return proto.CompactTextString(m) }
+func (*AlarmThreshold_OverrideBy) ProtoMessage()    {}
+func (*AlarmThreshold_OverrideBy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{3, 0}
+}
+func (m *AlarmThreshold_OverrideBy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlarmThreshold_OverrideBy.Unmarshal(m, b)
+}
+func (m *AlarmThreshold_OverrideBy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlarmThreshold_OverrideBy.Marshal(b, m, deterministic)
+}
+func (dst *AlarmThreshold_OverrideBy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlarmThreshold_OverrideBy.Merge(dst, src)
+}
+func (m *AlarmThreshold_OverrideBy) XXX_Size() int {
+	return xxx_messageInfo_AlarmThreshold_OverrideBy.Size(m)
+}
+func (m *AlarmThreshold_OverrideBy) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlarmThreshold_OverrideBy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlarmThreshold_OverrideBy proto.InternalMessageInfo
+
+func (m *AlarmThreshold_OverrideBy) GetConstructor() bool {
+	if m != nil {
+		return m.Constructor
+	}
+	return false
+}
+
+func (m *AlarmThreshold_OverrideBy) GetCarNumber() bool {
+	if m != nil {
+		return m.CarNumber
+	}
+	return false
+}
+
+func (m *AlarmThreshold_OverrideBy) GetTrack() bool {
+	if m != nil {
+		return m.Track
+	}
+	return false
+}
+
+// Channel is a telemetry channel of the telemetry service's channel registry. Every
+// TelemetryDatumDescription is a built in channel of the same name.
+type Channel struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Unit string `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+	// A value outside of min_value to max_value is rejected at ingest, a channel whose max_value is
+	// not greater than its min_value has no valid range.
+	MinValue             float64         `protobuf:"fixed64,3,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
+	MaxValue             float64         `protobuf:"fixed64,4,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	Category             ChannelCategory `protobuf:"varint,5,opt,name=category,proto3,enum=api.ChannelCategory" json:"category,omitempty"`
+	BuiltIn              bool            `protobuf:"varint,6,opt,name=built_in,json=builtIn,proto3" json:"built_in,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Channel) Reset()         { *m = Channel{} }
+func (m *Channel) String() string { return proto.CompactTextString(m) }
+func (*Channel) ProtoMessage()    {}
+func (*Channel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{4}
+}
+func (m *Channel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Channel.Unmarshal(m, b)
+}
+func (m *Channel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Channel.Marshal(b, m, deterministic)
+}
+func (dst *Channel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Channel.Merge(dst, src)
+}
+func (m *Channel) XXX_Size() int {
+	return xxx_messageInfo_Channel.Size(m)
+}
+func (m *Channel) XXX_DiscardUnknown() {
+	xxx_messageInfo_Channel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Channel proto.InternalMessageInfo
+
+func (m *Channel) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Channel) GetUnit() string {
+	if m != nil {
+		return m.Unit
+	}
+	return ""
+}
+
+func (m *Channel) GetMinValue() float64 {
+	if m != nil {
+		return m.MinValue
+	}
+	return 0
+}
+
+func (m *Channel) GetMaxValue() float64 {
+	if m != nil {
+		return m.MaxValue
+	}
+	return 0
+}
+
+func (m *Channel) GetCategory() ChannelCategory {
+	if m != nil {
+		return m.Category
+	}
+	return ChannelCategory_UNCATEGORIZED
+}
+
+func (m *Channel) GetBuiltIn() bool {
+	if m != nil {
+		return m.BuiltIn
+	}
+	return false
+}
+
 type AlarmAnalysisData struct {
 	Simulated            bool                                                `protobuf:"varint,1,opt,name=simulated,proto3" json:"simulated,omitempty"`
 	DateRangeBegin       *timestamp.Timestamp                                `protobuf:"bytes,2,opt,name=date_range_begin,json=dateRangeBegin,proto3" json:"date_range_begin,omitempty"`
@@ -788,7 +1280,7 @@ func (m *AlarmAnalysisData) Reset()         { *m = AlarmAnalysisData{} }
 func (m *AlarmAnalysisData) String() string { return proto.CompactTextString(m) }
 func (*AlarmAnalysisData) ProtoMessage()    {}
 func (*AlarmAnalysisData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{3}
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{5}
 }
 func (m *AlarmAnalysisData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AlarmAnalysisData.Unmarshal(m, b)
@@ -854,7 +1346,7 @@ func (m *AlarmAnalysisData_AlarmCountsByConstructorAndCar) String() string {
 }
 func (*AlarmAnalysisData_AlarmCountsByConstructorAndCar) ProtoMessage() {}
 func (*AlarmAnalysisData_AlarmCountsByConstructorAndCar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{3, 0}
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{5, 0}
 }
 func (m *AlarmAnalysisData_AlarmCountsByConstructorAndCar) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AlarmAnalysisData_AlarmCountsByConstructorAndCar.Unmarshal(m, b)
@@ -918,7 +1410,7 @@ func (m *ConstructorAlarmAnalysisData) Reset()         { *m = ConstructorAlarmAn
 func (m *ConstructorAlarmAnalysisData) String() string { return proto.CompactTextString(m) }
 func (*ConstructorAlarmAnalysisData) ProtoMessage()    {}
 func (*ConstructorAlarmAnalysisData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{4}
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{6}
 }
 func (m *ConstructorAlarmAnalysisData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConstructorAlarmAnalysisData.Unmarshal(m, b)
@@ -997,7 +1489,7 @@ func (m *ConstructorAlarmAnalysisData_AlarmCountsByDatumDescription) String() st
 }
 func (*ConstructorAlarmAnalysisData_AlarmCountsByDatumDescription) ProtoMessage() {}
 func (*ConstructorAlarmAnalysisData_AlarmCountsByDatumDescription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{4, 0}
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{6, 0}
 }
 func (m *ConstructorAlarmAnalysisData_AlarmCountsByDatumDescription) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConstructorAlarmAnalysisData_AlarmCountsByDatumDescription.Unmarshal(m, b)
@@ -1055,7 +1547,7 @@ func (m *SystemStatusReport) Reset()         { *m = SystemStatusReport{} }
 func (m *SystemStatusReport) String() string { return proto.CompactTextString(m) }
 func (*SystemStatusReport) ProtoMessage()    {}
 func (*SystemStatusReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{5}
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{7}
 }
 func (m *SystemStatusReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SystemStatusReport.Unmarshal(m, b)
@@ -1140,7 +1632,7 @@ func (m *SimulationMember) Reset()         { *m = SimulationMember{} }
 func (m *SimulationMember) String() string { return proto.CompactTextString(m) }
 func (*SimulationMember) ProtoMessage()    {}
 func (*SimulationMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{6}
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{8}
 }
 func (m *SimulationMember) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SimulationMember.Unmarshal(m, b)
@@ -1210,16 +1702,19 @@ type Simulation struct {
 	GranPrix                 GranPrix                     `protobuf:"varint,5,opt,name=gran_prix,json=granPrix,proto3,enum=api.GranPrix" json:"gran_prix,omitempty"`
 	Track                    Track                        `protobuf:"varint,6,opt,name=track,proto3,enum=api.Track" json:"track,omitempty"`
 	SimulationMemberMap      map[string]*SimulationMember `protobuf:"bytes,7,rep,name=simulation_member_map,json=simulationMemberMap,proto3" json:"simulation_member_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral     struct{}                     `json:"-"`
-	XXX_unrecognized         []byte                       `json:"-"`
-	XXX_sizecache            int32                        `json:"-"`
+	// seed seeds the generation of the simulated telemetry data, a simulation run again with the same
+	// seed and members generates the same telemetry data. When 0 the simulation service picks one.
+	Seed                 int64    `protobuf:"varint,8,opt,name=seed,proto3" json:"seed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Simulation) Reset()         { *m = Simulation{} }
 func (m *Simulation) String() string { return proto.CompactTextString(m) }
 func (*Simulation) ProtoMessage()    {}
 func (*Simulation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{7}
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{9}
 }
 func (m *Simulation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Simulation.Unmarshal(m, b)
@@ -1288,28 +1783,38 @@ func (m *Simulation) GetSimulationMemberMap() map[string]*SimulationMember {
 	return nil
 }
 
+func (m *Simulation) GetSeed() int64 {
+	if m != nil {
+		return m.Seed
+	}
+	return 0
+}
+
 type SimulationInfo struct {
-	Uuid                 string               `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	DurationInMinutes    int32                `protobuf:"varint,2,opt,name=duration_in_minutes,json=durationInMinutes,proto3" json:"duration_in_minutes,omitempty"`
-	SampleRate           SampleRate           `protobuf:"varint,3,opt,name=sample_rate,json=sampleRate,proto3,enum=api.SampleRate" json:"sample_rate,omitempty"`
-	GranPrix             GranPrix             `protobuf:"varint,4,opt,name=gran_prix,json=granPrix,proto3,enum=api.GranPrix" json:"gran_prix,omitempty"`
-	Track                Track                `protobuf:"varint,5,opt,name=track,proto3,enum=api.Track" json:"track,omitempty"`
-	State                SimulationState      `protobuf:"varint,6,opt,name=state,proto3,enum=api.SimulationState" json:"state,omitempty"`
-	StartTimestamp       *timestamp.Timestamp `protobuf:"bytes,7,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
-	EndTimestamp         *timestamp.Timestamp `protobuf:"bytes,8,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
-	PercentComplete      float64              `protobuf:"fixed64,9,opt,name=percent_complete,json=percentComplete,proto3" json:"percent_complete,omitempty"`
-	FinalStatusCode      string               `protobuf:"bytes,10,opt,name=final_status_code,json=finalStatusCode,proto3" json:"final_status_code,omitempty"`
-	FinalStatusMessage   string               `protobuf:"bytes,11,opt,name=final_status_message,json=finalStatusMessage,proto3" json:"final_status_message,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Uuid               string                  `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	DurationInMinutes  int32                   `protobuf:"varint,2,opt,name=duration_in_minutes,json=durationInMinutes,proto3" json:"duration_in_minutes,omitempty"`
+	SampleRate         SampleRate              `protobuf:"varint,3,opt,name=sample_rate,json=sampleRate,proto3,enum=api.SampleRate" json:"sample_rate,omitempty"`
+	GranPrix           GranPrix                `protobuf:"varint,4,opt,name=gran_prix,json=granPrix,proto3,enum=api.GranPrix" json:"gran_prix,omitempty"`
+	Track              Track                   `protobuf:"varint,5,opt,name=track,proto3,enum=api.Track" json:"track,omitempty"`
+	State              SimulationState         `protobuf:"varint,6,opt,name=state,proto3,enum=api.SimulationState" json:"state,omitempty"`
+	StartTimestamp     *timestamp.Timestamp    `protobuf:"bytes,7,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	EndTimestamp       *timestamp.Timestamp    `protobuf:"bytes,8,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	PercentComplete    float64                 `protobuf:"fixed64,9,opt,name=percent_complete,json=percentComplete,proto3" json:"percent_complete,omitempty"`
+	FinalStatusCode    string                  `protobuf:"bytes,10,opt,name=final_status_code,json=finalStatusCode,proto3" json:"final_status_code,omitempty"`
+	FinalStatusMessage string                  `protobuf:"bytes,11,opt,name=final_status_message,json=finalStatusMessage,proto3" json:"final_status_message,omitempty"`
+	Members            []*SimulationMemberInfo `protobuf:"bytes,12,rep,name=members,proto3" json:"members,omitempty"`
+	// seed is the seed the simulated telemetry data was generated from.
+	Seed                 int64    `protobuf:"varint,13,opt,name=seed,proto3" json:"seed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SimulationInfo) Reset()         { *m = SimulationInfo{} }
 func (m *SimulationInfo) String() string { return proto.CompactTextString(m) }
 func (*SimulationInfo) ProtoMessage()    {}
 func (*SimulationInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{8}
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{10}
 }
 func (m *SimulationInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SimulationInfo.Unmarshal(m, b)
@@ -1406,19 +1911,135 @@ func (m *SimulationInfo) GetFinalStatusMessage() string {
 	return ""
 }
 
-type AlivenessCheckRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *SimulationInfo) GetMembers() []*SimulationMemberInfo {
+	if m != nil {
+		return m.Members
+	}
+	return nil
 }
 
-func (m *AlivenessCheckRequest) Reset()         { *m = AlivenessCheckRequest{} }
-func (m *AlivenessCheckRequest) String() string { return proto.CompactTextString(m) }
-func (*AlivenessCheckRequest) ProtoMessage()    {}
-func (*AlivenessCheckRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{9}
+func (m *SimulationInfo) GetSeed() int64 {
+	if m != nil {
+		return m.Seed
+	}
+	return 0
 }
-func (m *AlivenessCheckRequest) XXX_Unmarshal(b []byte) error {
+
+type SimulationMemberInfo struct {
+	Uuid                  string      `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Constructor           Constructor `protobuf:"varint,2,opt,name=constructor,proto3,enum=api.Constructor" json:"constructor,omitempty"`
+	CarNumber             int32       `protobuf:"varint,3,opt,name=car_number,json=carNumber,proto3" json:"car_number,omitempty"`
+	ForceAlarm            bool        `protobuf:"varint,4,opt,name=force_alarm,json=forceAlarm,proto3" json:"force_alarm,omitempty"`
+	NoAlarms              bool        `protobuf:"varint,5,opt,name=no_alarms,json=noAlarms,proto3" json:"no_alarms,omitempty"`
+	AlarmOccurred         bool        `protobuf:"varint,6,opt,name=alarm_occurred,json=alarmOccurred,proto3" json:"alarm_occurred,omitempty"`
+	AlarmDatumDescription string      `protobuf:"bytes,7,opt,name=alarm_datum_description,json=alarmDatumDescription,proto3" json:"alarm_datum_description,omitempty"`
+	AlarmDatumUnit        string      `protobuf:"bytes,8,opt,name=alarm_datum_unit,json=alarmDatumUnit,proto3" json:"alarm_datum_unit,omitempty"`
+	AlarmDatumValue       float64     `protobuf:"fixed64,9,opt,name=alarm_datum_value,json=alarmDatumValue,proto3" json:"alarm_datum_value,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}    `json:"-"`
+	XXX_unrecognized      []byte      `json:"-"`
+	XXX_sizecache         int32       `json:"-"`
+}
+
+func (m *SimulationMemberInfo) Reset()         { *m = SimulationMemberInfo{} }
+func (m *SimulationMemberInfo) String() string { return proto.CompactTextString(m) }
+func (*SimulationMemberInfo) ProtoMessage()    {}
+func (*SimulationMemberInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{11}
+}
+func (m *SimulationMemberInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulationMemberInfo.Unmarshal(m, b)
+}
+func (m *SimulationMemberInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulationMemberInfo.Marshal(b, m, deterministic)
+}
+func (dst *SimulationMemberInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulationMemberInfo.Merge(dst, src)
+}
+func (m *SimulationMemberInfo) XXX_Size() int {
+	return xxx_messageInfo_SimulationMemberInfo.Size(m)
+}
+func (m *SimulationMemberInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulationMemberInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulationMemberInfo proto.InternalMessageInfo
+
+func (m *SimulationMemberInfo) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *SimulationMemberInfo) GetConstructor() Constructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return Constructor_ALPHA_ROMEO
+}
+
+func (m *SimulationMemberInfo) GetCarNumber() int32 {
+	if m != nil {
+		return m.CarNumber
+	}
+	return 0
+}
+
+func (m *SimulationMemberInfo) GetForceAlarm() bool {
+	if m != nil {
+		return m.ForceAlarm
+	}
+	return false
+}
+
+func (m *SimulationMemberInfo) GetNoAlarms() bool {
+	if m != nil {
+		return m.NoAlarms
+	}
+	return false
+}
+
+func (m *SimulationMemberInfo) GetAlarmOccurred() bool {
+	if m != nil {
+		return m.AlarmOccurred
+	}
+	return false
+}
+
+func (m *SimulationMemberInfo) GetAlarmDatumDescription() string {
+	if m != nil {
+		return m.AlarmDatumDescription
+	}
+	return ""
+}
+
+func (m *SimulationMemberInfo) GetAlarmDatumUnit() string {
+	if m != nil {
+		return m.AlarmDatumUnit
+	}
+	return ""
+}
+
+func (m *SimulationMemberInfo) GetAlarmDatumValue() float64 {
+	if m != nil {
+		return m.AlarmDatumValue
+	}
+	return 0
+}
+
+type AlivenessCheckRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlivenessCheckRequest) Reset()         { *m = AlivenessCheckRequest{} }
+func (m *AlivenessCheckRequest) String() string { return proto.CompactTextString(m) }
+func (*AlivenessCheckRequest) ProtoMessage()    {}
+func (*AlivenessCheckRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{12}
+}
+func (m *AlivenessCheckRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AlivenessCheckRequest.Unmarshal(m, b)
 }
 func (m *AlivenessCheckRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
@@ -1447,7 +2068,7 @@ func (m *AlivenessCheckResponse) Reset()         { *m = AlivenessCheckResponse{}
 func (m *AlivenessCheckResponse) String() string { return proto.CompactTextString(m) }
 func (*AlivenessCheckResponse) ProtoMessage()    {}
 func (*AlivenessCheckResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{10}
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{13}
 }
 func (m *AlivenessCheckResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AlivenessCheckResponse.Unmarshal(m, b)
@@ -1476,6 +2097,7 @@ func (m *AlivenessCheckResponse) GetDetails() *ResponseDetails {
 
 type TransmitTelemetryRequest struct {
 	TelemetryData        *TelemetryData `protobuf:"bytes,1,opt,name=telemetry_data,json=telemetryData,proto3" json:"telemetry_data,omitempty"`
+	AckMode              AckMode        `protobuf:"varint,2,opt,name=ack_mode,json=ackMode,proto3,enum=api.AckMode" json:"ack_mode,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -1485,7 +2107,7 @@ func (m *TransmitTelemetryRequest) Reset()         { *m = TransmitTelemetryReque
 func (m *TransmitTelemetryRequest) String() string { return proto.CompactTextString(m) }
 func (*TransmitTelemetryRequest) ProtoMessage()    {}
 func (*TransmitTelemetryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{11}
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{14}
 }
 func (m *TransmitTelemetryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TransmitTelemetryRequest.Unmarshal(m, b)
@@ -1512,6 +2134,13 @@ func (m *TransmitTelemetryRequest) GetTelemetryData() *TelemetryData {
 	return nil
 }
 
+func (m *TransmitTelemetryRequest) GetAckMode() AckMode {
+	if m != nil {
+		return m.AckMode
+	}
+	return AckMode_ACK_DURABLE
+}
+
 type TransmitTelemetryResponse struct {
 	Details              map[string]*ResponseDetails `protobuf:"bytes,1,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
@@ -1523,7 +2152,7 @@ func (m *TransmitTelemetryResponse) Reset()         { *m = TransmitTelemetryResp
 func (m *TransmitTelemetryResponse) String() string { return proto.CompactTextString(m) }
 func (*TransmitTelemetryResponse) ProtoMessage()    {}
 func (*TransmitTelemetryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{12}
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{15}
 }
 func (m *TransmitTelemetryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TransmitTelemetryResponse.Unmarshal(m, b)
@@ -1550,6 +2179,176 @@ func (m *TransmitTelemetryResponse) GetDetails() map[string]*ResponseDetails {
 	return nil
 }
 
+type TransmitTelemetryStreamRequest struct {
+	BatchSequenceNumber  int64          `protobuf:"varint,1,opt,name=batch_sequence_number,json=batchSequenceNumber,proto3" json:"batch_sequence_number,omitempty"`
+	TelemetryData        *TelemetryData `protobuf:"bytes,2,opt,name=telemetry_data,json=telemetryData,proto3" json:"telemetry_data,omitempty"`
+	AckMode              AckMode        `protobuf:"varint,3,opt,name=ack_mode,json=ackMode,proto3,enum=api.AckMode" json:"ack_mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *TransmitTelemetryStreamRequest) Reset()         { *m = TransmitTelemetryStreamRequest{} }
+func (m *TransmitTelemetryStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*TransmitTelemetryStreamRequest) ProtoMessage()    {}
+func (*TransmitTelemetryStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{16}
+}
+func (m *TransmitTelemetryStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransmitTelemetryStreamRequest.Unmarshal(m, b)
+}
+func (m *TransmitTelemetryStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransmitTelemetryStreamRequest.Marshal(b, m, deterministic)
+}
+func (dst *TransmitTelemetryStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransmitTelemetryStreamRequest.Merge(dst, src)
+}
+func (m *TransmitTelemetryStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_TransmitTelemetryStreamRequest.Size(m)
+}
+func (m *TransmitTelemetryStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransmitTelemetryStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransmitTelemetryStreamRequest proto.InternalMessageInfo
+
+func (m *TransmitTelemetryStreamRequest) GetBatchSequenceNumber() int64 {
+	if m != nil {
+		return m.BatchSequenceNumber
+	}
+	return 0
+}
+
+func (m *TransmitTelemetryStreamRequest) GetTelemetryData() *TelemetryData {
+	if m != nil {
+		return m.TelemetryData
+	}
+	return nil
+}
+
+func (m *TransmitTelemetryStreamRequest) GetAckMode() AckMode {
+	if m != nil {
+		return m.AckMode
+	}
+	return AckMode_ACK_DURABLE
+}
+
+type TelemetryBatchAck struct {
+	FirstBatchSequenceNumber int64                       `protobuf:"varint,1,opt,name=first_batch_sequence_number,json=firstBatchSequenceNumber,proto3" json:"first_batch_sequence_number,omitempty"`
+	LastBatchSequenceNumber  int64                       `protobuf:"varint,2,opt,name=last_batch_sequence_number,json=lastBatchSequenceNumber,proto3" json:"last_batch_sequence_number,omitempty"`
+	DatumCount               int32                       `protobuf:"varint,3,opt,name=datum_count,json=datumCount,proto3" json:"datum_count,omitempty"`
+	Details                  *ResponseDetails            `protobuf:"bytes,4,opt,name=details,proto3" json:"details,omitempty"`
+	DatumDetails             map[string]*ResponseDetails `protobuf:"bytes,5,rep,name=datum_details,json=datumDetails,proto3" json:"datum_details,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral     struct{}                    `json:"-"`
+	XXX_unrecognized         []byte                      `json:"-"`
+	XXX_sizecache            int32                       `json:"-"`
+}
+
+func (m *TelemetryBatchAck) Reset()         { *m = TelemetryBatchAck{} }
+func (m *TelemetryBatchAck) String() string { return proto.CompactTextString(m) }
+func (*TelemetryBatchAck) ProtoMessage()    {}
+func (*TelemetryBatchAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{17}
+}
+func (m *TelemetryBatchAck) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TelemetryBatchAck.Unmarshal(m, b)
+}
+func (m *TelemetryBatchAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TelemetryBatchAck.Marshal(b, m, deterministic)
+}
+func (dst *TelemetryBatchAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TelemetryBatchAck.Merge(dst, src)
+}
+func (m *TelemetryBatchAck) XXX_Size() int {
+	return xxx_messageInfo_TelemetryBatchAck.Size(m)
+}
+func (m *TelemetryBatchAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_TelemetryBatchAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TelemetryBatchAck proto.InternalMessageInfo
+
+func (m *TelemetryBatchAck) GetFirstBatchSequenceNumber() int64 {
+	if m != nil {
+		return m.FirstBatchSequenceNumber
+	}
+	return 0
+}
+
+func (m *TelemetryBatchAck) GetLastBatchSequenceNumber() int64 {
+	if m != nil {
+		return m.LastBatchSequenceNumber
+	}
+	return 0
+}
+
+func (m *TelemetryBatchAck) GetDatumCount() int32 {
+	if m != nil {
+		return m.DatumCount
+	}
+	return 0
+}
+
+func (m *TelemetryBatchAck) GetDetails() *ResponseDetails {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *TelemetryBatchAck) GetDatumDetails() map[string]*ResponseDetails {
+	if m != nil {
+		return m.DatumDetails
+	}
+	return nil
+}
+
+type TransmitTelemetryStreamResponse struct {
+	Details              *ResponseDetails     `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	BatchAcks            []*TelemetryBatchAck `protobuf:"bytes,2,rep,name=batch_acks,json=batchAcks,proto3" json:"batch_acks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *TransmitTelemetryStreamResponse) Reset()         { *m = TransmitTelemetryStreamResponse{} }
+func (m *TransmitTelemetryStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*TransmitTelemetryStreamResponse) ProtoMessage()    {}
+func (*TransmitTelemetryStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{18}
+}
+func (m *TransmitTelemetryStreamResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransmitTelemetryStreamResponse.Unmarshal(m, b)
+}
+func (m *TransmitTelemetryStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransmitTelemetryStreamResponse.Marshal(b, m, deterministic)
+}
+func (dst *TransmitTelemetryStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransmitTelemetryStreamResponse.Merge(dst, src)
+}
+func (m *TransmitTelemetryStreamResponse) XXX_Size() int {
+	return xxx_messageInfo_TransmitTelemetryStreamResponse.Size(m)
+}
+func (m *TransmitTelemetryStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransmitTelemetryStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransmitTelemetryStreamResponse proto.InternalMessageInfo
+
+func (m *TransmitTelemetryStreamResponse) GetDetails() *ResponseDetails {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *TransmitTelemetryStreamResponse) GetBatchAcks() []*TelemetryBatchAck {
+	if m != nil {
+		return m.BatchAcks
+	}
+	return nil
+}
+
 type RunSimulationRequest struct {
 	Simulation           *Simulation `protobuf:"bytes,1,opt,name=simulation,proto3" json:"simulation,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
@@ -1561,7 +2360,7 @@ func (m *RunSimulationRequest) Reset()         { *m = RunSimulationRequest{} }
 func (m *RunSimulationRequest) String() string { return proto.CompactTextString(m) }
 func (*RunSimulationRequest) ProtoMessage()    {}
 func (*RunSimulationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{13}
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{19}
 }
 func (m *RunSimulationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RunSimulationRequest.Unmarshal(m, b)
@@ -1599,7 +2398,7 @@ func (m *RunSimulationResponse) Reset()         { *m = RunSimulationResponse{} }
 func (m *RunSimulationResponse) String() string { return proto.CompactTextString(m) }
 func (*RunSimulationResponse) ProtoMessage()    {}
 func (*RunSimulationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{14}
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{20}
 }
 func (m *RunSimulationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RunSimulationResponse.Unmarshal(m, b)
@@ -1637,7 +2436,7 @@ func (m *GetSimulationInfoRequest) Reset()         { *m = GetSimulationInfoReque
 func (m *GetSimulationInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetSimulationInfoRequest) ProtoMessage()    {}
 func (*GetSimulationInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{15}
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{21}
 }
 func (m *GetSimulationInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetSimulationInfoRequest.Unmarshal(m, b)
@@ -1676,7 +2475,7 @@ func (m *GetSimulationInfoResponse) Reset()         { *m = GetSimulationInfoResp
 func (m *GetSimulationInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSimulationInfoResponse) ProtoMessage()    {}
 func (*GetSimulationInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{16}
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{22}
 }
 func (m *GetSimulationInfoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetSimulationInfoResponse.Unmarshal(m, b)
@@ -1710,598 +2509,2646 @@ func (m *GetSimulationInfoResponse) GetSimulationInfo() *SimulationInfo {
 	return nil
 }
 
-type GetTelemetryDataRequest struct {
-	Simulated            bool                              `protobuf:"varint,1,opt,name=simulated,proto3" json:"simulated,omitempty"`
-	SimulationUuid       string                            `protobuf:"bytes,2,opt,name=simulation_uuid,json=simulationUuid,proto3" json:"simulation_uuid,omitempty"`
-	DateRangeBegin       *timestamp.Timestamp              `protobuf:"bytes,3,opt,name=date_range_begin,json=dateRangeBegin,proto3" json:"date_range_begin,omitempty"`
-	DateRangeEnd         *timestamp.Timestamp              `protobuf:"bytes,4,opt,name=date_range_end,json=dateRangeEnd,proto3" json:"date_range_end,omitempty"`
-	GranPrix             GranPrix                          `protobuf:"varint,5,opt,name=gran_prix,json=granPrix,proto3,enum=api.GranPrix" json:"gran_prix,omitempty"`
-	Track                Track                             `protobuf:"varint,6,opt,name=track,proto3,enum=api.Track" json:"track,omitempty"`
-	Constructor          Constructor                       `protobuf:"varint,7,opt,name=constructor,proto3,enum=api.Constructor" json:"constructor,omitempty"`
-	CarNumber            int32                             `protobuf:"varint,8,opt,name=car_number,json=carNumber,proto3" json:"car_number,omitempty"`
-	DatumDescription     TelemetryDatumDescription         `protobuf:"varint,9,opt,name=datum_description,json=datumDescription,proto3,enum=api.TelemetryDatumDescription" json:"datum_description,omitempty"`
-	SearchBy             *GetTelemetryDataRequest_SearchBy `protobuf:"bytes,10,opt,name=search_by,json=searchBy,proto3" json:"search_by,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
-	XXX_unrecognized     []byte                            `json:"-"`
-	XXX_sizecache        int32                             `json:"-"`
+type CancelSimulationRequest struct {
+	SimulationUuid       string   `protobuf:"bytes,1,opt,name=simulation_uuid,json=simulationUuid,proto3" json:"simulation_uuid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetTelemetryDataRequest) Reset()         { *m = GetTelemetryDataRequest{} }
-func (m *GetTelemetryDataRequest) String() string { return proto.CompactTextString(m) }
-func (*GetTelemetryDataRequest) ProtoMessage()    {}
-func (*GetTelemetryDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{17}
+func (m *CancelSimulationRequest) Reset()         { *m = CancelSimulationRequest{} }
+func (m *CancelSimulationRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelSimulationRequest) ProtoMessage()    {}
+func (*CancelSimulationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{23}
 }
-func (m *GetTelemetryDataRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetTelemetryDataRequest.Unmarshal(m, b)
+func (m *CancelSimulationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelSimulationRequest.Unmarshal(m, b)
 }
-func (m *GetTelemetryDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetTelemetryDataRequest.Marshal(b, m, deterministic)
+func (m *CancelSimulationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelSimulationRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetTelemetryDataRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTelemetryDataRequest.Merge(dst, src)
+func (dst *CancelSimulationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelSimulationRequest.Merge(dst, src)
 }
-func (m *GetTelemetryDataRequest) XXX_Size() int {
-	return xxx_messageInfo_GetTelemetryDataRequest.Size(m)
+func (m *CancelSimulationRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelSimulationRequest.Size(m)
 }
-func (m *GetTelemetryDataRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTelemetryDataRequest.DiscardUnknown(m)
+func (m *CancelSimulationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelSimulationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTelemetryDataRequest proto.InternalMessageInfo
-
-func (m *GetTelemetryDataRequest) GetSimulated() bool {
-	if m != nil {
-		return m.Simulated
-	}
-	return false
-}
+var xxx_messageInfo_CancelSimulationRequest proto.InternalMessageInfo
 
-func (m *GetTelemetryDataRequest) GetSimulationUuid() string {
+func (m *CancelSimulationRequest) GetSimulationUuid() string {
 	if m != nil {
 		return m.SimulationUuid
 	}
 	return ""
 }
 
-func (m *GetTelemetryDataRequest) GetDateRangeBegin() *timestamp.Timestamp {
-	if m != nil {
-		return m.DateRangeBegin
-	}
-	return nil
+type CancelSimulationResponse struct {
+	Details              *ResponseDetails `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *GetTelemetryDataRequest) GetDateRangeEnd() *timestamp.Timestamp {
-	if m != nil {
-		return m.DateRangeEnd
-	}
-	return nil
+func (m *CancelSimulationResponse) Reset()         { *m = CancelSimulationResponse{} }
+func (m *CancelSimulationResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelSimulationResponse) ProtoMessage()    {}
+func (*CancelSimulationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{24}
 }
-
-func (m *GetTelemetryDataRequest) GetGranPrix() GranPrix {
-	if m != nil {
-		return m.GranPrix
-	}
-	return GranPrix_UNITED_STATES
+func (m *CancelSimulationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelSimulationResponse.Unmarshal(m, b)
 }
-
-func (m *GetTelemetryDataRequest) GetTrack() Track {
-	if m != nil {
-		return m.Track
-	}
-	return Track_AUSTIN
+func (m *CancelSimulationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelSimulationResponse.Marshal(b, m, deterministic)
 }
-
-func (m *GetTelemetryDataRequest) GetConstructor() Constructor {
-	if m != nil {
-		return m.Constructor
-	}
-	return Constructor_ALPHA_ROMEO
+func (dst *CancelSimulationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelSimulationResponse.Merge(dst, src)
 }
-
-func (m *GetTelemetryDataRequest) GetCarNumber() int32 {
-	if m != nil {
-		return m.CarNumber
-	}
-	return 0
+func (m *CancelSimulationResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelSimulationResponse.Size(m)
 }
-
-func (m *GetTelemetryDataRequest) GetDatumDescription() TelemetryDatumDescription {
-	if m != nil {
-		return m.DatumDescription
-	}
-	return TelemetryDatumDescription_G_FORCE
+func (m *CancelSimulationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelSimulationResponse.DiscardUnknown(m)
 }
 
-func (m *GetTelemetryDataRequest) GetSearchBy() *GetTelemetryDataRequest_SearchBy {
+var xxx_messageInfo_CancelSimulationResponse proto.InternalMessageInfo
+
+func (m *CancelSimulationResponse) GetDetails() *ResponseDetails {
 	if m != nil {
-		return m.SearchBy
+		return m.Details
 	}
 	return nil
 }
 
-type GetTelemetryDataRequest_SearchBy struct {
-	DateRange            bool     `protobuf:"varint,1,opt,name=date_range,json=dateRange,proto3" json:"date_range,omitempty"`
-	Constructor          bool     `protobuf:"varint,2,opt,name=constructor,proto3" json:"constructor,omitempty"`
-	CarNumber            bool     `protobuf:"varint,3,opt,name=car_number,json=carNumber,proto3" json:"car_number,omitempty"`
-	DatumDescription     bool     `protobuf:"varint,4,opt,name=datum_description,json=datumDescription,proto3" json:"datum_description,omitempty"`
-	GranPrix             bool     `protobuf:"varint,5,opt,name=gran_prix,json=granPrix,proto3" json:"gran_prix,omitempty"`
-	Track                bool     `protobuf:"varint,6,opt,name=track,proto3" json:"track,omitempty"`
-	HighAlarm            bool     `protobuf:"varint,7,opt,name=high_alarm,json=highAlarm,proto3" json:"high_alarm,omitempty"`
-	LowAlarm             bool     `protobuf:"varint,8,opt,name=low_alarm,json=lowAlarm,proto3" json:"low_alarm,omitempty"`
+type PauseSimulationRequest struct {
+	SimulationUuid       string   `protobuf:"bytes,1,opt,name=simulation_uuid,json=simulationUuid,proto3" json:"simulation_uuid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetTelemetryDataRequest_SearchBy) Reset()         { *m = GetTelemetryDataRequest_SearchBy{} }
-func (m *GetTelemetryDataRequest_SearchBy) String() string { return proto.CompactTextString(m) }
-func (*GetTelemetryDataRequest_SearchBy) ProtoMessage()    {}
-func (*GetTelemetryDataRequest_SearchBy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{17, 0}
+func (m *PauseSimulationRequest) Reset()         { *m = PauseSimulationRequest{} }
+func (m *PauseSimulationRequest) String() string { return proto.CompactTextString(m) }
+func (*PauseSimulationRequest) ProtoMessage()    {}
+func (*PauseSimulationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{25}
 }
-func (m *GetTelemetryDataRequest_SearchBy) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetTelemetryDataRequest_SearchBy.Unmarshal(m, b)
+func (m *PauseSimulationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseSimulationRequest.Unmarshal(m, b)
 }
-func (m *GetTelemetryDataRequest_SearchBy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetTelemetryDataRequest_SearchBy.Marshal(b, m, deterministic)
+func (m *PauseSimulationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseSimulationRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetTelemetryDataRequest_SearchBy) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTelemetryDataRequest_SearchBy.Merge(dst, src)
+func (dst *PauseSimulationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseSimulationRequest.Merge(dst, src)
 }
-func (m *GetTelemetryDataRequest_SearchBy) XXX_Size() int {
-	return xxx_messageInfo_GetTelemetryDataRequest_SearchBy.Size(m)
+func (m *PauseSimulationRequest) XXX_Size() int {
+	return xxx_messageInfo_PauseSimulationRequest.Size(m)
 }
-func (m *GetTelemetryDataRequest_SearchBy) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTelemetryDataRequest_SearchBy.DiscardUnknown(m)
+func (m *PauseSimulationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseSimulationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTelemetryDataRequest_SearchBy proto.InternalMessageInfo
+var xxx_messageInfo_PauseSimulationRequest proto.InternalMessageInfo
 
-func (m *GetTelemetryDataRequest_SearchBy) GetDateRange() bool {
+func (m *PauseSimulationRequest) GetSimulationUuid() string {
 	if m != nil {
-		return m.DateRange
+		return m.SimulationUuid
 	}
-	return false
+	return ""
 }
 
-func (m *GetTelemetryDataRequest_SearchBy) GetConstructor() bool {
-	if m != nil {
-		return m.Constructor
-	}
-	return false
+type PauseSimulationResponse struct {
+	Details              *ResponseDetails `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *GetTelemetryDataRequest_SearchBy) GetCarNumber() bool {
-	if m != nil {
-		return m.CarNumber
-	}
-	return false
+func (m *PauseSimulationResponse) Reset()         { *m = PauseSimulationResponse{} }
+func (m *PauseSimulationResponse) String() string { return proto.CompactTextString(m) }
+func (*PauseSimulationResponse) ProtoMessage()    {}
+func (*PauseSimulationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{26}
 }
-
-func (m *GetTelemetryDataRequest_SearchBy) GetDatumDescription() bool {
-	if m != nil {
-		return m.DatumDescription
-	}
-	return false
+func (m *PauseSimulationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseSimulationResponse.Unmarshal(m, b)
+}
+func (m *PauseSimulationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseSimulationResponse.Marshal(b, m, deterministic)
+}
+func (dst *PauseSimulationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseSimulationResponse.Merge(dst, src)
+}
+func (m *PauseSimulationResponse) XXX_Size() int {
+	return xxx_messageInfo_PauseSimulationResponse.Size(m)
+}
+func (m *PauseSimulationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseSimulationResponse.DiscardUnknown(m)
 }
 
-func (m *GetTelemetryDataRequest_SearchBy) GetGranPrix() bool {
+var xxx_messageInfo_PauseSimulationResponse proto.InternalMessageInfo
+
+func (m *PauseSimulationResponse) GetDetails() *ResponseDetails {
 	if m != nil {
-		return m.GranPrix
+		return m.Details
 	}
-	return false
+	return nil
 }
 
-func (m *GetTelemetryDataRequest_SearchBy) GetTrack() bool {
-	if m != nil {
-		return m.Track
-	}
-	return false
+type ResumeSimulationRequest struct {
+	SimulationUuid       string   `protobuf:"bytes,1,opt,name=simulation_uuid,json=simulationUuid,proto3" json:"simulation_uuid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetTelemetryDataRequest_SearchBy) GetHighAlarm() bool {
-	if m != nil {
-		return m.HighAlarm
-	}
-	return false
+func (m *ResumeSimulationRequest) Reset()         { *m = ResumeSimulationRequest{} }
+func (m *ResumeSimulationRequest) String() string { return proto.CompactTextString(m) }
+func (*ResumeSimulationRequest) ProtoMessage()    {}
+func (*ResumeSimulationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{27}
+}
+func (m *ResumeSimulationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeSimulationRequest.Unmarshal(m, b)
+}
+func (m *ResumeSimulationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeSimulationRequest.Marshal(b, m, deterministic)
+}
+func (dst *ResumeSimulationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeSimulationRequest.Merge(dst, src)
+}
+func (m *ResumeSimulationRequest) XXX_Size() int {
+	return xxx_messageInfo_ResumeSimulationRequest.Size(m)
+}
+func (m *ResumeSimulationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeSimulationRequest.DiscardUnknown(m)
 }
 
-func (m *GetTelemetryDataRequest_SearchBy) GetLowAlarm() bool {
+var xxx_messageInfo_ResumeSimulationRequest proto.InternalMessageInfo
+
+func (m *ResumeSimulationRequest) GetSimulationUuid() string {
 	if m != nil {
-		return m.LowAlarm
+		return m.SimulationUuid
 	}
-	return false
+	return ""
 }
 
-type GetTelemetryDataResponse struct {
+type ResumeSimulationResponse struct {
 	Details              *ResponseDetails `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
-	TelemetryData        *TelemetryData   `protobuf:"bytes,2,opt,name=telemetry_data,json=telemetryData,proto3" json:"telemetry_data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *GetTelemetryDataResponse) Reset()         { *m = GetTelemetryDataResponse{} }
-func (m *GetTelemetryDataResponse) String() string { return proto.CompactTextString(m) }
-func (*GetTelemetryDataResponse) ProtoMessage()    {}
-func (*GetTelemetryDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{18}
+func (m *ResumeSimulationResponse) Reset()         { *m = ResumeSimulationResponse{} }
+func (m *ResumeSimulationResponse) String() string { return proto.CompactTextString(m) }
+func (*ResumeSimulationResponse) ProtoMessage()    {}
+func (*ResumeSimulationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{28}
 }
-func (m *GetTelemetryDataResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetTelemetryDataResponse.Unmarshal(m, b)
+func (m *ResumeSimulationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeSimulationResponse.Unmarshal(m, b)
 }
-func (m *GetTelemetryDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetTelemetryDataResponse.Marshal(b, m, deterministic)
+func (m *ResumeSimulationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeSimulationResponse.Marshal(b, m, deterministic)
 }
-func (dst *GetTelemetryDataResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTelemetryDataResponse.Merge(dst, src)
+func (dst *ResumeSimulationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeSimulationResponse.Merge(dst, src)
 }
-func (m *GetTelemetryDataResponse) XXX_Size() int {
-	return xxx_messageInfo_GetTelemetryDataResponse.Size(m)
+func (m *ResumeSimulationResponse) XXX_Size() int {
+	return xxx_messageInfo_ResumeSimulationResponse.Size(m)
 }
-func (m *GetTelemetryDataResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTelemetryDataResponse.DiscardUnknown(m)
+func (m *ResumeSimulationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeSimulationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTelemetryDataResponse proto.InternalMessageInfo
+var xxx_messageInfo_ResumeSimulationResponse proto.InternalMessageInfo
 
-func (m *GetTelemetryDataResponse) GetDetails() *ResponseDetails {
+func (m *ResumeSimulationResponse) GetDetails() *ResponseDetails {
 	if m != nil {
 		return m.Details
 	}
 	return nil
 }
 
-func (m *GetTelemetryDataResponse) GetTelemetryData() *TelemetryData {
-	if m != nil {
-		return m.TelemetryData
-	}
-	return nil
+// An empty filter list matches any value.
+type ListSimulationsRequest struct {
+	States   []SimulationState `protobuf:"varint,1,rep,packed,name=states,proto3,enum=api.SimulationState" json:"states,omitempty"`
+	GranPrix []GranPrix        `protobuf:"varint,2,rep,packed,name=gran_prix,json=granPrix,proto3,enum=api.GranPrix" json:"gran_prix,omitempty"`
+	Tracks   []Track           `protobuf:"varint,3,rep,packed,name=tracks,proto3,enum=api.Track" json:"tracks,omitempty"`
+	// constructors matches the simulations with a member of any of the constructors.
+	Constructors []Constructor `protobuf:"varint,4,rep,packed,name=constructors,proto3,enum=api.Constructor" json:"constructors,omitempty"`
+	// start_time_from is inclusive and start_time_to is exclusive, either may be unset. A
+	// simulation that has not started yet has no start time and is excluded by either bound.
+	StartTimeFrom *timestamp.Timestamp `protobuf:"bytes,5,opt,name=start_time_from,json=startTimeFrom,proto3" json:"start_time_from,omitempty"`
+	StartTimeTo   *timestamp.Timestamp `protobuf:"bytes,6,opt,name=start_time_to,json=startTimeTo,proto3" json:"start_time_to,omitempty"`
+	SortBy        SimulationSortField  `protobuf:"varint,7,opt,name=sort_by,json=sortBy,proto3,enum=api.SimulationSortField" json:"sort_by,omitempty"`
+	Descending    bool                 `protobuf:"varint,8,opt,name=descending,proto3" json:"descending,omitempty"`
+	PageSize      int32                `protobuf:"varint,9,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token is the next_page_token of a previous response, it is rejected unless the filters
+	// and sort of the request are the same as those of the request that it was issued for.
+	PageToken            string   `protobuf:"bytes,10,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type GetAlarmAnalysisRequest struct {
-	Simulated            bool                 `protobuf:"varint,1,opt,name=simulated,proto3" json:"simulated,omitempty"`
-	SimulationUuid       string               `protobuf:"bytes,2,opt,name=simulation_uuid,json=simulationUuid,proto3" json:"simulation_uuid,omitempty"`
-	DateRangeBegin       *timestamp.Timestamp `protobuf:"bytes,3,opt,name=date_range_begin,json=dateRangeBegin,proto3" json:"date_range_begin,omitempty"`
-	DateRangeEnd         *timestamp.Timestamp `protobuf:"bytes,4,opt,name=date_range_end,json=dateRangeEnd,proto3" json:"date_range_end,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+func (m *ListSimulationsRequest) Reset()         { *m = ListSimulationsRequest{} }
+func (m *ListSimulationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSimulationsRequest) ProtoMessage()    {}
+func (*ListSimulationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{29}
 }
-
-func (m *GetAlarmAnalysisRequest) Reset()         { *m = GetAlarmAnalysisRequest{} }
-func (m *GetAlarmAnalysisRequest) String() string { return proto.CompactTextString(m) }
-func (*GetAlarmAnalysisRequest) ProtoMessage()    {}
-func (*GetAlarmAnalysisRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{19}
+func (m *ListSimulationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSimulationsRequest.Unmarshal(m, b)
 }
-func (m *GetAlarmAnalysisRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetAlarmAnalysisRequest.Unmarshal(m, b)
+func (m *ListSimulationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSimulationsRequest.Marshal(b, m, deterministic)
 }
-func (m *GetAlarmAnalysisRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetAlarmAnalysisRequest.Marshal(b, m, deterministic)
+func (dst *ListSimulationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSimulationsRequest.Merge(dst, src)
 }
-func (dst *GetAlarmAnalysisRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetAlarmAnalysisRequest.Merge(dst, src)
+func (m *ListSimulationsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSimulationsRequest.Size(m)
 }
-func (m *GetAlarmAnalysisRequest) XXX_Size() int {
-	return xxx_messageInfo_GetAlarmAnalysisRequest.Size(m)
+func (m *ListSimulationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSimulationsRequest.DiscardUnknown(m)
 }
-func (m *GetAlarmAnalysisRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetAlarmAnalysisRequest.DiscardUnknown(m)
+
+var xxx_messageInfo_ListSimulationsRequest proto.InternalMessageInfo
+
+func (m *ListSimulationsRequest) GetStates() []SimulationState {
+	if m != nil {
+		return m.States
+	}
+	return nil
 }
 
-var xxx_messageInfo_GetAlarmAnalysisRequest proto.InternalMessageInfo
+func (m *ListSimulationsRequest) GetGranPrix() []GranPrix {
+	if m != nil {
+		return m.GranPrix
+	}
+	return nil
+}
 
-func (m *GetAlarmAnalysisRequest) GetSimulated() bool {
+func (m *ListSimulationsRequest) GetTracks() []Track {
 	if m != nil {
-		return m.Simulated
+		return m.Tracks
 	}
-	return false
+	return nil
 }
 
-func (m *GetAlarmAnalysisRequest) GetSimulationUuid() string {
+func (m *ListSimulationsRequest) GetConstructors() []Constructor {
 	if m != nil {
-		return m.SimulationUuid
+		return m.Constructors
 	}
-	return ""
+	return nil
 }
 
-func (m *GetAlarmAnalysisRequest) GetDateRangeBegin() *timestamp.Timestamp {
+func (m *ListSimulationsRequest) GetStartTimeFrom() *timestamp.Timestamp {
 	if m != nil {
-		return m.DateRangeBegin
+		return m.StartTimeFrom
 	}
 	return nil
 }
 
-func (m *GetAlarmAnalysisRequest) GetDateRangeEnd() *timestamp.Timestamp {
+func (m *ListSimulationsRequest) GetStartTimeTo() *timestamp.Timestamp {
 	if m != nil {
-		return m.DateRangeEnd
+		return m.StartTimeTo
 	}
 	return nil
 }
 
-type GetAlarmAnalysisResponse struct {
-	Details              *ResponseDetails   `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
-	AlarmAnalysisData    *AlarmAnalysisData `protobuf:"bytes,2,opt,name=alarm_analysis_data,json=alarmAnalysisData,proto3" json:"alarm_analysis_data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+func (m *ListSimulationsRequest) GetSortBy() SimulationSortField {
+	if m != nil {
+		return m.SortBy
+	}
+	return SimulationSortField_START_TIMESTAMP
 }
 
-func (m *GetAlarmAnalysisResponse) Reset()         { *m = GetAlarmAnalysisResponse{} }
-func (m *GetAlarmAnalysisResponse) String() string { return proto.CompactTextString(m) }
-func (*GetAlarmAnalysisResponse) ProtoMessage()    {}
-func (*GetAlarmAnalysisResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{20}
+func (m *ListSimulationsRequest) GetDescending() bool {
+	if m != nil {
+		return m.Descending
+	}
+	return false
 }
-func (m *GetAlarmAnalysisResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetAlarmAnalysisResponse.Unmarshal(m, b)
+
+func (m *ListSimulationsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
 }
-func (m *GetAlarmAnalysisResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetAlarmAnalysisResponse.Marshal(b, m, deterministic)
+
+func (m *ListSimulationsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
 }
-func (dst *GetAlarmAnalysisResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetAlarmAnalysisResponse.Merge(dst, src)
+
+type ListSimulationsResponse struct {
+	Details              *ResponseDetails  `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	Simulations          []*SimulationInfo `protobuf:"bytes,2,rep,name=simulations,proto3" json:"simulations,omitempty"`
+	NextPageToken        string            `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
-func (m *GetAlarmAnalysisResponse) XXX_Size() int {
-	return xxx_messageInfo_GetAlarmAnalysisResponse.Size(m)
+
+func (m *ListSimulationsResponse) Reset()         { *m = ListSimulationsResponse{} }
+func (m *ListSimulationsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSimulationsResponse) ProtoMessage()    {}
+func (*ListSimulationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{30}
 }
-func (m *GetAlarmAnalysisResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetAlarmAnalysisResponse.DiscardUnknown(m)
+func (m *ListSimulationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSimulationsResponse.Unmarshal(m, b)
+}
+func (m *ListSimulationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSimulationsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListSimulationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSimulationsResponse.Merge(dst, src)
+}
+func (m *ListSimulationsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSimulationsResponse.Size(m)
+}
+func (m *ListSimulationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSimulationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetAlarmAnalysisResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListSimulationsResponse proto.InternalMessageInfo
 
-func (m *GetAlarmAnalysisResponse) GetDetails() *ResponseDetails {
+func (m *ListSimulationsResponse) GetDetails() *ResponseDetails {
 	if m != nil {
 		return m.Details
 	}
 	return nil
 }
 
-func (m *GetAlarmAnalysisResponse) GetAlarmAnalysisData() *AlarmAnalysisData {
+func (m *ListSimulationsResponse) GetSimulations() []*SimulationInfo {
 	if m != nil {
-		return m.AlarmAnalysisData
+		return m.Simulations
 	}
 	return nil
 }
 
-type GetConstructorAlarmAnalysisRequest struct {
-	Simulated            bool                 `protobuf:"varint,1,opt,name=simulated,proto3" json:"simulated,omitempty"`
-	SimulationUuid       string               `protobuf:"bytes,2,opt,name=simulation_uuid,json=simulationUuid,proto3" json:"simulation_uuid,omitempty"`
-	DateRangeBegin       *timestamp.Timestamp `protobuf:"bytes,3,opt,name=date_range_begin,json=dateRangeBegin,proto3" json:"date_range_begin,omitempty"`
-	DateRangeEnd         *timestamp.Timestamp `protobuf:"bytes,4,opt,name=date_range_end,json=dateRangeEnd,proto3" json:"date_range_end,omitempty"`
-	Constructor          Constructor          `protobuf:"varint,5,opt,name=constructor,proto3,enum=api.Constructor" json:"constructor,omitempty"`
-	CarNumber            int32                `protobuf:"varint,6,opt,name=car_number,json=carNumber,proto3" json:"car_number,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+func (m *ListSimulationsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
 }
 
-func (m *GetConstructorAlarmAnalysisRequest) Reset()         { *m = GetConstructorAlarmAnalysisRequest{} }
-func (m *GetConstructorAlarmAnalysisRequest) String() string { return proto.CompactTextString(m) }
-func (*GetConstructorAlarmAnalysisRequest) ProtoMessage()    {}
-func (*GetConstructorAlarmAnalysisRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{21}
+type GetTelemetryDataRequest struct {
+	Simulated         bool                              `protobuf:"varint,1,opt,name=simulated,proto3" json:"simulated,omitempty"`
+	SimulationUuid    string                            `protobuf:"bytes,2,opt,name=simulation_uuid,json=simulationUuid,proto3" json:"simulation_uuid,omitempty"`
+	DateRangeBegin    *timestamp.Timestamp              `protobuf:"bytes,3,opt,name=date_range_begin,json=dateRangeBegin,proto3" json:"date_range_begin,omitempty"`
+	DateRangeEnd      *timestamp.Timestamp              `protobuf:"bytes,4,opt,name=date_range_end,json=dateRangeEnd,proto3" json:"date_range_end,omitempty"`
+	GranPrix          GranPrix                          `protobuf:"varint,5,opt,name=gran_prix,json=granPrix,proto3,enum=api.GranPrix" json:"gran_prix,omitempty"`
+	Track             Track                             `protobuf:"varint,6,opt,name=track,proto3,enum=api.Track" json:"track,omitempty"`
+	Constructor       Constructor                       `protobuf:"varint,7,opt,name=constructor,proto3,enum=api.Constructor" json:"constructor,omitempty"`
+	CarNumber         int32                             `protobuf:"varint,8,opt,name=car_number,json=carNumber,proto3" json:"car_number,omitempty"`
+	DatumDescription  TelemetryDatumDescription         `protobuf:"varint,9,opt,name=datum_description,json=datumDescription,proto3,enum=api.TelemetryDatumDescription" json:"datum_description,omitempty"`
+	SearchBy          *GetTelemetryDataRequest_SearchBy `protobuf:"bytes,10,opt,name=search_by,json=searchBy,proto3" json:"search_by,omitempty"`
+	PageSize          int32                             `protobuf:"varint,11,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken         string                            `protobuf:"bytes,12,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Constructors      []Constructor                     `protobuf:"varint,13,rep,packed,name=constructors,proto3,enum=api.Constructor" json:"constructors,omitempty"`
+	CarNumbers        []int32                           `protobuf:"varint,14,rep,packed,name=car_numbers,json=carNumbers,proto3" json:"car_numbers,omitempty"`
+	DatumDescriptions []TelemetryDatumDescription       `protobuf:"varint,15,rep,packed,name=datum_descriptions,json=datumDescriptions,proto3,enum=api.TelemetryDatumDescription" json:"datum_descriptions,omitempty"`
+	Lap               int32                             `protobuf:"varint,16,opt,name=lap,proto3" json:"lap,omitempty"`
+	Sector            int32                             `protobuf:"varint,17,opt,name=sector,proto3" json:"sector,omitempty"`
+	Laps              []int32                           `protobuf:"varint,18,rep,packed,name=laps,proto3" json:"laps,omitempty"`
+	Sectors           []int32                           `protobuf:"varint,19,rep,packed,name=sectors,proto3" json:"sectors,omitempty"`
+	// channels are channel registry names, built in channel names match the datum of that
+	// description.
+	Channels             []string `protobuf:"bytes,20,rep,name=channels,proto3" json:"channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *GetConstructorAlarmAnalysisRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetConstructorAlarmAnalysisRequest.Unmarshal(m, b)
+
+func (m *GetTelemetryDataRequest) Reset()         { *m = GetTelemetryDataRequest{} }
+func (m *GetTelemetryDataRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTelemetryDataRequest) ProtoMessage()    {}
+func (*GetTelemetryDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{31}
 }
-func (m *GetConstructorAlarmAnalysisRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetConstructorAlarmAnalysisRequest.Marshal(b, m, deterministic)
+func (m *GetTelemetryDataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTelemetryDataRequest.Unmarshal(m, b)
 }
-func (dst *GetConstructorAlarmAnalysisRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetConstructorAlarmAnalysisRequest.Merge(dst, src)
+func (m *GetTelemetryDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTelemetryDataRequest.Marshal(b, m, deterministic)
 }
-func (m *GetConstructorAlarmAnalysisRequest) XXX_Size() int {
-	return xxx_messageInfo_GetConstructorAlarmAnalysisRequest.Size(m)
+func (dst *GetTelemetryDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTelemetryDataRequest.Merge(dst, src)
 }
-func (m *GetConstructorAlarmAnalysisRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetConstructorAlarmAnalysisRequest.DiscardUnknown(m)
+func (m *GetTelemetryDataRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTelemetryDataRequest.Size(m)
+}
+func (m *GetTelemetryDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTelemetryDataRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetConstructorAlarmAnalysisRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetTelemetryDataRequest proto.InternalMessageInfo
 
-func (m *GetConstructorAlarmAnalysisRequest) GetSimulated() bool {
+func (m *GetTelemetryDataRequest) GetSimulated() bool {
 	if m != nil {
 		return m.Simulated
 	}
 	return false
 }
 
-func (m *GetConstructorAlarmAnalysisRequest) GetSimulationUuid() string {
+func (m *GetTelemetryDataRequest) GetSimulationUuid() string {
 	if m != nil {
 		return m.SimulationUuid
 	}
 	return ""
 }
 
-func (m *GetConstructorAlarmAnalysisRequest) GetDateRangeBegin() *timestamp.Timestamp {
+func (m *GetTelemetryDataRequest) GetDateRangeBegin() *timestamp.Timestamp {
 	if m != nil {
 		return m.DateRangeBegin
 	}
 	return nil
 }
 
-func (m *GetConstructorAlarmAnalysisRequest) GetDateRangeEnd() *timestamp.Timestamp {
+func (m *GetTelemetryDataRequest) GetDateRangeEnd() *timestamp.Timestamp {
 	if m != nil {
 		return m.DateRangeEnd
 	}
 	return nil
 }
 
-func (m *GetConstructorAlarmAnalysisRequest) GetConstructor() Constructor {
+func (m *GetTelemetryDataRequest) GetGranPrix() GranPrix {
 	if m != nil {
-		return m.Constructor
+		return m.GranPrix
 	}
-	return Constructor_ALPHA_ROMEO
+	return GranPrix_UNITED_STATES
 }
 
-func (m *GetConstructorAlarmAnalysisRequest) GetCarNumber() int32 {
+func (m *GetTelemetryDataRequest) GetTrack() Track {
 	if m != nil {
-		return m.CarNumber
+		return m.Track
 	}
-	return 0
+	return Track_AUSTIN
 }
 
-type GetConstructorAlarmAnalysisResponse struct {
-	Details                      *ResponseDetails              `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
-	ConstructorAlarmAnalysisData *ConstructorAlarmAnalysisData `protobuf:"bytes,2,opt,name=constructor_alarm_analysis_data,json=constructorAlarmAnalysisData,proto3" json:"constructor_alarm_analysis_data,omitempty"`
-	XXX_NoUnkeyedLiteral         struct{}                      `json:"-"`
-	XXX_unrecognized             []byte                        `json:"-"`
-	XXX_sizecache                int32                         `json:"-"`
+func (m *GetTelemetryDataRequest) GetConstructor() Constructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return Constructor_ALPHA_ROMEO
 }
 
-func (m *GetConstructorAlarmAnalysisResponse) Reset()         { *m = GetConstructorAlarmAnalysisResponse{} }
-func (m *GetConstructorAlarmAnalysisResponse) String() string { return proto.CompactTextString(m) }
-func (*GetConstructorAlarmAnalysisResponse) ProtoMessage()    {}
-func (*GetConstructorAlarmAnalysisResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{22}
+func (m *GetTelemetryDataRequest) GetCarNumber() int32 {
+	if m != nil {
+		return m.CarNumber
+	}
+	return 0
 }
-func (m *GetConstructorAlarmAnalysisResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetConstructorAlarmAnalysisResponse.Unmarshal(m, b)
+
+func (m *GetTelemetryDataRequest) GetDatumDescription() TelemetryDatumDescription {
+	if m != nil {
+		return m.DatumDescription
+	}
+	return TelemetryDatumDescription_G_FORCE
 }
-func (m *GetConstructorAlarmAnalysisResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetConstructorAlarmAnalysisResponse.Marshal(b, m, deterministic)
+
+func (m *GetTelemetryDataRequest) GetSearchBy() *GetTelemetryDataRequest_SearchBy {
+	if m != nil {
+		return m.SearchBy
+	}
+	return nil
 }
-func (dst *GetConstructorAlarmAnalysisResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetConstructorAlarmAnalysisResponse.Merge(dst, src)
+
+func (m *GetTelemetryDataRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
 }
-func (m *GetConstructorAlarmAnalysisResponse) XXX_Size() int {
-	return xxx_messageInfo_GetConstructorAlarmAnalysisResponse.Size(m)
+
+func (m *GetTelemetryDataRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
 }
-func (m *GetConstructorAlarmAnalysisResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetConstructorAlarmAnalysisResponse.DiscardUnknown(m)
+
+func (m *GetTelemetryDataRequest) GetConstructors() []Constructor {
+	if m != nil {
+		return m.Constructors
+	}
+	return nil
 }
 
-var xxx_messageInfo_GetConstructorAlarmAnalysisResponse proto.InternalMessageInfo
+func (m *GetTelemetryDataRequest) GetCarNumbers() []int32 {
+	if m != nil {
+		return m.CarNumbers
+	}
+	return nil
+}
 
-func (m *GetConstructorAlarmAnalysisResponse) GetDetails() *ResponseDetails {
+func (m *GetTelemetryDataRequest) GetDatumDescriptions() []TelemetryDatumDescription {
 	if m != nil {
-		return m.Details
+		return m.DatumDescriptions
 	}
 	return nil
 }
 
-func (m *GetConstructorAlarmAnalysisResponse) GetConstructorAlarmAnalysisData() *ConstructorAlarmAnalysisData {
+func (m *GetTelemetryDataRequest) GetLap() int32 {
 	if m != nil {
-		return m.ConstructorAlarmAnalysisData
+		return m.Lap
+	}
+	return 0
+}
+
+func (m *GetTelemetryDataRequest) GetSector() int32 {
+	if m != nil {
+		return m.Sector
+	}
+	return 0
+}
+
+func (m *GetTelemetryDataRequest) GetLaps() []int32 {
+	if m != nil {
+		return m.Laps
 	}
 	return nil
 }
 
-type GetSystemStatusRequest struct {
-	ClientUuid           string   `protobuf:"bytes,1,opt,name=client_uuid,json=clientUuid,proto3" json:"client_uuid,omitempty"`
+func (m *GetTelemetryDataRequest) GetSectors() []int32 {
+	if m != nil {
+		return m.Sectors
+	}
+	return nil
+}
+
+func (m *GetTelemetryDataRequest) GetChannels() []string {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+type GetTelemetryDataRequest_SearchBy struct {
+	DateRange            bool     `protobuf:"varint,1,opt,name=date_range,json=dateRange,proto3" json:"date_range,omitempty"`
+	Constructor          bool     `protobuf:"varint,2,opt,name=constructor,proto3" json:"constructor,omitempty"`
+	CarNumber            bool     `protobuf:"varint,3,opt,name=car_number,json=carNumber,proto3" json:"car_number,omitempty"`
+	DatumDescription     bool     `protobuf:"varint,4,opt,name=datum_description,json=datumDescription,proto3" json:"datum_description,omitempty"`
+	GranPrix             bool     `protobuf:"varint,5,opt,name=gran_prix,json=granPrix,proto3" json:"gran_prix,omitempty"`
+	Track                bool     `protobuf:"varint,6,opt,name=track,proto3" json:"track,omitempty"`
+	HighAlarm            bool     `protobuf:"varint,7,opt,name=high_alarm,json=highAlarm,proto3" json:"high_alarm,omitempty"`
+	LowAlarm             bool     `protobuf:"varint,8,opt,name=low_alarm,json=lowAlarm,proto3" json:"low_alarm,omitempty"`
+	Lap                  bool     `protobuf:"varint,9,opt,name=lap,proto3" json:"lap,omitempty"`
+	Sector               bool     `protobuf:"varint,10,opt,name=sector,proto3" json:"sector,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetSystemStatusRequest) Reset()         { *m = GetSystemStatusRequest{} }
-func (m *GetSystemStatusRequest) String() string { return proto.CompactTextString(m) }
-func (*GetSystemStatusRequest) ProtoMessage()    {}
-func (*GetSystemStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{23}
+func (m *GetTelemetryDataRequest_SearchBy) Reset()         { *m = GetTelemetryDataRequest_SearchBy{} }
+func (m *GetTelemetryDataRequest_SearchBy) String() string { return proto.CompactTextString(m) }
+func (*GetTelemetryDataRequest_SearchBy) ProtoMessage()    {}
+func (*GetTelemetryDataRequest_SearchBy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{31, 0}
 }
-func (m *GetSystemStatusRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetSystemStatusRequest.Unmarshal(m, b)
+func (m *GetTelemetryDataRequest_SearchBy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTelemetryDataRequest_SearchBy.Unmarshal(m, b)
 }
-func (m *GetSystemStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetSystemStatusRequest.Marshal(b, m, deterministic)
+func (m *GetTelemetryDataRequest_SearchBy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTelemetryDataRequest_SearchBy.Marshal(b, m, deterministic)
 }
-func (dst *GetSystemStatusRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSystemStatusRequest.Merge(dst, src)
+func (dst *GetTelemetryDataRequest_SearchBy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTelemetryDataRequest_SearchBy.Merge(dst, src)
 }
-func (m *GetSystemStatusRequest) XXX_Size() int {
-	return xxx_messageInfo_GetSystemStatusRequest.Size(m)
+func (m *GetTelemetryDataRequest_SearchBy) XXX_Size() int {
+	return xxx_messageInfo_GetTelemetryDataRequest_SearchBy.Size(m)
 }
-func (m *GetSystemStatusRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSystemStatusRequest.DiscardUnknown(m)
+func (m *GetTelemetryDataRequest_SearchBy) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTelemetryDataRequest_SearchBy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetSystemStatusRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetTelemetryDataRequest_SearchBy proto.InternalMessageInfo
 
-func (m *GetSystemStatusRequest) GetClientUuid() string {
+func (m *GetTelemetryDataRequest_SearchBy) GetDateRange() bool {
 	if m != nil {
-		return m.ClientUuid
+		return m.DateRange
 	}
-	return ""
+	return false
 }
 
-type GetSystemStatusResponse struct {
-	Details              *ResponseDetails    `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
-	SystemStatusReport   *SystemStatusReport `protobuf:"bytes,2,opt,name=system_status_report,json=systemStatusReport,proto3" json:"system_status_report,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+func (m *GetTelemetryDataRequest_SearchBy) GetConstructor() bool {
+	if m != nil {
+		return m.Constructor
+	}
+	return false
 }
 
-func (m *GetSystemStatusResponse) Reset()         { *m = GetSystemStatusResponse{} }
-func (m *GetSystemStatusResponse) String() string { return proto.CompactTextString(m) }
-func (*GetSystemStatusResponse) ProtoMessage()    {}
-func (*GetSystemStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_FOTAAS_30307ce35d3090ce, []int{24}
+func (m *GetTelemetryDataRequest_SearchBy) GetCarNumber() bool {
+	if m != nil {
+		return m.CarNumber
+	}
+	return false
 }
-func (m *GetSystemStatusResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetSystemStatusResponse.Unmarshal(m, b)
+
+func (m *GetTelemetryDataRequest_SearchBy) GetDatumDescription() bool {
+	if m != nil {
+		return m.DatumDescription
+	}
+	return false
 }
-func (m *GetSystemStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetSystemStatusResponse.Marshal(b, m, deterministic)
+
+func (m *GetTelemetryDataRequest_SearchBy) GetGranPrix() bool {
+	if m != nil {
+		return m.GranPrix
+	}
+	return false
 }
-func (dst *GetSystemStatusResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSystemStatusResponse.Merge(dst, src)
+
+func (m *GetTelemetryDataRequest_SearchBy) GetTrack() bool {
+	if m != nil {
+		return m.Track
+	}
+	return false
 }
-func (m *GetSystemStatusResponse) XXX_Size() int {
-	return xxx_messageInfo_GetSystemStatusResponse.Size(m)
+
+func (m *GetTelemetryDataRequest_SearchBy) GetHighAlarm() bool {
+	if m != nil {
+		return m.HighAlarm
+	}
+	return false
 }
-func (m *GetSystemStatusResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSystemStatusResponse.DiscardUnknown(m)
+
+func (m *GetTelemetryDataRequest_SearchBy) GetLowAlarm() bool {
+	if m != nil {
+		return m.LowAlarm
+	}
+	return false
 }
 
-var xxx_messageInfo_GetSystemStatusResponse proto.InternalMessageInfo
+func (m *GetTelemetryDataRequest_SearchBy) GetLap() bool {
+	if m != nil {
+		return m.Lap
+	}
+	return false
+}
 
-func (m *GetSystemStatusResponse) GetDetails() *ResponseDetails {
+func (m *GetTelemetryDataRequest_SearchBy) GetSector() bool {
+	if m != nil {
+		return m.Sector
+	}
+	return false
+}
+
+type GetTelemetryDataResponse struct {
+	Details              *ResponseDetails `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	TelemetryData        *TelemetryData   `protobuf:"bytes,2,opt,name=telemetry_data,json=telemetryData,proto3" json:"telemetry_data,omitempty"`
+	NextPageToken        string           `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetTelemetryDataResponse) Reset()         { *m = GetTelemetryDataResponse{} }
+func (m *GetTelemetryDataResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTelemetryDataResponse) ProtoMessage()    {}
+func (*GetTelemetryDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{32}
+}
+func (m *GetTelemetryDataResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTelemetryDataResponse.Unmarshal(m, b)
+}
+func (m *GetTelemetryDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTelemetryDataResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetTelemetryDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTelemetryDataResponse.Merge(dst, src)
+}
+func (m *GetTelemetryDataResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTelemetryDataResponse.Size(m)
+}
+func (m *GetTelemetryDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTelemetryDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTelemetryDataResponse proto.InternalMessageInfo
+
+func (m *GetTelemetryDataResponse) GetDetails() *ResponseDetails {
 	if m != nil {
 		return m.Details
 	}
 	return nil
 }
 
-func (m *GetSystemStatusResponse) GetSystemStatusReport() *SystemStatusReport {
+func (m *GetTelemetryDataResponse) GetTelemetryData() *TelemetryData {
 	if m != nil {
-		return m.SystemStatusReport
+		return m.TelemetryData
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*ResponseDetails)(nil), "api.ResponseDetails")
-	proto.RegisterType((*TelemetryDatum)(nil), "api.TelemetryDatum")
-	proto.RegisterType((*TelemetryData)(nil), "api.TelemetryData")
-	proto.RegisterMapType((map[string]*TelemetryDatum)(nil), "api.TelemetryData.TelemetryDatumMapEntry")
-	proto.RegisterType((*AlarmAnalysisData)(nil), "api.AlarmAnalysisData")
-	proto.RegisterType((*AlarmAnalysisData_AlarmCountsByConstructorAndCar)(nil), "api.AlarmAnalysisData.AlarmCountsByConstructorAndCar")
-	proto.RegisterType((*ConstructorAlarmAnalysisData)(nil), "api.ConstructorAlarmAnalysisData")
-	proto.RegisterType((*ConstructorAlarmAnalysisData_AlarmCountsByDatumDescription)(nil), "api.ConstructorAlarmAnalysisData.AlarmCountsByDatumDescription")
-	proto.RegisterType((*SystemStatusReport)(nil), "api.SystemStatusReport")
-	proto.RegisterType((*SimulationMember)(nil), "api.SimulationMember")
-	proto.RegisterType((*Simulation)(nil), "api.Simulation")
-	proto.RegisterMapType((map[string]*SimulationMember)(nil), "api.Simulation.SimulationMemberMapEntry")
-	proto.RegisterType((*SimulationInfo)(nil), "api.SimulationInfo")
-	proto.RegisterType((*AlivenessCheckRequest)(nil), "api.AlivenessCheckRequest")
-	proto.RegisterType((*AlivenessCheckResponse)(nil), "api.AlivenessCheckResponse")
-	proto.RegisterType((*TransmitTelemetryRequest)(nil), "api.TransmitTelemetryRequest")
-	proto.RegisterType((*TransmitTelemetryResponse)(nil), "api.TransmitTelemetryResponse")
-	proto.RegisterMapType((map[string]*ResponseDetails)(nil), "api.TransmitTelemetryResponse.DetailsEntry")
-	proto.RegisterType((*RunSimulationRequest)(nil), "api.RunSimulationRequest")
-	proto.RegisterType((*RunSimulationResponse)(nil), "api.RunSimulationResponse")
+func (m *GetTelemetryDataResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type SubscribeTelemetryRequest struct {
+	SimulationUuid       string                      `protobuf:"bytes,1,opt,name=simulation_uuid,json=simulationUuid,proto3" json:"simulation_uuid,omitempty"`
+	Constructors         []Constructor               `protobuf:"varint,2,rep,packed,name=constructors,proto3,enum=api.Constructor" json:"constructors,omitempty"`
+	CarNumbers           []int32                     `protobuf:"varint,3,rep,packed,name=car_numbers,json=carNumbers,proto3" json:"car_numbers,omitempty"`
+	DatumDescriptions    []TelemetryDatumDescription `protobuf:"varint,4,rep,packed,name=datum_descriptions,json=datumDescriptions,proto3,enum=api.TelemetryDatumDescription" json:"datum_descriptions,omitempty"`
+	Channels             []string                    `protobuf:"bytes,5,rep,name=channels,proto3" json:"channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *SubscribeTelemetryRequest) Reset()         { *m = SubscribeTelemetryRequest{} }
+func (m *SubscribeTelemetryRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeTelemetryRequest) ProtoMessage()    {}
+func (*SubscribeTelemetryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{33}
+}
+func (m *SubscribeTelemetryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeTelemetryRequest.Unmarshal(m, b)
+}
+func (m *SubscribeTelemetryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeTelemetryRequest.Marshal(b, m, deterministic)
+}
+func (dst *SubscribeTelemetryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeTelemetryRequest.Merge(dst, src)
+}
+func (m *SubscribeTelemetryRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeTelemetryRequest.Size(m)
+}
+func (m *SubscribeTelemetryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeTelemetryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeTelemetryRequest proto.InternalMessageInfo
+
+func (m *SubscribeTelemetryRequest) GetSimulationUuid() string {
+	if m != nil {
+		return m.SimulationUuid
+	}
+	return ""
+}
+
+func (m *SubscribeTelemetryRequest) GetConstructors() []Constructor {
+	if m != nil {
+		return m.Constructors
+	}
+	return nil
+}
+
+func (m *SubscribeTelemetryRequest) GetCarNumbers() []int32 {
+	if m != nil {
+		return m.CarNumbers
+	}
+	return nil
+}
+
+func (m *SubscribeTelemetryRequest) GetDatumDescriptions() []TelemetryDatumDescription {
+	if m != nil {
+		return m.DatumDescriptions
+	}
+	return nil
+}
+
+func (m *SubscribeTelemetryRequest) GetChannels() []string {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+type SubscribeTelemetryResponse struct {
+	Details              *ResponseDetails `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	TelemetryData        *TelemetryData   `protobuf:"bytes,2,opt,name=telemetry_data,json=telemetryData,proto3" json:"telemetry_data,omitempty"`
+	DroppedDatumCount    int64            `protobuf:"varint,3,opt,name=dropped_datum_count,json=droppedDatumCount,proto3" json:"dropped_datum_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SubscribeTelemetryResponse) Reset()         { *m = SubscribeTelemetryResponse{} }
+func (m *SubscribeTelemetryResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribeTelemetryResponse) ProtoMessage()    {}
+func (*SubscribeTelemetryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{34}
+}
+func (m *SubscribeTelemetryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeTelemetryResponse.Unmarshal(m, b)
+}
+func (m *SubscribeTelemetryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeTelemetryResponse.Marshal(b, m, deterministic)
+}
+func (dst *SubscribeTelemetryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeTelemetryResponse.Merge(dst, src)
+}
+func (m *SubscribeTelemetryResponse) XXX_Size() int {
+	return xxx_messageInfo_SubscribeTelemetryResponse.Size(m)
+}
+func (m *SubscribeTelemetryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeTelemetryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeTelemetryResponse proto.InternalMessageInfo
+
+func (m *SubscribeTelemetryResponse) GetDetails() *ResponseDetails {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *SubscribeTelemetryResponse) GetTelemetryData() *TelemetryData {
+	if m != nil {
+		return m.TelemetryData
+	}
+	return nil
+}
+
+func (m *SubscribeTelemetryResponse) GetDroppedDatumCount() int64 {
+	if m != nil {
+		return m.DroppedDatumCount
+	}
+	return 0
+}
+
+type ExportTelemetryRequest struct {
+	Filter               *GetTelemetryDataRequest `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Format               ExportFormat             `protobuf:"varint,2,opt,name=format,proto3,enum=api.ExportFormat" json:"format,omitempty"`
+	Layout               ExportLayout             `protobuf:"varint,3,opt,name=layout,proto3,enum=api.ExportLayout" json:"layout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ExportTelemetryRequest) Reset()         { *m = ExportTelemetryRequest{} }
+func (m *ExportTelemetryRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportTelemetryRequest) ProtoMessage()    {}
+func (*ExportTelemetryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{35}
+}
+func (m *ExportTelemetryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTelemetryRequest.Unmarshal(m, b)
+}
+func (m *ExportTelemetryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTelemetryRequest.Marshal(b, m, deterministic)
+}
+func (dst *ExportTelemetryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTelemetryRequest.Merge(dst, src)
+}
+func (m *ExportTelemetryRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportTelemetryRequest.Size(m)
+}
+func (m *ExportTelemetryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTelemetryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTelemetryRequest proto.InternalMessageInfo
+
+func (m *ExportTelemetryRequest) GetFilter() *GetTelemetryDataRequest {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ExportTelemetryRequest) GetFormat() ExportFormat {
+	if m != nil {
+		return m.Format
+	}
+	return ExportFormat_CSV
+}
+
+func (m *ExportTelemetryRequest) GetLayout() ExportLayout {
+	if m != nil {
+		return m.Layout
+	}
+	return ExportLayout_LONG
+}
+
+type ExportTelemetryResponse struct {
+	Details              *ResponseDetails `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	Chunk                []byte           `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ExportTelemetryResponse) Reset()         { *m = ExportTelemetryResponse{} }
+func (m *ExportTelemetryResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportTelemetryResponse) ProtoMessage()    {}
+func (*ExportTelemetryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{36}
+}
+func (m *ExportTelemetryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTelemetryResponse.Unmarshal(m, b)
+}
+func (m *ExportTelemetryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTelemetryResponse.Marshal(b, m, deterministic)
+}
+func (dst *ExportTelemetryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTelemetryResponse.Merge(dst, src)
+}
+func (m *ExportTelemetryResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportTelemetryResponse.Size(m)
+}
+func (m *ExportTelemetryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTelemetryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTelemetryResponse proto.InternalMessageInfo
+
+func (m *ExportTelemetryResponse) GetDetails() *ResponseDetails {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *ExportTelemetryResponse) GetChunk() []byte {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
+}
+
+type GetIngestStatsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetIngestStatsRequest) Reset()         { *m = GetIngestStatsRequest{} }
+func (m *GetIngestStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetIngestStatsRequest) ProtoMessage()    {}
+func (*GetIngestStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{37}
+}
+func (m *GetIngestStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIngestStatsRequest.Unmarshal(m, b)
+}
+func (m *GetIngestStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIngestStatsRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetIngestStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIngestStatsRequest.Merge(dst, src)
+}
+func (m *GetIngestStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetIngestStatsRequest.Size(m)
+}
+func (m *GetIngestStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIngestStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetIngestStatsRequest proto.InternalMessageInfo
+
+type IngestStats struct {
+	// Telemetry datum queued or being written.
+	QueueDepth        int32 `protobuf:"varint,1,opt,name=queue_depth,json=queueDepth,proto3" json:"queue_depth,omitempty"`
+	QueueCapacity     int32 `protobuf:"varint,2,opt,name=queue_capacity,json=queueCapacity,proto3" json:"queue_capacity,omitempty"`
+	FlushWorkers      int32 `protobuf:"varint,3,opt,name=flush_workers,json=flushWorkers,proto3" json:"flush_workers,omitempty"`
+	FlushCount        int64 `protobuf:"varint,4,opt,name=flush_count,json=flushCount,proto3" json:"flush_count,omitempty"`
+	FlushedDatumCount int64 `protobuf:"varint,5,opt,name=flushed_datum_count,json=flushedDatumCount,proto3" json:"flushed_datum_count,omitempty"`
+	FailedDatumCount  int64 `protobuf:"varint,6,opt,name=failed_datum_count,json=failedDatumCount,proto3" json:"failed_datum_count,omitempty"`
+	// Telemetry datum refused with BACKPRESSURE.
+	RejectedDatumCount   int64    `protobuf:"varint,7,opt,name=rejected_datum_count,json=rejectedDatumCount,proto3" json:"rejected_datum_count,omitempty"`
+	LastFlushLatencyMs   float64  `protobuf:"fixed64,8,opt,name=last_flush_latency_ms,json=lastFlushLatencyMs,proto3" json:"last_flush_latency_ms,omitempty"`
+	MeanFlushLatencyMs   float64  `protobuf:"fixed64,9,opt,name=mean_flush_latency_ms,json=meanFlushLatencyMs,proto3" json:"mean_flush_latency_ms,omitempty"`
+	MaxFlushLatencyMs    float64  `protobuf:"fixed64,10,opt,name=max_flush_latency_ms,json=maxFlushLatencyMs,proto3" json:"max_flush_latency_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IngestStats) Reset()         { *m = IngestStats{} }
+func (m *IngestStats) String() string { return proto.CompactTextString(m) }
+func (*IngestStats) ProtoMessage()    {}
+func (*IngestStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{38}
+}
+func (m *IngestStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IngestStats.Unmarshal(m, b)
+}
+func (m *IngestStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IngestStats.Marshal(b, m, deterministic)
+}
+func (dst *IngestStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IngestStats.Merge(dst, src)
+}
+func (m *IngestStats) XXX_Size() int {
+	return xxx_messageInfo_IngestStats.Size(m)
+}
+func (m *IngestStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_IngestStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IngestStats proto.InternalMessageInfo
+
+func (m *IngestStats) GetQueueDepth() int32 {
+	if m != nil {
+		return m.QueueDepth
+	}
+	return 0
+}
+
+func (m *IngestStats) GetQueueCapacity() int32 {
+	if m != nil {
+		return m.QueueCapacity
+	}
+	return 0
+}
+
+func (m *IngestStats) GetFlushWorkers() int32 {
+	if m != nil {
+		return m.FlushWorkers
+	}
+	return 0
+}
+
+func (m *IngestStats) GetFlushCount() int64 {
+	if m != nil {
+		return m.FlushCount
+	}
+	return 0
+}
+
+func (m *IngestStats) GetFlushedDatumCount() int64 {
+	if m != nil {
+		return m.FlushedDatumCount
+	}
+	return 0
+}
+
+func (m *IngestStats) GetFailedDatumCount() int64 {
+	if m != nil {
+		return m.FailedDatumCount
+	}
+	return 0
+}
+
+func (m *IngestStats) GetRejectedDatumCount() int64 {
+	if m != nil {
+		return m.RejectedDatumCount
+	}
+	return 0
+}
+
+func (m *IngestStats) GetLastFlushLatencyMs() float64 {
+	if m != nil {
+		return m.LastFlushLatencyMs
+	}
+	return 0
+}
+
+func (m *IngestStats) GetMeanFlushLatencyMs() float64 {
+	if m != nil {
+		return m.MeanFlushLatencyMs
+	}
+	return 0
+}
+
+func (m *IngestStats) GetMaxFlushLatencyMs() float64 {
+	if m != nil {
+		return m.MaxFlushLatencyMs
+	}
+	return 0
+}
+
+type GetIngestStatsResponse struct {
+	Details              *ResponseDetails `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	IngestStats          *IngestStats     `protobuf:"bytes,2,opt,name=ingest_stats,json=ingestStats,proto3" json:"ingest_stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetIngestStatsResponse) Reset()         { *m = GetIngestStatsResponse{} }
+func (m *GetIngestStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetIngestStatsResponse) ProtoMessage()    {}
+func (*GetIngestStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{39}
+}
+func (m *GetIngestStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIngestStatsResponse.Unmarshal(m, b)
+}
+func (m *GetIngestStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIngestStatsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetIngestStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIngestStatsResponse.Merge(dst, src)
+}
+func (m *GetIngestStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetIngestStatsResponse.Size(m)
+}
+func (m *GetIngestStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIngestStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetIngestStatsResponse proto.InternalMessageInfo
+
+func (m *GetIngestStatsResponse) GetDetails() *ResponseDetails {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *GetIngestStatsResponse) GetIngestStats() *IngestStats {
+	if m != nil {
+		return m.IngestStats
+	}
+	return nil
+}
+
+type PurgeTelemetryRequest struct {
+	DryRun               bool     `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurgeTelemetryRequest) Reset()         { *m = PurgeTelemetryRequest{} }
+func (m *PurgeTelemetryRequest) String() string { return proto.CompactTextString(m) }
+func (*PurgeTelemetryRequest) ProtoMessage()    {}
+func (*PurgeTelemetryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{40}
+}
+func (m *PurgeTelemetryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurgeTelemetryRequest.Unmarshal(m, b)
+}
+func (m *PurgeTelemetryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurgeTelemetryRequest.Marshal(b, m, deterministic)
+}
+func (dst *PurgeTelemetryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeTelemetryRequest.Merge(dst, src)
+}
+func (m *PurgeTelemetryRequest) XXX_Size() int {
+	return xxx_messageInfo_PurgeTelemetryRequest.Size(m)
+}
+func (m *PurgeTelemetryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeTelemetryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgeTelemetryRequest proto.InternalMessageInfo
+
+func (m *PurgeTelemetryRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type PurgeTelemetryResponse struct {
+	Details              *ResponseDetails `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	DryRun               bool             `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	SimulatedDatumCount  int64            `protobuf:"varint,3,opt,name=simulated_datum_count,json=simulatedDatumCount,proto3" json:"simulated_datum_count,omitempty"`
+	RealDatumCount       int64            `protobuf:"varint,4,opt,name=real_datum_count,json=realDatumCount,proto3" json:"real_datum_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *PurgeTelemetryResponse) Reset()         { *m = PurgeTelemetryResponse{} }
+func (m *PurgeTelemetryResponse) String() string { return proto.CompactTextString(m) }
+func (*PurgeTelemetryResponse) ProtoMessage()    {}
+func (*PurgeTelemetryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{41}
+}
+func (m *PurgeTelemetryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurgeTelemetryResponse.Unmarshal(m, b)
+}
+func (m *PurgeTelemetryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurgeTelemetryResponse.Marshal(b, m, deterministic)
+}
+func (dst *PurgeTelemetryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeTelemetryResponse.Merge(dst, src)
+}
+func (m *PurgeTelemetryResponse) XXX_Size() int {
+	return xxx_messageInfo_PurgeTelemetryResponse.Size(m)
+}
+func (m *PurgeTelemetryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeTelemetryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgeTelemetryResponse proto.InternalMessageInfo
+
+func (m *PurgeTelemetryResponse) GetDetails() *ResponseDetails {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *PurgeTelemetryResponse) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *PurgeTelemetryResponse) GetSimulatedDatumCount() int64 {
+	if m != nil {
+		return m.SimulatedDatumCount
+	}
+	return 0
+}
+
+func (m *PurgeTelemetryResponse) GetRealDatumCount() int64 {
+	if m != nil {
+		return m.RealDatumCount
+	}
+	return 0
+}
+
+type GetTelemetryAggregatesRequest struct {
+	Filter               *GetTelemetryDataRequest `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	BucketWidthMillis    int64                    `protobuf:"varint,2,opt,name=bucket_width_millis,json=bucketWidthMillis,proto3" json:"bucket_width_millis,omitempty"`
+	AggregateFunctions   []AggregateFunction      `protobuf:"varint,3,rep,packed,name=aggregate_functions,json=aggregateFunctions,proto3,enum=api.AggregateFunction" json:"aggregate_functions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetTelemetryAggregatesRequest) Reset()         { *m = GetTelemetryAggregatesRequest{} }
+func (m *GetTelemetryAggregatesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTelemetryAggregatesRequest) ProtoMessage()    {}
+func (*GetTelemetryAggregatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{42}
+}
+func (m *GetTelemetryAggregatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTelemetryAggregatesRequest.Unmarshal(m, b)
+}
+func (m *GetTelemetryAggregatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTelemetryAggregatesRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetTelemetryAggregatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTelemetryAggregatesRequest.Merge(dst, src)
+}
+func (m *GetTelemetryAggregatesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTelemetryAggregatesRequest.Size(m)
+}
+func (m *GetTelemetryAggregatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTelemetryAggregatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTelemetryAggregatesRequest proto.InternalMessageInfo
+
+func (m *GetTelemetryAggregatesRequest) GetFilter() *GetTelemetryDataRequest {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *GetTelemetryAggregatesRequest) GetBucketWidthMillis() int64 {
+	if m != nil {
+		return m.BucketWidthMillis
+	}
+	return 0
+}
+
+func (m *GetTelemetryAggregatesRequest) GetAggregateFunctions() []AggregateFunction {
+	if m != nil {
+		return m.AggregateFunctions
+	}
+	return nil
+}
+
+type TelemetryAggregateBucket struct {
+	BucketBegin          *timestamp.Timestamp `protobuf:"bytes,1,opt,name=bucket_begin,json=bucketBegin,proto3" json:"bucket_begin,omitempty"`
+	Count                int64                `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Min                  float64              `protobuf:"fixed64,3,opt,name=min,proto3" json:"min,omitempty"`
+	Max                  float64              `protobuf:"fixed64,4,opt,name=max,proto3" json:"max,omitempty"`
+	Mean                 float64              `protobuf:"fixed64,5,opt,name=mean,proto3" json:"mean,omitempty"`
+	Last                 float64              `protobuf:"fixed64,6,opt,name=last,proto3" json:"last,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *TelemetryAggregateBucket) Reset()         { *m = TelemetryAggregateBucket{} }
+func (m *TelemetryAggregateBucket) String() string { return proto.CompactTextString(m) }
+func (*TelemetryAggregateBucket) ProtoMessage()    {}
+func (*TelemetryAggregateBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{43}
+}
+func (m *TelemetryAggregateBucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TelemetryAggregateBucket.Unmarshal(m, b)
+}
+func (m *TelemetryAggregateBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TelemetryAggregateBucket.Marshal(b, m, deterministic)
+}
+func (dst *TelemetryAggregateBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TelemetryAggregateBucket.Merge(dst, src)
+}
+func (m *TelemetryAggregateBucket) XXX_Size() int {
+	return xxx_messageInfo_TelemetryAggregateBucket.Size(m)
+}
+func (m *TelemetryAggregateBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_TelemetryAggregateBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TelemetryAggregateBucket proto.InternalMessageInfo
+
+func (m *TelemetryAggregateBucket) GetBucketBegin() *timestamp.Timestamp {
+	if m != nil {
+		return m.BucketBegin
+	}
+	return nil
+}
+
+func (m *TelemetryAggregateBucket) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *TelemetryAggregateBucket) GetMin() float64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *TelemetryAggregateBucket) GetMax() float64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *TelemetryAggregateBucket) GetMean() float64 {
+	if m != nil {
+		return m.Mean
+	}
+	return 0
+}
+
+func (m *TelemetryAggregateBucket) GetLast() float64 {
+	if m != nil {
+		return m.Last
+	}
+	return 0
+}
+
+type TelemetryAggregateSeries struct {
+	Constructor      Constructor                 `protobuf:"varint,1,opt,name=constructor,proto3,enum=api.Constructor" json:"constructor,omitempty"`
+	CarNumber        int32                       `protobuf:"varint,2,opt,name=car_number,json=carNumber,proto3" json:"car_number,omitempty"`
+	DatumDescription TelemetryDatumDescription   `protobuf:"varint,3,opt,name=datum_description,json=datumDescription,proto3,enum=api.TelemetryDatumDescription" json:"datum_description,omitempty"`
+	Unit             TelemetryDatumUnit          `protobuf:"varint,4,opt,name=unit,proto3,enum=api.TelemetryDatumUnit" json:"unit,omitempty"`
+	Buckets          []*TelemetryAggregateBucket `protobuf:"bytes,5,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	// channel and channel_unit are set for the series of a channel registry channel.
+	Channel              string   `protobuf:"bytes,6,opt,name=channel,proto3" json:"channel,omitempty"`
+	ChannelUnit          string   `protobuf:"bytes,7,opt,name=channel_unit,json=channelUnit,proto3" json:"channel_unit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TelemetryAggregateSeries) Reset()         { *m = TelemetryAggregateSeries{} }
+func (m *TelemetryAggregateSeries) String() string { return proto.CompactTextString(m) }
+func (*TelemetryAggregateSeries) ProtoMessage()    {}
+func (*TelemetryAggregateSeries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{44}
+}
+func (m *TelemetryAggregateSeries) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TelemetryAggregateSeries.Unmarshal(m, b)
+}
+func (m *TelemetryAggregateSeries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TelemetryAggregateSeries.Marshal(b, m, deterministic)
+}
+func (dst *TelemetryAggregateSeries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TelemetryAggregateSeries.Merge(dst, src)
+}
+func (m *TelemetryAggregateSeries) XXX_Size() int {
+	return xxx_messageInfo_TelemetryAggregateSeries.Size(m)
+}
+func (m *TelemetryAggregateSeries) XXX_DiscardUnknown() {
+	xxx_messageInfo_TelemetryAggregateSeries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TelemetryAggregateSeries proto.InternalMessageInfo
+
+func (m *TelemetryAggregateSeries) GetConstructor() Constructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return Constructor_ALPHA_ROMEO
+}
+
+func (m *TelemetryAggregateSeries) GetCarNumber() int32 {
+	if m != nil {
+		return m.CarNumber
+	}
+	return 0
+}
+
+func (m *TelemetryAggregateSeries) GetDatumDescription() TelemetryDatumDescription {
+	if m != nil {
+		return m.DatumDescription
+	}
+	return TelemetryDatumDescription_G_FORCE
+}
+
+func (m *TelemetryAggregateSeries) GetUnit() TelemetryDatumUnit {
+	if m != nil {
+		return m.Unit
+	}
+	return TelemetryDatumUnit_G
+}
+
+func (m *TelemetryAggregateSeries) GetBuckets() []*TelemetryAggregateBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+func (m *TelemetryAggregateSeries) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *TelemetryAggregateSeries) GetChannelUnit() string {
+	if m != nil {
+		return m.ChannelUnit
+	}
+	return ""
+}
+
+type GetTelemetryAggregatesResponse struct {
+	Details              *ResponseDetails            `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	Series               []*TelemetryAggregateSeries `protobuf:"bytes,2,rep,name=series,proto3" json:"series,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *GetTelemetryAggregatesResponse) Reset()         { *m = GetTelemetryAggregatesResponse{} }
+func (m *GetTelemetryAggregatesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTelemetryAggregatesResponse) ProtoMessage()    {}
+func (*GetTelemetryAggregatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{45}
+}
+func (m *GetTelemetryAggregatesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTelemetryAggregatesResponse.Unmarshal(m, b)
+}
+func (m *GetTelemetryAggregatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTelemetryAggregatesResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetTelemetryAggregatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTelemetryAggregatesResponse.Merge(dst, src)
+}
+func (m *GetTelemetryAggregatesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTelemetryAggregatesResponse.Size(m)
+}
+func (m *GetTelemetryAggregatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTelemetryAggregatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTelemetryAggregatesResponse proto.InternalMessageInfo
+
+func (m *GetTelemetryAggregatesResponse) GetDetails() *ResponseDetails {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *GetTelemetryAggregatesResponse) GetSeries() []*TelemetryAggregateSeries {
+	if m != nil {
+		return m.Series
+	}
+	return nil
+}
+
+type CreateAlarmThresholdRequest struct {
+	AlarmThreshold       *AlarmThreshold `protobuf:"bytes,1,opt,name=alarm_threshold,json=alarmThreshold,proto3" json:"alarm_threshold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CreateAlarmThresholdRequest) Reset()         { *m = CreateAlarmThresholdRequest{} }
+func (m *CreateAlarmThresholdRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateAlarmThresholdRequest) ProtoMessage()    {}
+func (*CreateAlarmThresholdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{46}
+}
+func (m *CreateAlarmThresholdRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAlarmThresholdRequest.Unmarshal(m, b)
+}
+func (m *CreateAlarmThresholdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAlarmThresholdRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateAlarmThresholdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAlarmThresholdRequest.Merge(dst, src)
+}
+func (m *CreateAlarmThresholdRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateAlarmThresholdRequest.Size(m)
+}
+func (m *CreateAlarmThresholdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAlarmThresholdRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAlarmThresholdRequest proto.InternalMessageInfo
+
+func (m *CreateAlarmThresholdRequest) GetAlarmThreshold() *AlarmThreshold {
+	if m != nil {
+		return m.AlarmThreshold
+	}
+	return nil
+}
+
+type CreateAlarmThresholdResponse struct {
+	Details              *ResponseDetails `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	AlarmThreshold       *AlarmThreshold  `protobuf:"bytes,2,opt,name=alarm_threshold,json=alarmThreshold,proto3" json:"alarm_threshold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CreateAlarmThresholdResponse) Reset()         { *m = CreateAlarmThresholdResponse{} }
+func (m *CreateAlarmThresholdResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateAlarmThresholdResponse) ProtoMessage()    {}
+func (*CreateAlarmThresholdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{47}
+}
+func (m *CreateAlarmThresholdResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAlarmThresholdResponse.Unmarshal(m, b)
+}
+func (m *CreateAlarmThresholdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAlarmThresholdResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateAlarmThresholdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAlarmThresholdResponse.Merge(dst, src)
+}
+func (m *CreateAlarmThresholdResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateAlarmThresholdResponse.Size(m)
+}
+func (m *CreateAlarmThresholdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAlarmThresholdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAlarmThresholdResponse proto.InternalMessageInfo
+
+func (m *CreateAlarmThresholdResponse) GetDetails() *ResponseDetails {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *CreateAlarmThresholdResponse) GetAlarmThreshold() *AlarmThreshold {
+	if m != nil {
+		return m.AlarmThreshold
+	}
+	return nil
+}
+
+type GetAlarmThresholdRequest struct {
+	Uuid                 string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAlarmThresholdRequest) Reset()         { *m = GetAlarmThresholdRequest{} }
+func (m *GetAlarmThresholdRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAlarmThresholdRequest) ProtoMessage()    {}
+func (*GetAlarmThresholdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{48}
+}
+func (m *GetAlarmThresholdRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAlarmThresholdRequest.Unmarshal(m, b)
+}
+func (m *GetAlarmThresholdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAlarmThresholdRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetAlarmThresholdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAlarmThresholdRequest.Merge(dst, src)
+}
+func (m *GetAlarmThresholdRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAlarmThresholdRequest.Size(m)
+}
+func (m *GetAlarmThresholdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAlarmThresholdRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAlarmThresholdRequest proto.InternalMessageInfo
+
+func (m *GetAlarmThresholdRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+type GetAlarmThresholdResponse struct {
+	Details              *ResponseDetails `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	AlarmThreshold       *AlarmThreshold  `protobuf:"bytes,2,opt,name=alarm_threshold,json=alarmThreshold,proto3" json:"alarm_threshold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetAlarmThresholdResponse) Reset()         { *m = GetAlarmThresholdResponse{} }
+func (m *GetAlarmThresholdResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAlarmThresholdResponse) ProtoMessage()    {}
+func (*GetAlarmThresholdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{49}
+}
+func (m *GetAlarmThresholdResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAlarmThresholdResponse.Unmarshal(m, b)
+}
+func (m *GetAlarmThresholdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAlarmThresholdResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetAlarmThresholdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAlarmThresholdResponse.Merge(dst, src)
+}
+func (m *GetAlarmThresholdResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAlarmThresholdResponse.Size(m)
+}
+func (m *GetAlarmThresholdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAlarmThresholdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAlarmThresholdResponse proto.InternalMessageInfo
+
+func (m *GetAlarmThresholdResponse) GetDetails() *ResponseDetails {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *GetAlarmThresholdResponse) GetAlarmThreshold() *AlarmThreshold {
+	if m != nil {
+		return m.AlarmThreshold
+	}
+	return nil
+}
+
+type ListAlarmThresholdsRequest struct {
+	DatumDescriptions    []TelemetryDatumDescription `protobuf:"varint,1,rep,packed,name=datum_descriptions,json=datumDescriptions,proto3,enum=api.TelemetryDatumDescription" json:"datum_descriptions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *ListAlarmThresholdsRequest) Reset()         { *m = ListAlarmThresholdsRequest{} }
+func (m *ListAlarmThresholdsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAlarmThresholdsRequest) ProtoMessage()    {}
+func (*ListAlarmThresholdsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{50}
+}
+func (m *ListAlarmThresholdsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAlarmThresholdsRequest.Unmarshal(m, b)
+}
+func (m *ListAlarmThresholdsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAlarmThresholdsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListAlarmThresholdsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAlarmThresholdsRequest.Merge(dst, src)
+}
+func (m *ListAlarmThresholdsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAlarmThresholdsRequest.Size(m)
+}
+func (m *ListAlarmThresholdsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAlarmThresholdsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAlarmThresholdsRequest proto.InternalMessageInfo
+
+func (m *ListAlarmThresholdsRequest) GetDatumDescriptions() []TelemetryDatumDescription {
+	if m != nil {
+		return m.DatumDescriptions
+	}
+	return nil
+}
+
+type ListAlarmThresholdsResponse struct {
+	Details              *ResponseDetails  `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	AlarmThresholds      []*AlarmThreshold `protobuf:"bytes,2,rep,name=alarm_thresholds,json=alarmThresholds,proto3" json:"alarm_thresholds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListAlarmThresholdsResponse) Reset()         { *m = ListAlarmThresholdsResponse{} }
+func (m *ListAlarmThresholdsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAlarmThresholdsResponse) ProtoMessage()    {}
+func (*ListAlarmThresholdsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{51}
+}
+func (m *ListAlarmThresholdsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAlarmThresholdsResponse.Unmarshal(m, b)
+}
+func (m *ListAlarmThresholdsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAlarmThresholdsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListAlarmThresholdsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAlarmThresholdsResponse.Merge(dst, src)
+}
+func (m *ListAlarmThresholdsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAlarmThresholdsResponse.Size(m)
+}
+func (m *ListAlarmThresholdsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAlarmThresholdsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAlarmThresholdsResponse proto.InternalMessageInfo
+
+func (m *ListAlarmThresholdsResponse) GetDetails() *ResponseDetails {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *ListAlarmThresholdsResponse) GetAlarmThresholds() []*AlarmThreshold {
+	if m != nil {
+		return m.AlarmThresholds
+	}
+	return nil
+}
+
+type UpdateAlarmThresholdRequest struct {
+	AlarmThreshold       *AlarmThreshold `protobuf:"bytes,1,opt,name=alarm_threshold,json=alarmThreshold,proto3" json:"alarm_threshold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *UpdateAlarmThresholdRequest) Reset()         { *m = UpdateAlarmThresholdRequest{} }
+func (m *UpdateAlarmThresholdRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateAlarmThresholdRequest) ProtoMessage()    {}
+func (*UpdateAlarmThresholdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{52}
+}
+func (m *UpdateAlarmThresholdRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateAlarmThresholdRequest.Unmarshal(m, b)
+}
+func (m *UpdateAlarmThresholdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateAlarmThresholdRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateAlarmThresholdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateAlarmThresholdRequest.Merge(dst, src)
+}
+func (m *UpdateAlarmThresholdRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateAlarmThresholdRequest.Size(m)
+}
+func (m *UpdateAlarmThresholdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateAlarmThresholdRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateAlarmThresholdRequest proto.InternalMessageInfo
+
+func (m *UpdateAlarmThresholdRequest) GetAlarmThreshold() *AlarmThreshold {
+	if m != nil {
+		return m.AlarmThreshold
+	}
+	return nil
+}
+
+type UpdateAlarmThresholdResponse struct {
+	Details              *ResponseDetails `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	AlarmThreshold       *AlarmThreshold  `protobuf:"bytes,2,opt,name=alarm_threshold,json=alarmThreshold,proto3" json:"alarm_threshold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *UpdateAlarmThresholdResponse) Reset()         { *m = UpdateAlarmThresholdResponse{} }
+func (m *UpdateAlarmThresholdResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateAlarmThresholdResponse) ProtoMessage()    {}
+func (*UpdateAlarmThresholdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{53}
+}
+func (m *UpdateAlarmThresholdResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateAlarmThresholdResponse.Unmarshal(m, b)
+}
+func (m *UpdateAlarmThresholdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateAlarmThresholdResponse.Marshal(b, m, deterministic)
+}
+func (dst *UpdateAlarmThresholdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateAlarmThresholdResponse.Merge(dst, src)
+}
+func (m *UpdateAlarmThresholdResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateAlarmThresholdResponse.Size(m)
+}
+func (m *UpdateAlarmThresholdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateAlarmThresholdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateAlarmThresholdResponse proto.InternalMessageInfo
+
+func (m *UpdateAlarmThresholdResponse) GetDetails() *ResponseDetails {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *UpdateAlarmThresholdResponse) GetAlarmThreshold() *AlarmThreshold {
+	if m != nil {
+		return m.AlarmThreshold
+	}
+	return nil
+}
+
+type DeleteAlarmThresholdRequest struct {
+	Uuid                 string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteAlarmThresholdRequest) Reset()         { *m = DeleteAlarmThresholdRequest{} }
+func (m *DeleteAlarmThresholdRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteAlarmThresholdRequest) ProtoMessage()    {}
+func (*DeleteAlarmThresholdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{54}
+}
+func (m *DeleteAlarmThresholdRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAlarmThresholdRequest.Unmarshal(m, b)
+}
+func (m *DeleteAlarmThresholdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAlarmThresholdRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteAlarmThresholdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAlarmThresholdRequest.Merge(dst, src)
+}
+func (m *DeleteAlarmThresholdRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteAlarmThresholdRequest.Size(m)
+}
+func (m *DeleteAlarmThresholdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAlarmThresholdRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteAlarmThresholdRequest proto.InternalMessageInfo
+
+func (m *DeleteAlarmThresholdRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+type DeleteAlarmThresholdResponse struct {
+	Details              *ResponseDetails `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *DeleteAlarmThresholdResponse) Reset()         { *m = DeleteAlarmThresholdResponse{} }
+func (m *DeleteAlarmThresholdResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteAlarmThresholdResponse) ProtoMessage()    {}
+func (*DeleteAlarmThresholdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{55}
+}
+func (m *DeleteAlarmThresholdResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAlarmThresholdResponse.Unmarshal(m, b)
+}
+func (m *DeleteAlarmThresholdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAlarmThresholdResponse.Marshal(b, m, deterministic)
+}
+func (dst *DeleteAlarmThresholdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAlarmThresholdResponse.Merge(dst, src)
+}
+func (m *DeleteAlarmThresholdResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteAlarmThresholdResponse.Size(m)
+}
+func (m *DeleteAlarmThresholdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAlarmThresholdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteAlarmThresholdResponse proto.InternalMessageInfo
+
+func (m *DeleteAlarmThresholdResponse) GetDetails() *ResponseDetails {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+type RegisterChannelRequest struct {
+	Channel              *Channel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterChannelRequest) Reset()         { *m = RegisterChannelRequest{} }
+func (m *RegisterChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterChannelRequest) ProtoMessage()    {}
+func (*RegisterChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{56}
+}
+func (m *RegisterChannelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterChannelRequest.Unmarshal(m, b)
+}
+func (m *RegisterChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterChannelRequest.Marshal(b, m, deterministic)
+}
+func (dst *RegisterChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterChannelRequest.Merge(dst, src)
+}
+func (m *RegisterChannelRequest) XXX_Size() int {
+	return xxx_messageInfo_RegisterChannelRequest.Size(m)
+}
+func (m *RegisterChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterChannelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterChannelRequest proto.InternalMessageInfo
+
+func (m *RegisterChannelRequest) GetChannel() *Channel {
+	if m != nil {
+		return m.Channel
+	}
+	return nil
+}
+
+type RegisterChannelResponse struct {
+	Details              *ResponseDetails `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	Channel              *Channel         `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *RegisterChannelResponse) Reset()         { *m = RegisterChannelResponse{} }
+func (m *RegisterChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisterChannelResponse) ProtoMessage()    {}
+func (*RegisterChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{57}
+}
+func (m *RegisterChannelResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterChannelResponse.Unmarshal(m, b)
+}
+func (m *RegisterChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterChannelResponse.Marshal(b, m, deterministic)
+}
+func (dst *RegisterChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterChannelResponse.Merge(dst, src)
+}
+func (m *RegisterChannelResponse) XXX_Size() int {
+	return xxx_messageInfo_RegisterChannelResponse.Size(m)
+}
+func (m *RegisterChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterChannelResponse proto.InternalMessageInfo
+
+func (m *RegisterChannelResponse) GetDetails() *ResponseDetails {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *RegisterChannelResponse) GetChannel() *Channel {
+	if m != nil {
+		return m.Channel
+	}
+	return nil
+}
+
+type ListChannelsRequest struct {
+	Categories           []ChannelCategory `protobuf:"varint,1,rep,packed,name=categories,proto3,enum=api.ChannelCategory" json:"categories,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListChannelsRequest) Reset()         { *m = ListChannelsRequest{} }
+func (m *ListChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListChannelsRequest) ProtoMessage()    {}
+func (*ListChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{58}
+}
+func (m *ListChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListChannelsRequest.Unmarshal(m, b)
+}
+func (m *ListChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListChannelsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListChannelsRequest.Merge(dst, src)
+}
+func (m *ListChannelsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListChannelsRequest.Size(m)
+}
+func (m *ListChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListChannelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListChannelsRequest proto.InternalMessageInfo
+
+func (m *ListChannelsRequest) GetCategories() []ChannelCategory {
+	if m != nil {
+		return m.Categories
+	}
+	return nil
+}
+
+type ListChannelsResponse struct {
+	Details              *ResponseDetails `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	Channels             []*Channel       `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListChannelsResponse) Reset()         { *m = ListChannelsResponse{} }
+func (m *ListChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListChannelsResponse) ProtoMessage()    {}
+func (*ListChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{59}
+}
+func (m *ListChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListChannelsResponse.Unmarshal(m, b)
+}
+func (m *ListChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListChannelsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListChannelsResponse.Merge(dst, src)
+}
+func (m *ListChannelsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListChannelsResponse.Size(m)
+}
+func (m *ListChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListChannelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListChannelsResponse proto.InternalMessageInfo
+
+func (m *ListChannelsResponse) GetDetails() *ResponseDetails {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *ListChannelsResponse) GetChannels() []*Channel {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+// CarTransmitProgress is the last simulation_transmit_sequence_number up to which every sequence
+// number of a car of a simulation is stored.
+type CarTransmitProgress struct {
+	Constructor                Constructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=api.Constructor" json:"constructor,omitempty"`
+	CarNumber                  int32       `protobuf:"varint,2,opt,name=car_number,json=carNumber,proto3" json:"car_number,omitempty"`
+	LastTransmitSequenceNumber int32       `protobuf:"varint,3,opt,name=last_transmit_sequence_number,json=lastTransmitSequenceNumber,proto3" json:"last_transmit_sequence_number,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{}    `json:"-"`
+	XXX_unrecognized           []byte      `json:"-"`
+	XXX_sizecache              int32       `json:"-"`
+}
+
+func (m *CarTransmitProgress) Reset()         { *m = CarTransmitProgress{} }
+func (m *CarTransmitProgress) String() string { return proto.CompactTextString(m) }
+func (*CarTransmitProgress) ProtoMessage()    {}
+func (*CarTransmitProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{60}
+}
+func (m *CarTransmitProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CarTransmitProgress.Unmarshal(m, b)
+}
+func (m *CarTransmitProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CarTransmitProgress.Marshal(b, m, deterministic)
+}
+func (dst *CarTransmitProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CarTransmitProgress.Merge(dst, src)
+}
+func (m *CarTransmitProgress) XXX_Size() int {
+	return xxx_messageInfo_CarTransmitProgress.Size(m)
+}
+func (m *CarTransmitProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_CarTransmitProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CarTransmitProgress proto.InternalMessageInfo
+
+func (m *CarTransmitProgress) GetConstructor() Constructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return Constructor_ALPHA_ROMEO
+}
+
+func (m *CarTransmitProgress) GetCarNumber() int32 {
+	if m != nil {
+		return m.CarNumber
+	}
+	return 0
+}
+
+func (m *CarTransmitProgress) GetLastTransmitSequenceNumber() int32 {
+	if m != nil {
+		return m.LastTransmitSequenceNumber
+	}
+	return 0
+}
+
+type GetTransmitProgressRequest struct {
+	SimulationUuid       string   `protobuf:"bytes,1,opt,name=simulation_uuid,json=simulationUuid,proto3" json:"simulation_uuid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTransmitProgressRequest) Reset()         { *m = GetTransmitProgressRequest{} }
+func (m *GetTransmitProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTransmitProgressRequest) ProtoMessage()    {}
+func (*GetTransmitProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{61}
+}
+func (m *GetTransmitProgressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTransmitProgressRequest.Unmarshal(m, b)
+}
+func (m *GetTransmitProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTransmitProgressRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetTransmitProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTransmitProgressRequest.Merge(dst, src)
+}
+func (m *GetTransmitProgressRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTransmitProgressRequest.Size(m)
+}
+func (m *GetTransmitProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTransmitProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTransmitProgressRequest proto.InternalMessageInfo
+
+func (m *GetTransmitProgressRequest) GetSimulationUuid() string {
+	if m != nil {
+		return m.SimulationUuid
+	}
+	return ""
+}
+
+// cars has no entry for a car whose sequence number 0 is not stored.
+type GetTransmitProgressResponse struct {
+	Details              *ResponseDetails       `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	Cars                 []*CarTransmitProgress `protobuf:"bytes,2,rep,name=cars,proto3" json:"cars,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GetTransmitProgressResponse) Reset()         { *m = GetTransmitProgressResponse{} }
+func (m *GetTransmitProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTransmitProgressResponse) ProtoMessage()    {}
+func (*GetTransmitProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{62}
+}
+func (m *GetTransmitProgressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTransmitProgressResponse.Unmarshal(m, b)
+}
+func (m *GetTransmitProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTransmitProgressResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetTransmitProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTransmitProgressResponse.Merge(dst, src)
+}
+func (m *GetTransmitProgressResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTransmitProgressResponse.Size(m)
+}
+func (m *GetTransmitProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTransmitProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTransmitProgressResponse proto.InternalMessageInfo
+
+func (m *GetTransmitProgressResponse) GetDetails() *ResponseDetails {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *GetTransmitProgressResponse) GetCars() []*CarTransmitProgress {
+	if m != nil {
+		return m.Cars
+	}
+	return nil
+}
+
+type GetAlarmAnalysisRequest struct {
+	Simulated            bool                 `protobuf:"varint,1,opt,name=simulated,proto3" json:"simulated,omitempty"`
+	SimulationUuid       string               `protobuf:"bytes,2,opt,name=simulation_uuid,json=simulationUuid,proto3" json:"simulation_uuid,omitempty"`
+	DateRangeBegin       *timestamp.Timestamp `protobuf:"bytes,3,opt,name=date_range_begin,json=dateRangeBegin,proto3" json:"date_range_begin,omitempty"`
+	DateRangeEnd         *timestamp.Timestamp `protobuf:"bytes,4,opt,name=date_range_end,json=dateRangeEnd,proto3" json:"date_range_end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetAlarmAnalysisRequest) Reset()         { *m = GetAlarmAnalysisRequest{} }
+func (m *GetAlarmAnalysisRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAlarmAnalysisRequest) ProtoMessage()    {}
+func (*GetAlarmAnalysisRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{63}
+}
+func (m *GetAlarmAnalysisRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAlarmAnalysisRequest.Unmarshal(m, b)
+}
+func (m *GetAlarmAnalysisRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAlarmAnalysisRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetAlarmAnalysisRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAlarmAnalysisRequest.Merge(dst, src)
+}
+func (m *GetAlarmAnalysisRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAlarmAnalysisRequest.Size(m)
+}
+func (m *GetAlarmAnalysisRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAlarmAnalysisRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAlarmAnalysisRequest proto.InternalMessageInfo
+
+func (m *GetAlarmAnalysisRequest) GetSimulated() bool {
+	if m != nil {
+		return m.Simulated
+	}
+	return false
+}
+
+func (m *GetAlarmAnalysisRequest) GetSimulationUuid() string {
+	if m != nil {
+		return m.SimulationUuid
+	}
+	return ""
+}
+
+func (m *GetAlarmAnalysisRequest) GetDateRangeBegin() *timestamp.Timestamp {
+	if m != nil {
+		return m.DateRangeBegin
+	}
+	return nil
+}
+
+func (m *GetAlarmAnalysisRequest) GetDateRangeEnd() *timestamp.Timestamp {
+	if m != nil {
+		return m.DateRangeEnd
+	}
+	return nil
+}
+
+type GetAlarmAnalysisResponse struct {
+	Details              *ResponseDetails   `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	AlarmAnalysisData    *AlarmAnalysisData `protobuf:"bytes,2,opt,name=alarm_analysis_data,json=alarmAnalysisData,proto3" json:"alarm_analysis_data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetAlarmAnalysisResponse) Reset()         { *m = GetAlarmAnalysisResponse{} }
+func (m *GetAlarmAnalysisResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAlarmAnalysisResponse) ProtoMessage()    {}
+func (*GetAlarmAnalysisResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{64}
+}
+func (m *GetAlarmAnalysisResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAlarmAnalysisResponse.Unmarshal(m, b)
+}
+func (m *GetAlarmAnalysisResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAlarmAnalysisResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetAlarmAnalysisResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAlarmAnalysisResponse.Merge(dst, src)
+}
+func (m *GetAlarmAnalysisResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAlarmAnalysisResponse.Size(m)
+}
+func (m *GetAlarmAnalysisResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAlarmAnalysisResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAlarmAnalysisResponse proto.InternalMessageInfo
+
+func (m *GetAlarmAnalysisResponse) GetDetails() *ResponseDetails {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *GetAlarmAnalysisResponse) GetAlarmAnalysisData() *AlarmAnalysisData {
+	if m != nil {
+		return m.AlarmAnalysisData
+	}
+	return nil
+}
+
+type GetConstructorAlarmAnalysisRequest struct {
+	Simulated            bool                 `protobuf:"varint,1,opt,name=simulated,proto3" json:"simulated,omitempty"`
+	SimulationUuid       string               `protobuf:"bytes,2,opt,name=simulation_uuid,json=simulationUuid,proto3" json:"simulation_uuid,omitempty"`
+	DateRangeBegin       *timestamp.Timestamp `protobuf:"bytes,3,opt,name=date_range_begin,json=dateRangeBegin,proto3" json:"date_range_begin,omitempty"`
+	DateRangeEnd         *timestamp.Timestamp `protobuf:"bytes,4,opt,name=date_range_end,json=dateRangeEnd,proto3" json:"date_range_end,omitempty"`
+	Constructor          Constructor          `protobuf:"varint,5,opt,name=constructor,proto3,enum=api.Constructor" json:"constructor,omitempty"`
+	CarNumber            int32                `protobuf:"varint,6,opt,name=car_number,json=carNumber,proto3" json:"car_number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetConstructorAlarmAnalysisRequest) Reset()         { *m = GetConstructorAlarmAnalysisRequest{} }
+func (m *GetConstructorAlarmAnalysisRequest) String() string { return proto.CompactTextString(m) }
+func (*GetConstructorAlarmAnalysisRequest) ProtoMessage()    {}
+func (*GetConstructorAlarmAnalysisRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{65}
+}
+func (m *GetConstructorAlarmAnalysisRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetConstructorAlarmAnalysisRequest.Unmarshal(m, b)
+}
+func (m *GetConstructorAlarmAnalysisRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetConstructorAlarmAnalysisRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetConstructorAlarmAnalysisRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetConstructorAlarmAnalysisRequest.Merge(dst, src)
+}
+func (m *GetConstructorAlarmAnalysisRequest) XXX_Size() int {
+	return xxx_messageInfo_GetConstructorAlarmAnalysisRequest.Size(m)
+}
+func (m *GetConstructorAlarmAnalysisRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetConstructorAlarmAnalysisRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetConstructorAlarmAnalysisRequest proto.InternalMessageInfo
+
+func (m *GetConstructorAlarmAnalysisRequest) GetSimulated() bool {
+	if m != nil {
+		return m.Simulated
+	}
+	return false
+}
+
+func (m *GetConstructorAlarmAnalysisRequest) GetSimulationUuid() string {
+	if m != nil {
+		return m.SimulationUuid
+	}
+	return ""
+}
+
+func (m *GetConstructorAlarmAnalysisRequest) GetDateRangeBegin() *timestamp.Timestamp {
+	if m != nil {
+		return m.DateRangeBegin
+	}
+	return nil
+}
+
+func (m *GetConstructorAlarmAnalysisRequest) GetDateRangeEnd() *timestamp.Timestamp {
+	if m != nil {
+		return m.DateRangeEnd
+	}
+	return nil
+}
+
+func (m *GetConstructorAlarmAnalysisRequest) GetConstructor() Constructor {
+	if m != nil {
+		return m.Constructor
+	}
+	return Constructor_ALPHA_ROMEO
+}
+
+func (m *GetConstructorAlarmAnalysisRequest) GetCarNumber() int32 {
+	if m != nil {
+		return m.CarNumber
+	}
+	return 0
+}
+
+type GetConstructorAlarmAnalysisResponse struct {
+	Details                      *ResponseDetails              `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	ConstructorAlarmAnalysisData *ConstructorAlarmAnalysisData `protobuf:"bytes,2,opt,name=constructor_alarm_analysis_data,json=constructorAlarmAnalysisData,proto3" json:"constructor_alarm_analysis_data,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}                      `json:"-"`
+	XXX_unrecognized             []byte                        `json:"-"`
+	XXX_sizecache                int32                         `json:"-"`
+}
+
+func (m *GetConstructorAlarmAnalysisResponse) Reset()         { *m = GetConstructorAlarmAnalysisResponse{} }
+func (m *GetConstructorAlarmAnalysisResponse) String() string { return proto.CompactTextString(m) }
+func (*GetConstructorAlarmAnalysisResponse) ProtoMessage()    {}
+func (*GetConstructorAlarmAnalysisResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{66}
+}
+func (m *GetConstructorAlarmAnalysisResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetConstructorAlarmAnalysisResponse.Unmarshal(m, b)
+}
+func (m *GetConstructorAlarmAnalysisResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetConstructorAlarmAnalysisResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetConstructorAlarmAnalysisResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetConstructorAlarmAnalysisResponse.Merge(dst, src)
+}
+func (m *GetConstructorAlarmAnalysisResponse) XXX_Size() int {
+	return xxx_messageInfo_GetConstructorAlarmAnalysisResponse.Size(m)
+}
+func (m *GetConstructorAlarmAnalysisResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetConstructorAlarmAnalysisResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetConstructorAlarmAnalysisResponse proto.InternalMessageInfo
+
+func (m *GetConstructorAlarmAnalysisResponse) GetDetails() *ResponseDetails {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *GetConstructorAlarmAnalysisResponse) GetConstructorAlarmAnalysisData() *ConstructorAlarmAnalysisData {
+	if m != nil {
+		return m.ConstructorAlarmAnalysisData
+	}
+	return nil
+}
+
+type GetSystemStatusRequest struct {
+	ClientUuid           string   `protobuf:"bytes,1,opt,name=client_uuid,json=clientUuid,proto3" json:"client_uuid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSystemStatusRequest) Reset()         { *m = GetSystemStatusRequest{} }
+func (m *GetSystemStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSystemStatusRequest) ProtoMessage()    {}
+func (*GetSystemStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{67}
+}
+func (m *GetSystemStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSystemStatusRequest.Unmarshal(m, b)
+}
+func (m *GetSystemStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSystemStatusRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetSystemStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSystemStatusRequest.Merge(dst, src)
+}
+func (m *GetSystemStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSystemStatusRequest.Size(m)
+}
+func (m *GetSystemStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSystemStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSystemStatusRequest proto.InternalMessageInfo
+
+func (m *GetSystemStatusRequest) GetClientUuid() string {
+	if m != nil {
+		return m.ClientUuid
+	}
+	return ""
+}
+
+type GetSystemStatusResponse struct {
+	Details              *ResponseDetails    `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	SystemStatusReport   *SystemStatusReport `protobuf:"bytes,2,opt,name=system_status_report,json=systemStatusReport,proto3" json:"system_status_report,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GetSystemStatusResponse) Reset()         { *m = GetSystemStatusResponse{} }
+func (m *GetSystemStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSystemStatusResponse) ProtoMessage()    {}
+func (*GetSystemStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_FOTAAS_498f4cc372f9039d, []int{68}
+}
+func (m *GetSystemStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSystemStatusResponse.Unmarshal(m, b)
+}
+func (m *GetSystemStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSystemStatusResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetSystemStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSystemStatusResponse.Merge(dst, src)
+}
+func (m *GetSystemStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSystemStatusResponse.Size(m)
+}
+func (m *GetSystemStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSystemStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSystemStatusResponse proto.InternalMessageInfo
+
+func (m *GetSystemStatusResponse) GetDetails() *ResponseDetails {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *GetSystemStatusResponse) GetSystemStatusReport() *SystemStatusReport {
+	if m != nil {
+		return m.SystemStatusReport
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ResponseDetails)(nil), "api.ResponseDetails")
+	proto.RegisterType((*TelemetryDatum)(nil), "api.TelemetryDatum")
+	proto.RegisterType((*TelemetryData)(nil), "api.TelemetryData")
+	proto.RegisterMapType((map[string]*TelemetryDatum)(nil), "api.TelemetryData.TelemetryDatumMapEntry")
+	proto.RegisterType((*AlarmThreshold)(nil), "api.AlarmThreshold")
+	proto.RegisterType((*AlarmThreshold_OverrideBy)(nil), "api.AlarmThreshold.OverrideBy")
+	proto.RegisterType((*Channel)(nil), "api.Channel")
+	proto.RegisterType((*AlarmAnalysisData)(nil), "api.AlarmAnalysisData")
+	proto.RegisterType((*AlarmAnalysisData_AlarmCountsByConstructorAndCar)(nil), "api.AlarmAnalysisData.AlarmCountsByConstructorAndCar")
+	proto.RegisterType((*ConstructorAlarmAnalysisData)(nil), "api.ConstructorAlarmAnalysisData")
+	proto.RegisterType((*ConstructorAlarmAnalysisData_AlarmCountsByDatumDescription)(nil), "api.ConstructorAlarmAnalysisData.AlarmCountsByDatumDescription")
+	proto.RegisterType((*SystemStatusReport)(nil), "api.SystemStatusReport")
+	proto.RegisterType((*SimulationMember)(nil), "api.SimulationMember")
+	proto.RegisterType((*Simulation)(nil), "api.Simulation")
+	proto.RegisterMapType((map[string]*SimulationMember)(nil), "api.Simulation.SimulationMemberMapEntry")
+	proto.RegisterType((*SimulationInfo)(nil), "api.SimulationInfo")
+	proto.RegisterType((*SimulationMemberInfo)(nil), "api.SimulationMemberInfo")
+	proto.RegisterType((*AlivenessCheckRequest)(nil), "api.AlivenessCheckRequest")
+	proto.RegisterType((*AlivenessCheckResponse)(nil), "api.AlivenessCheckResponse")
+	proto.RegisterType((*TransmitTelemetryRequest)(nil), "api.TransmitTelemetryRequest")
+	proto.RegisterType((*TransmitTelemetryResponse)(nil), "api.TransmitTelemetryResponse")
+	proto.RegisterMapType((map[string]*ResponseDetails)(nil), "api.TransmitTelemetryResponse.DetailsEntry")
+	proto.RegisterType((*TransmitTelemetryStreamRequest)(nil), "api.TransmitTelemetryStreamRequest")
+	proto.RegisterType((*TelemetryBatchAck)(nil), "api.TelemetryBatchAck")
+	proto.RegisterMapType((map[string]*ResponseDetails)(nil), "api.TelemetryBatchAck.DatumDetailsEntry")
+	proto.RegisterType((*TransmitTelemetryStreamResponse)(nil), "api.TransmitTelemetryStreamResponse")
+	proto.RegisterType((*RunSimulationRequest)(nil), "api.RunSimulationRequest")
+	proto.RegisterType((*RunSimulationResponse)(nil), "api.RunSimulationResponse")
 	proto.RegisterType((*GetSimulationInfoRequest)(nil), "api.GetSimulationInfoRequest")
 	proto.RegisterType((*GetSimulationInfoResponse)(nil), "api.GetSimulationInfoResponse")
+	proto.RegisterType((*CancelSimulationRequest)(nil), "api.CancelSimulationRequest")
+	proto.RegisterType((*CancelSimulationResponse)(nil), "api.CancelSimulationResponse")
+	proto.RegisterType((*PauseSimulationRequest)(nil), "api.PauseSimulationRequest")
+	proto.RegisterType((*PauseSimulationResponse)(nil), "api.PauseSimulationResponse")
+	proto.RegisterType((*ResumeSimulationRequest)(nil), "api.ResumeSimulationRequest")
+	proto.RegisterType((*ResumeSimulationResponse)(nil), "api.ResumeSimulationResponse")
+	proto.RegisterType((*ListSimulationsRequest)(nil), "api.ListSimulationsRequest")
+	proto.RegisterType((*ListSimulationsResponse)(nil), "api.ListSimulationsResponse")
 	proto.RegisterType((*GetTelemetryDataRequest)(nil), "api.GetTelemetryDataRequest")
 	proto.RegisterType((*GetTelemetryDataRequest_SearchBy)(nil), "api.GetTelemetryDataRequest.SearchBy")
 	proto.RegisterType((*GetTelemetryDataResponse)(nil), "api.GetTelemetryDataResponse")
+	proto.RegisterType((*SubscribeTelemetryRequest)(nil), "api.SubscribeTelemetryRequest")
+	proto.RegisterType((*SubscribeTelemetryResponse)(nil), "api.SubscribeTelemetryResponse")
+	proto.RegisterType((*ExportTelemetryRequest)(nil), "api.ExportTelemetryRequest")
+	proto.RegisterType((*ExportTelemetryResponse)(nil), "api.ExportTelemetryResponse")
+	proto.RegisterType((*GetIngestStatsRequest)(nil), "api.GetIngestStatsRequest")
+	proto.RegisterType((*IngestStats)(nil), "api.IngestStats")
+	proto.RegisterType((*GetIngestStatsResponse)(nil), "api.GetIngestStatsResponse")
+	proto.RegisterType((*PurgeTelemetryRequest)(nil), "api.PurgeTelemetryRequest")
+	proto.RegisterType((*PurgeTelemetryResponse)(nil), "api.PurgeTelemetryResponse")
+	proto.RegisterType((*GetTelemetryAggregatesRequest)(nil), "api.GetTelemetryAggregatesRequest")
+	proto.RegisterType((*TelemetryAggregateBucket)(nil), "api.TelemetryAggregateBucket")
+	proto.RegisterType((*TelemetryAggregateSeries)(nil), "api.TelemetryAggregateSeries")
+	proto.RegisterType((*GetTelemetryAggregatesResponse)(nil), "api.GetTelemetryAggregatesResponse")
+	proto.RegisterType((*CreateAlarmThresholdRequest)(nil), "api.CreateAlarmThresholdRequest")
+	proto.RegisterType((*CreateAlarmThresholdResponse)(nil), "api.CreateAlarmThresholdResponse")
+	proto.RegisterType((*GetAlarmThresholdRequest)(nil), "api.GetAlarmThresholdRequest")
+	proto.RegisterType((*GetAlarmThresholdResponse)(nil), "api.GetAlarmThresholdResponse")
+	proto.RegisterType((*ListAlarmThresholdsRequest)(nil), "api.ListAlarmThresholdsRequest")
+	proto.RegisterType((*ListAlarmThresholdsResponse)(nil), "api.ListAlarmThresholdsResponse")
+	proto.RegisterType((*UpdateAlarmThresholdRequest)(nil), "api.UpdateAlarmThresholdRequest")
+	proto.RegisterType((*UpdateAlarmThresholdResponse)(nil), "api.UpdateAlarmThresholdResponse")
+	proto.RegisterType((*DeleteAlarmThresholdRequest)(nil), "api.DeleteAlarmThresholdRequest")
+	proto.RegisterType((*DeleteAlarmThresholdResponse)(nil), "api.DeleteAlarmThresholdResponse")
+	proto.RegisterType((*RegisterChannelRequest)(nil), "api.RegisterChannelRequest")
+	proto.RegisterType((*RegisterChannelResponse)(nil), "api.RegisterChannelResponse")
+	proto.RegisterType((*ListChannelsRequest)(nil), "api.ListChannelsRequest")
+	proto.RegisterType((*ListChannelsResponse)(nil), "api.ListChannelsResponse")
+	proto.RegisterType((*CarTransmitProgress)(nil), "api.CarTransmitProgress")
+	proto.RegisterType((*GetTransmitProgressRequest)(nil), "api.GetTransmitProgressRequest")
+	proto.RegisterType((*GetTransmitProgressResponse)(nil), "api.GetTransmitProgressResponse")
 	proto.RegisterType((*GetAlarmAnalysisRequest)(nil), "api.GetAlarmAnalysisRequest")
 	proto.RegisterType((*GetAlarmAnalysisResponse)(nil), "api.GetAlarmAnalysisResponse")
 	proto.RegisterType((*GetConstructorAlarmAnalysisRequest)(nil), "api.GetConstructorAlarmAnalysisRequest")
@@ -2313,126 +5160,678 @@ func init() {
 	proto.RegisterEnum("api.Constructor", Constructor_name, Constructor_value)
 	proto.RegisterEnum("api.TelemetryDatumUnit", TelemetryDatumUnit_name, TelemetryDatumUnit_value)
 	proto.RegisterEnum("api.TelemetryDatumDescription", TelemetryDatumDescription_name, TelemetryDatumDescription_value)
+	proto.RegisterEnum("api.ChannelCategory", ChannelCategory_name, ChannelCategory_value)
 	proto.RegisterEnum("api.ResponseCode", ResponseCode_name, ResponseCode_value)
 	proto.RegisterEnum("api.TestResult", TestResult_name, TestResult_value)
 	proto.RegisterEnum("api.SimulationRateMultiplier", SimulationRateMultiplier_name, SimulationRateMultiplier_value)
 	proto.RegisterEnum("api.SampleRate", SampleRate_name, SampleRate_value)
 	proto.RegisterEnum("api.SimulationState", SimulationState_name, SimulationState_value)
+	proto.RegisterEnum("api.SimulationSortField", SimulationSortField_name, SimulationSortField_value)
+	proto.RegisterEnum("api.AggregateFunction", AggregateFunction_name, AggregateFunction_value)
+	proto.RegisterEnum("api.ExportFormat", ExportFormat_name, ExportFormat_value)
+	proto.RegisterEnum("api.ExportLayout", ExportLayout_name, ExportLayout_value)
+	proto.RegisterEnum("api.AckMode", AckMode_name, AckMode_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// TelemetryServiceClient is the client API for TelemetryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TelemetryServiceClient interface {
+	AlivenessCheck(ctx context.Context, in *AlivenessCheckRequest, opts ...grpc.CallOption) (*AlivenessCheckResponse, error)
+	TransmitTelemetry(ctx context.Context, in *TransmitTelemetryRequest, opts ...grpc.CallOption) (*TransmitTelemetryResponse, error)
+	TransmitTelemetryStream(ctx context.Context, opts ...grpc.CallOption) (TelemetryService_TransmitTelemetryStreamClient, error)
+	GetTelemetryData(ctx context.Context, in *GetTelemetryDataRequest, opts ...grpc.CallOption) (*GetTelemetryDataResponse, error)
+	StreamTelemetryData(ctx context.Context, in *GetTelemetryDataRequest, opts ...grpc.CallOption) (TelemetryService_StreamTelemetryDataClient, error)
+	GetTelemetryAggregates(ctx context.Context, in *GetTelemetryAggregatesRequest, opts ...grpc.CallOption) (*GetTelemetryAggregatesResponse, error)
+	SubscribeTelemetry(ctx context.Context, in *SubscribeTelemetryRequest, opts ...grpc.CallOption) (TelemetryService_SubscribeTelemetryClient, error)
+	ExportTelemetry(ctx context.Context, in *ExportTelemetryRequest, opts ...grpc.CallOption) (TelemetryService_ExportTelemetryClient, error)
+	PurgeTelemetry(ctx context.Context, in *PurgeTelemetryRequest, opts ...grpc.CallOption) (*PurgeTelemetryResponse, error)
+	GetIngestStats(ctx context.Context, in *GetIngestStatsRequest, opts ...grpc.CallOption) (*GetIngestStatsResponse, error)
+	CreateAlarmThreshold(ctx context.Context, in *CreateAlarmThresholdRequest, opts ...grpc.CallOption) (*CreateAlarmThresholdResponse, error)
+	GetAlarmThreshold(ctx context.Context, in *GetAlarmThresholdRequest, opts ...grpc.CallOption) (*GetAlarmThresholdResponse, error)
+	ListAlarmThresholds(ctx context.Context, in *ListAlarmThresholdsRequest, opts ...grpc.CallOption) (*ListAlarmThresholdsResponse, error)
+	UpdateAlarmThreshold(ctx context.Context, in *UpdateAlarmThresholdRequest, opts ...grpc.CallOption) (*UpdateAlarmThresholdResponse, error)
+	DeleteAlarmThreshold(ctx context.Context, in *DeleteAlarmThresholdRequest, opts ...grpc.CallOption) (*DeleteAlarmThresholdResponse, error)
+	RegisterChannel(ctx context.Context, in *RegisterChannelRequest, opts ...grpc.CallOption) (*RegisterChannelResponse, error)
+	ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error)
+	GetTransmitProgress(ctx context.Context, in *GetTransmitProgressRequest, opts ...grpc.CallOption) (*GetTransmitProgressResponse, error)
+}
+
+type telemetryServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewTelemetryServiceClient(cc *grpc.ClientConn) TelemetryServiceClient {
+	return &telemetryServiceClient{cc}
+}
+
+func (c *telemetryServiceClient) AlivenessCheck(ctx context.Context, in *AlivenessCheckRequest, opts ...grpc.CallOption) (*AlivenessCheckResponse, error) {
+	out := new(AlivenessCheckResponse)
+	err := c.cc.Invoke(ctx, "/api.TelemetryService/AlivenessCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telemetryServiceClient) TransmitTelemetry(ctx context.Context, in *TransmitTelemetryRequest, opts ...grpc.CallOption) (*TransmitTelemetryResponse, error) {
+	out := new(TransmitTelemetryResponse)
+	err := c.cc.Invoke(ctx, "/api.TelemetryService/TransmitTelemetry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telemetryServiceClient) TransmitTelemetryStream(ctx context.Context, opts ...grpc.CallOption) (TelemetryService_TransmitTelemetryStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TelemetryService_serviceDesc.Streams[0], "/api.TelemetryService/TransmitTelemetryStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &telemetryServiceTransmitTelemetryStreamClient{stream}
+	return x, nil
+}
+
+type TelemetryService_TransmitTelemetryStreamClient interface {
+	Send(*TransmitTelemetryStreamRequest) error
+	CloseAndRecv() (*TransmitTelemetryStreamResponse, error)
+	grpc.ClientStream
+}
+
+type telemetryServiceTransmitTelemetryStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *telemetryServiceTransmitTelemetryStreamClient) Send(m *TransmitTelemetryStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *telemetryServiceTransmitTelemetryStreamClient) CloseAndRecv() (*TransmitTelemetryStreamResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(TransmitTelemetryStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *telemetryServiceClient) GetTelemetryData(ctx context.Context, in *GetTelemetryDataRequest, opts ...grpc.CallOption) (*GetTelemetryDataResponse, error) {
+	out := new(GetTelemetryDataResponse)
+	err := c.cc.Invoke(ctx, "/api.TelemetryService/GetTelemetryData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telemetryServiceClient) StreamTelemetryData(ctx context.Context, in *GetTelemetryDataRequest, opts ...grpc.CallOption) (TelemetryService_StreamTelemetryDataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TelemetryService_serviceDesc.Streams[1], "/api.TelemetryService/StreamTelemetryData", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &telemetryServiceStreamTelemetryDataClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TelemetryService_StreamTelemetryDataClient interface {
+	Recv() (*GetTelemetryDataResponse, error)
+	grpc.ClientStream
+}
+
+type telemetryServiceStreamTelemetryDataClient struct {
+	grpc.ClientStream
+}
+
+func (x *telemetryServiceStreamTelemetryDataClient) Recv() (*GetTelemetryDataResponse, error) {
+	m := new(GetTelemetryDataResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *telemetryServiceClient) GetTelemetryAggregates(ctx context.Context, in *GetTelemetryAggregatesRequest, opts ...grpc.CallOption) (*GetTelemetryAggregatesResponse, error) {
+	out := new(GetTelemetryAggregatesResponse)
+	err := c.cc.Invoke(ctx, "/api.TelemetryService/GetTelemetryAggregates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telemetryServiceClient) SubscribeTelemetry(ctx context.Context, in *SubscribeTelemetryRequest, opts ...grpc.CallOption) (TelemetryService_SubscribeTelemetryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TelemetryService_serviceDesc.Streams[2], "/api.TelemetryService/SubscribeTelemetry", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &telemetryServiceSubscribeTelemetryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TelemetryService_SubscribeTelemetryClient interface {
+	Recv() (*SubscribeTelemetryResponse, error)
+	grpc.ClientStream
+}
+
+type telemetryServiceSubscribeTelemetryClient struct {
+	grpc.ClientStream
+}
+
+func (x *telemetryServiceSubscribeTelemetryClient) Recv() (*SubscribeTelemetryResponse, error) {
+	m := new(SubscribeTelemetryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *telemetryServiceClient) ExportTelemetry(ctx context.Context, in *ExportTelemetryRequest, opts ...grpc.CallOption) (TelemetryService_ExportTelemetryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TelemetryService_serviceDesc.Streams[3], "/api.TelemetryService/ExportTelemetry", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &telemetryServiceExportTelemetryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TelemetryService_ExportTelemetryClient interface {
+	Recv() (*ExportTelemetryResponse, error)
+	grpc.ClientStream
+}
+
+type telemetryServiceExportTelemetryClient struct {
+	grpc.ClientStream
+}
+
+func (x *telemetryServiceExportTelemetryClient) Recv() (*ExportTelemetryResponse, error) {
+	m := new(ExportTelemetryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *telemetryServiceClient) PurgeTelemetry(ctx context.Context, in *PurgeTelemetryRequest, opts ...grpc.CallOption) (*PurgeTelemetryResponse, error) {
+	out := new(PurgeTelemetryResponse)
+	err := c.cc.Invoke(ctx, "/api.TelemetryService/PurgeTelemetry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telemetryServiceClient) GetIngestStats(ctx context.Context, in *GetIngestStatsRequest, opts ...grpc.CallOption) (*GetIngestStatsResponse, error) {
+	out := new(GetIngestStatsResponse)
+	err := c.cc.Invoke(ctx, "/api.TelemetryService/GetIngestStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telemetryServiceClient) CreateAlarmThreshold(ctx context.Context, in *CreateAlarmThresholdRequest, opts ...grpc.CallOption) (*CreateAlarmThresholdResponse, error) {
+	out := new(CreateAlarmThresholdResponse)
+	err := c.cc.Invoke(ctx, "/api.TelemetryService/CreateAlarmThreshold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telemetryServiceClient) GetAlarmThreshold(ctx context.Context, in *GetAlarmThresholdRequest, opts ...grpc.CallOption) (*GetAlarmThresholdResponse, error) {
+	out := new(GetAlarmThresholdResponse)
+	err := c.cc.Invoke(ctx, "/api.TelemetryService/GetAlarmThreshold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telemetryServiceClient) ListAlarmThresholds(ctx context.Context, in *ListAlarmThresholdsRequest, opts ...grpc.CallOption) (*ListAlarmThresholdsResponse, error) {
+	out := new(ListAlarmThresholdsResponse)
+	err := c.cc.Invoke(ctx, "/api.TelemetryService/ListAlarmThresholds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telemetryServiceClient) UpdateAlarmThreshold(ctx context.Context, in *UpdateAlarmThresholdRequest, opts ...grpc.CallOption) (*UpdateAlarmThresholdResponse, error) {
+	out := new(UpdateAlarmThresholdResponse)
+	err := c.cc.Invoke(ctx, "/api.TelemetryService/UpdateAlarmThreshold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telemetryServiceClient) DeleteAlarmThreshold(ctx context.Context, in *DeleteAlarmThresholdRequest, opts ...grpc.CallOption) (*DeleteAlarmThresholdResponse, error) {
+	out := new(DeleteAlarmThresholdResponse)
+	err := c.cc.Invoke(ctx, "/api.TelemetryService/DeleteAlarmThreshold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telemetryServiceClient) RegisterChannel(ctx context.Context, in *RegisterChannelRequest, opts ...grpc.CallOption) (*RegisterChannelResponse, error) {
+	out := new(RegisterChannelResponse)
+	err := c.cc.Invoke(ctx, "/api.TelemetryService/RegisterChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telemetryServiceClient) ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error) {
+	out := new(ListChannelsResponse)
+	err := c.cc.Invoke(ctx, "/api.TelemetryService/ListChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telemetryServiceClient) GetTransmitProgress(ctx context.Context, in *GetTransmitProgressRequest, opts ...grpc.CallOption) (*GetTransmitProgressResponse, error) {
+	out := new(GetTransmitProgressResponse)
+	err := c.cc.Invoke(ctx, "/api.TelemetryService/GetTransmitProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TelemetryServiceServer is the server API for TelemetryService service.
+type TelemetryServiceServer interface {
+	AlivenessCheck(context.Context, *AlivenessCheckRequest) (*AlivenessCheckResponse, error)
+	TransmitTelemetry(context.Context, *TransmitTelemetryRequest) (*TransmitTelemetryResponse, error)
+	TransmitTelemetryStream(TelemetryService_TransmitTelemetryStreamServer) error
+	GetTelemetryData(context.Context, *GetTelemetryDataRequest) (*GetTelemetryDataResponse, error)
+	StreamTelemetryData(*GetTelemetryDataRequest, TelemetryService_StreamTelemetryDataServer) error
+	GetTelemetryAggregates(context.Context, *GetTelemetryAggregatesRequest) (*GetTelemetryAggregatesResponse, error)
+	SubscribeTelemetry(*SubscribeTelemetryRequest, TelemetryService_SubscribeTelemetryServer) error
+	ExportTelemetry(*ExportTelemetryRequest, TelemetryService_ExportTelemetryServer) error
+	PurgeTelemetry(context.Context, *PurgeTelemetryRequest) (*PurgeTelemetryResponse, error)
+	GetIngestStats(context.Context, *GetIngestStatsRequest) (*GetIngestStatsResponse, error)
+	CreateAlarmThreshold(context.Context, *CreateAlarmThresholdRequest) (*CreateAlarmThresholdResponse, error)
+	GetAlarmThreshold(context.Context, *GetAlarmThresholdRequest) (*GetAlarmThresholdResponse, error)
+	ListAlarmThresholds(context.Context, *ListAlarmThresholdsRequest) (*ListAlarmThresholdsResponse, error)
+	UpdateAlarmThreshold(context.Context, *UpdateAlarmThresholdRequest) (*UpdateAlarmThresholdResponse, error)
+	DeleteAlarmThreshold(context.Context, *DeleteAlarmThresholdRequest) (*DeleteAlarmThresholdResponse, error)
+	RegisterChannel(context.Context, *RegisterChannelRequest) (*RegisterChannelResponse, error)
+	ListChannels(context.Context, *ListChannelsRequest) (*ListChannelsResponse, error)
+	GetTransmitProgress(context.Context, *GetTransmitProgressRequest) (*GetTransmitProgressResponse, error)
+}
+
+func RegisterTelemetryServiceServer(s *grpc.Server, srv TelemetryServiceServer) {
+	s.RegisterService(&_TelemetryService_serviceDesc, srv)
+}
+
+func _TelemetryService_AlivenessCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlivenessCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelemetryServiceServer).AlivenessCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TelemetryService/AlivenessCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelemetryServiceServer).AlivenessCheck(ctx, req.(*AlivenessCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TelemetryService_TransmitTelemetry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransmitTelemetryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelemetryServiceServer).TransmitTelemetry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TelemetryService/TransmitTelemetry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelemetryServiceServer).TransmitTelemetry(ctx, req.(*TransmitTelemetryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TelemetryService_TransmitTelemetryStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TelemetryServiceServer).TransmitTelemetryStream(&telemetryServiceTransmitTelemetryStreamServer{stream})
+}
+
+type TelemetryService_TransmitTelemetryStreamServer interface {
+	SendAndClose(*TransmitTelemetryStreamResponse) error
+	Recv() (*TransmitTelemetryStreamRequest, error)
+	grpc.ServerStream
+}
+
+type telemetryServiceTransmitTelemetryStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *telemetryServiceTransmitTelemetryStreamServer) SendAndClose(m *TransmitTelemetryStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *telemetryServiceTransmitTelemetryStreamServer) Recv() (*TransmitTelemetryStreamRequest, error) {
+	m := new(TransmitTelemetryStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _TelemetryService_GetTelemetryData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTelemetryDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelemetryServiceServer).GetTelemetryData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TelemetryService/GetTelemetryData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelemetryServiceServer).GetTelemetryData(ctx, req.(*GetTelemetryDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TelemetryService_StreamTelemetryData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetTelemetryDataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TelemetryServiceServer).StreamTelemetryData(m, &telemetryServiceStreamTelemetryDataServer{stream})
+}
+
+type TelemetryService_StreamTelemetryDataServer interface {
+	Send(*GetTelemetryDataResponse) error
+	grpc.ServerStream
+}
+
+type telemetryServiceStreamTelemetryDataServer struct {
+	grpc.ServerStream
+}
+
+func (x *telemetryServiceStreamTelemetryDataServer) Send(m *GetTelemetryDataResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TelemetryService_GetTelemetryAggregates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTelemetryAggregatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelemetryServiceServer).GetTelemetryAggregates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TelemetryService/GetTelemetryAggregates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelemetryServiceServer).GetTelemetryAggregates(ctx, req.(*GetTelemetryAggregatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func _TelemetryService_SubscribeTelemetry_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeTelemetryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TelemetryServiceServer).SubscribeTelemetry(m, &telemetryServiceSubscribeTelemetryServer{stream})
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+type TelemetryService_SubscribeTelemetryServer interface {
+	Send(*SubscribeTelemetryResponse) error
+	grpc.ServerStream
+}
 
-// TelemetryServiceClient is the client API for TelemetryService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type TelemetryServiceClient interface {
-	AlivenessCheck(ctx context.Context, in *AlivenessCheckRequest, opts ...grpc.CallOption) (*AlivenessCheckResponse, error)
-	TransmitTelemetry(ctx context.Context, in *TransmitTelemetryRequest, opts ...grpc.CallOption) (*TransmitTelemetryResponse, error)
-	GetTelemetryData(ctx context.Context, in *GetTelemetryDataRequest, opts ...grpc.CallOption) (*GetTelemetryDataResponse, error)
+type telemetryServiceSubscribeTelemetryServer struct {
+	grpc.ServerStream
 }
 
-type telemetryServiceClient struct {
-	cc *grpc.ClientConn
+func (x *telemetryServiceSubscribeTelemetryServer) Send(m *SubscribeTelemetryResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func NewTelemetryServiceClient(cc *grpc.ClientConn) TelemetryServiceClient {
-	return &telemetryServiceClient{cc}
+func _TelemetryService_ExportTelemetry_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportTelemetryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TelemetryServiceServer).ExportTelemetry(m, &telemetryServiceExportTelemetryServer{stream})
 }
 
-func (c *telemetryServiceClient) AlivenessCheck(ctx context.Context, in *AlivenessCheckRequest, opts ...grpc.CallOption) (*AlivenessCheckResponse, error) {
-	out := new(AlivenessCheckResponse)
-	err := c.cc.Invoke(ctx, "/api.TelemetryService/AlivenessCheck", in, out, opts...)
-	if err != nil {
+type TelemetryService_ExportTelemetryServer interface {
+	Send(*ExportTelemetryResponse) error
+	grpc.ServerStream
+}
+
+type telemetryServiceExportTelemetryServer struct {
+	grpc.ServerStream
+}
+
+func (x *telemetryServiceExportTelemetryServer) Send(m *ExportTelemetryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TelemetryService_PurgeTelemetry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTelemetryRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TelemetryServiceServer).PurgeTelemetry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TelemetryService/PurgeTelemetry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelemetryServiceServer).PurgeTelemetry(ctx, req.(*PurgeTelemetryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *telemetryServiceClient) TransmitTelemetry(ctx context.Context, in *TransmitTelemetryRequest, opts ...grpc.CallOption) (*TransmitTelemetryResponse, error) {
-	out := new(TransmitTelemetryResponse)
-	err := c.cc.Invoke(ctx, "/api.TelemetryService/TransmitTelemetry", in, out, opts...)
-	if err != nil {
+func _TelemetryService_GetIngestStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIngestStatsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TelemetryServiceServer).GetIngestStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TelemetryService/GetIngestStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelemetryServiceServer).GetIngestStats(ctx, req.(*GetIngestStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *telemetryServiceClient) GetTelemetryData(ctx context.Context, in *GetTelemetryDataRequest, opts ...grpc.CallOption) (*GetTelemetryDataResponse, error) {
-	out := new(GetTelemetryDataResponse)
-	err := c.cc.Invoke(ctx, "/api.TelemetryService/GetTelemetryData", in, out, opts...)
-	if err != nil {
+func _TelemetryService_CreateAlarmThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAlarmThresholdRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(TelemetryServiceServer).CreateAlarmThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TelemetryService/CreateAlarmThreshold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelemetryServiceServer).CreateAlarmThreshold(ctx, req.(*CreateAlarmThresholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// TelemetryServiceServer is the server API for TelemetryService service.
-type TelemetryServiceServer interface {
-	AlivenessCheck(context.Context, *AlivenessCheckRequest) (*AlivenessCheckResponse, error)
-	TransmitTelemetry(context.Context, *TransmitTelemetryRequest) (*TransmitTelemetryResponse, error)
-	GetTelemetryData(context.Context, *GetTelemetryDataRequest) (*GetTelemetryDataResponse, error)
+func _TelemetryService_GetAlarmThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAlarmThresholdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelemetryServiceServer).GetAlarmThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TelemetryService/GetAlarmThreshold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelemetryServiceServer).GetAlarmThreshold(ctx, req.(*GetAlarmThresholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterTelemetryServiceServer(s *grpc.Server, srv TelemetryServiceServer) {
-	s.RegisterService(&_TelemetryService_serviceDesc, srv)
+func _TelemetryService_ListAlarmThresholds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlarmThresholdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelemetryServiceServer).ListAlarmThresholds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TelemetryService/ListAlarmThresholds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelemetryServiceServer).ListAlarmThresholds(ctx, req.(*ListAlarmThresholdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _TelemetryService_AlivenessCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AlivenessCheckRequest)
+func _TelemetryService_UpdateAlarmThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAlarmThresholdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TelemetryServiceServer).AlivenessCheck(ctx, in)
+		return srv.(TelemetryServiceServer).UpdateAlarmThreshold(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.TelemetryService/AlivenessCheck",
+		FullMethod: "/api.TelemetryService/UpdateAlarmThreshold",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TelemetryServiceServer).AlivenessCheck(ctx, req.(*AlivenessCheckRequest))
+		return srv.(TelemetryServiceServer).UpdateAlarmThreshold(ctx, req.(*UpdateAlarmThresholdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TelemetryService_TransmitTelemetry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TransmitTelemetryRequest)
+func _TelemetryService_DeleteAlarmThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAlarmThresholdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TelemetryServiceServer).TransmitTelemetry(ctx, in)
+		return srv.(TelemetryServiceServer).DeleteAlarmThreshold(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.TelemetryService/TransmitTelemetry",
+		FullMethod: "/api.TelemetryService/DeleteAlarmThreshold",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TelemetryServiceServer).TransmitTelemetry(ctx, req.(*TransmitTelemetryRequest))
+		return srv.(TelemetryServiceServer).DeleteAlarmThreshold(ctx, req.(*DeleteAlarmThresholdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TelemetryService_GetTelemetryData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTelemetryDataRequest)
+func _TelemetryService_RegisterChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterChannelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TelemetryServiceServer).GetTelemetryData(ctx, in)
+		return srv.(TelemetryServiceServer).RegisterChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.TelemetryService/GetTelemetryData",
+		FullMethod: "/api.TelemetryService/RegisterChannel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TelemetryServiceServer).GetTelemetryData(ctx, req.(*GetTelemetryDataRequest))
+		return srv.(TelemetryServiceServer).RegisterChannel(ctx, req.(*RegisterChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TelemetryService_ListChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelemetryServiceServer).ListChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TelemetryService/ListChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelemetryServiceServer).ListChannels(ctx, req.(*ListChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TelemetryService_GetTransmitProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransmitProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelemetryServiceServer).GetTransmitProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.TelemetryService/GetTransmitProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelemetryServiceServer).GetTransmitProgress(ctx, req.(*GetTransmitProgressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2453,8 +5852,73 @@ var _TelemetryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTelemetryData",
 			Handler:    _TelemetryService_GetTelemetryData_Handler,
 		},
+		{
+			MethodName: "GetTelemetryAggregates",
+			Handler:    _TelemetryService_GetTelemetryAggregates_Handler,
+		},
+		{
+			MethodName: "PurgeTelemetry",
+			Handler:    _TelemetryService_PurgeTelemetry_Handler,
+		},
+		{
+			MethodName: "GetIngestStats",
+			Handler:    _TelemetryService_GetIngestStats_Handler,
+		},
+		{
+			MethodName: "CreateAlarmThreshold",
+			Handler:    _TelemetryService_CreateAlarmThreshold_Handler,
+		},
+		{
+			MethodName: "GetAlarmThreshold",
+			Handler:    _TelemetryService_GetAlarmThreshold_Handler,
+		},
+		{
+			MethodName: "ListAlarmThresholds",
+			Handler:    _TelemetryService_ListAlarmThresholds_Handler,
+		},
+		{
+			MethodName: "UpdateAlarmThreshold",
+			Handler:    _TelemetryService_UpdateAlarmThreshold_Handler,
+		},
+		{
+			MethodName: "DeleteAlarmThreshold",
+			Handler:    _TelemetryService_DeleteAlarmThreshold_Handler,
+		},
+		{
+			MethodName: "RegisterChannel",
+			Handler:    _TelemetryService_RegisterChannel_Handler,
+		},
+		{
+			MethodName: "ListChannels",
+			Handler:    _TelemetryService_ListChannels_Handler,
+		},
+		{
+			MethodName: "GetTransmitProgress",
+			Handler:    _TelemetryService_GetTransmitProgress_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TransmitTelemetryStream",
+			Handler:       _TelemetryService_TransmitTelemetryStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StreamTelemetryData",
+			Handler:       _TelemetryService_StreamTelemetryData_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeTelemetry",
+			Handler:       _TelemetryService_SubscribeTelemetry_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportTelemetry",
+			Handler:       _TelemetryService_ExportTelemetry_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "FOTAAS.proto",
 }
 
@@ -2595,6 +6059,10 @@ type SimulationServiceClient interface {
 	AlivenessCheck(ctx context.Context, in *AlivenessCheckRequest, opts ...grpc.CallOption) (*AlivenessCheckResponse, error)
 	RunSimulation(ctx context.Context, in *RunSimulationRequest, opts ...grpc.CallOption) (*RunSimulationResponse, error)
 	GetSimulationInfo(ctx context.Context, in *GetSimulationInfoRequest, opts ...grpc.CallOption) (*GetSimulationInfoResponse, error)
+	CancelSimulation(ctx context.Context, in *CancelSimulationRequest, opts ...grpc.CallOption) (*CancelSimulationResponse, error)
+	PauseSimulation(ctx context.Context, in *PauseSimulationRequest, opts ...grpc.CallOption) (*PauseSimulationResponse, error)
+	ResumeSimulation(ctx context.Context, in *ResumeSimulationRequest, opts ...grpc.CallOption) (*ResumeSimulationResponse, error)
+	ListSimulations(ctx context.Context, in *ListSimulationsRequest, opts ...grpc.CallOption) (*ListSimulationsResponse, error)
 }
 
 type simulationServiceClient struct {
@@ -2632,11 +6100,51 @@ func (c *simulationServiceClient) GetSimulationInfo(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *simulationServiceClient) CancelSimulation(ctx context.Context, in *CancelSimulationRequest, opts ...grpc.CallOption) (*CancelSimulationResponse, error) {
+	out := new(CancelSimulationResponse)
+	err := c.cc.Invoke(ctx, "/api.SimulationService/CancelSimulation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simulationServiceClient) PauseSimulation(ctx context.Context, in *PauseSimulationRequest, opts ...grpc.CallOption) (*PauseSimulationResponse, error) {
+	out := new(PauseSimulationResponse)
+	err := c.cc.Invoke(ctx, "/api.SimulationService/PauseSimulation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simulationServiceClient) ResumeSimulation(ctx context.Context, in *ResumeSimulationRequest, opts ...grpc.CallOption) (*ResumeSimulationResponse, error) {
+	out := new(ResumeSimulationResponse)
+	err := c.cc.Invoke(ctx, "/api.SimulationService/ResumeSimulation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simulationServiceClient) ListSimulations(ctx context.Context, in *ListSimulationsRequest, opts ...grpc.CallOption) (*ListSimulationsResponse, error) {
+	out := new(ListSimulationsResponse)
+	err := c.cc.Invoke(ctx, "/api.SimulationService/ListSimulations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SimulationServiceServer is the server API for SimulationService service.
 type SimulationServiceServer interface {
 	AlivenessCheck(context.Context, *AlivenessCheckRequest) (*AlivenessCheckResponse, error)
 	RunSimulation(context.Context, *RunSimulationRequest) (*RunSimulationResponse, error)
 	GetSimulationInfo(context.Context, *GetSimulationInfoRequest) (*GetSimulationInfoResponse, error)
+	CancelSimulation(context.Context, *CancelSimulationRequest) (*CancelSimulationResponse, error)
+	PauseSimulation(context.Context, *PauseSimulationRequest) (*PauseSimulationResponse, error)
+	ResumeSimulation(context.Context, *ResumeSimulationRequest) (*ResumeSimulationResponse, error)
+	ListSimulations(context.Context, *ListSimulationsRequest) (*ListSimulationsResponse, error)
 }
 
 func RegisterSimulationServiceServer(s *grpc.Server, srv SimulationServiceServer) {
@@ -2697,6 +6205,78 @@ func _SimulationService_GetSimulationInfo_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SimulationService_CancelSimulation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSimulationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimulationServiceServer).CancelSimulation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SimulationService/CancelSimulation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimulationServiceServer).CancelSimulation(ctx, req.(*CancelSimulationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimulationService_PauseSimulation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseSimulationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimulationServiceServer).PauseSimulation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SimulationService/PauseSimulation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimulationServiceServer).PauseSimulation(ctx, req.(*PauseSimulationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimulationService_ResumeSimulation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeSimulationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimulationServiceServer).ResumeSimulation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SimulationService/ResumeSimulation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimulationServiceServer).ResumeSimulation(ctx, req.(*ResumeSimulationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimulationService_ListSimulations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSimulationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimulationServiceServer).ListSimulations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SimulationService/ListSimulations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimulationServiceServer).ListSimulations(ctx, req.(*ListSimulationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _SimulationService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.SimulationService",
 	HandlerType: (*SimulationServiceServer)(nil),
@@ -2713,6 +6293,22 @@ var _SimulationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSimulationInfo",
 			Handler:    _SimulationService_GetSimulationInfo_Handler,
 		},
+		{
+			MethodName: "CancelSimulation",
+			Handler:    _SimulationService_CancelSimulation_Handler,
+		},
+		{
+			MethodName: "PauseSimulation",
+			Handler:    _SimulationService_PauseSimulation_Handler,
+		},
+		{
+			MethodName: "ResumeSimulation",
+			Handler:    _SimulationService_ResumeSimulation_Handler,
+		},
+		{
+			MethodName: "ListSimulations",
+			Handler:    _SimulationService_ListSimulations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "FOTAAS.proto",
@@ -2815,200 +6411,358 @@ var _SystemStatusService_serviceDesc = grpc.ServiceDesc{
 	Metadata: "FOTAAS.proto",
 }
 
-func init() { proto.RegisterFile("FOTAAS.proto", fileDescriptor_FOTAAS_30307ce35d3090ce) }
-
-var fileDescriptor_FOTAAS_30307ce35d3090ce = []byte{
-	// 3066 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x39, 0x4d, 0x6f, 0xe3, 0x48,
-	0x76, 0xa6, 0x3e, 0x6c, 0xe9, 0xc9, 0xb6, 0x4a, 0x65, 0x77, 0xb7, 0x5a, 0xed, 0x9e, 0x76, 0x34,
-	0x98, 0x8c, 0xc7, 0x0d, 0x78, 0xba, 0x9d, 0x0c, 0x30, 0x33, 0x08, 0x30, 0x53, 0xa2, 0xca, 0x12,
-	0xdb, 0x14, 0xa9, 0x29, 0x52, 0x33, 0xdd, 0x9d, 0x04, 0x04, 0x5b, 0x62, 0xbb, 0x85, 0x91, 0x29,
-	0x87, 0xa4, 0x7a, 0xc6, 0xb7, 0x1c, 0x92, 0x20, 0x39, 0x05, 0x08, 0xe6, 0x9a, 0x53, 0x90, 0x53,
-	0x80, 0x04, 0x08, 0xf6, 0xb8, 0xd8, 0x3d, 0xcd, 0xfe, 0x89, 0xbd, 0xec, 0x71, 0xb1, 0x97, 0xfd,
-	0x0b, 0x8b, 0x2a, 0x92, 0x12, 0x45, 0xd1, 0xee, 0x0f, 0xf4, 0x62, 0xb1, 0x73, 0x12, 0xeb, 0x7d,
-	0xd5, 0xab, 0xf7, 0x55, 0xef, 0x95, 0x60, 0xf3, 0x44, 0x37, 0x09, 0x31, 0x8e, 0x2e, 0xbc, 0x69,
-	0x30, 0xc5, 0x79, 0xfb, 0x62, 0xdc, 0xb8, 0x77, 0x36, 0x9d, 0x9e, 0x4d, 0x9c, 0x8f, 0x05, 0xe8,
-	0xd9, 0xec, 0xf9, 0xc7, 0xc1, 0xf8, 0xdc, 0xf1, 0x03, 0xfb, 0xfc, 0x22, 0xa4, 0x6a, 0x32, 0xa8,
-	0x32, 0xc7, 0xbf, 0x98, 0xba, 0xbe, 0xd3, 0x76, 0x02, 0x7b, 0x3c, 0xf1, 0xf1, 0x07, 0x50, 0x18,
-	0x4e, 0x47, 0x4e, 0x5d, 0xda, 0x97, 0x0e, 0xb6, 0x8f, 0x6b, 0x47, 0xf6, 0xc5, 0xf8, 0x28, 0xa6,
-	0x91, 0xa7, 0x23, 0x87, 0x09, 0x34, 0xae, 0xc3, 0xc6, 0xb9, 0xe3, 0xfb, 0xf6, 0x99, 0x53, 0xcf,
-	0xed, 0x4b, 0x07, 0x65, 0x16, 0x2f, 0x9b, 0xff, 0x5b, 0x84, 0x6d, 0xd3, 0x99, 0x38, 0xe7, 0x4e,
-	0xe0, 0x5d, 0xb6, 0xed, 0x60, 0x76, 0x8e, 0x31, 0x14, 0x66, 0xb3, 0xf1, 0x48, 0xc8, 0x2c, 0x33,
-	0xf1, 0x8d, 0xbf, 0x84, 0xca, 0xc8, 0xf1, 0x87, 0xde, 0xf8, 0x22, 0x18, 0x4f, 0x5d, 0x21, 0x64,
-	0xfb, 0xf8, 0x3d, 0xb1, 0xdd, 0x32, 0x77, 0x7b, 0x41, 0xc5, 0x92, 0x2c, 0xf8, 0x3e, 0x14, 0x66,
-	0xee, 0x38, 0xa8, 0xe7, 0x05, 0xeb, 0xad, 0x0c, 0xd6, 0x81, 0x3b, 0x0e, 0x98, 0x20, 0xc2, 0x9f,
-	0x42, 0x79, 0x7e, 0xf8, 0x7a, 0x61, 0x5f, 0x3a, 0xa8, 0x1c, 0x37, 0x8e, 0x42, 0xf3, 0x1c, 0xc5,
-	0xe6, 0x39, 0x32, 0x63, 0x0a, 0xb6, 0x20, 0xc6, 0x0d, 0x28, 0x4d, 0xec, 0x60, 0x1c, 0xcc, 0x46,
-	0x4e, 0xbd, 0xb8, 0x2f, 0x1d, 0x48, 0x6c, 0xbe, 0xc6, 0x7b, 0x50, 0x9e, 0x4c, 0xdd, 0xb3, 0x10,
-	0xb9, 0x2e, 0x90, 0x0b, 0x00, 0xc7, 0x3a, 0x13, 0xe7, 0xa5, 0x2d, 0x0e, 0xb8, 0x11, 0x62, 0xe7,
-	0x00, 0xbc, 0x0b, 0xc5, 0x97, 0xf6, 0x64, 0xe6, 0xd4, 0x4b, 0x02, 0x13, 0x2e, 0xf0, 0x5d, 0x80,
-	0x17, 0xe3, 0xb3, 0x17, 0x96, 0x3d, 0xb1, 0xbd, 0xf3, 0x7a, 0x79, 0x5f, 0x3a, 0x28, 0xb1, 0x32,
-	0x87, 0x10, 0x0e, 0xc0, 0x77, 0xf8, 0x86, 0xdf, 0x45, 0x58, 0x10, 0xd8, 0xd2, 0x64, 0xfa, 0x5d,
-	0x88, 0xdc, 0x83, 0xb2, 0x3f, 0x3e, 0x9f, 0x4d, 0xec, 0xc0, 0x19, 0xd5, 0x2b, 0x21, 0xeb, 0x1c,
-	0x80, 0x3f, 0x84, 0x6a, 0xb4, 0x18, 0x4f, 0x5d, 0x4b, 0xf8, 0x63, 0x53, 0xf8, 0x63, 0x7b, 0x01,
-	0x1e, 0x70, 0xcf, 0xf4, 0xe0, 0xfd, 0x04, 0x61, 0xe0, 0xd9, 0xae, 0x7f, 0x3e, 0x0e, 0x2c, 0xdf,
-	0xf9, 0x87, 0x99, 0xe3, 0x0e, 0x1d, 0xcb, 0x9d, 0x9d, 0x3f, 0x73, 0xbc, 0xfa, 0xd6, 0xbe, 0x74,
-	0x50, 0x64, 0xfb, 0x0b, 0x52, 0x33, 0xa2, 0x34, 0x22, 0x42, 0x4d, 0xd0, 0xe1, 0x43, 0x28, 0x9f,
-	0x79, 0xb6, 0x6b, 0x5d, 0x78, 0xe3, 0xef, 0xeb, 0xdb, 0xc2, 0x57, 0x5b, 0xc2, 0x57, 0x1d, 0xcf,
-	0x76, 0xfb, 0xde, 0xf8, 0x7b, 0x56, 0x3a, 0x8b, 0xbe, 0xf0, 0x3e, 0x14, 0x03, 0xcf, 0x1e, 0x7e,
-	0x5b, 0xaf, 0x0a, 0x3a, 0x08, 0x7d, 0xca, 0x21, 0x2c, 0x44, 0xe0, 0x63, 0xa8, 0x0c, 0xa7, 0xae,
-	0x1f, 0x78, 0xb3, 0x61, 0x30, 0xf5, 0xea, 0x48, 0xd0, 0x21, 0x41, 0x27, 0x2f, 0xe0, 0x2c, 0x49,
-	0xc4, 0x6d, 0x3a, 0xb4, 0xbd, 0x58, 0xef, 0x9a, 0xd0, 0xbb, 0x3c, 0xb4, 0xbd, 0x50, 0xc1, 0xe6,
-	0x8f, 0x12, 0x6c, 0x25, 0xe3, 0xc6, 0xc6, 0x4f, 0x60, 0x27, 0x88, 0x01, 0xd6, 0x88, 0x47, 0x92,
-	0x75, 0x6e, 0x5f, 0xd4, 0x8b, 0xfb, 0xf9, 0x83, 0xca, 0xf1, 0x47, 0x2b, 0x81, 0x66, 0xa7, 0xc2,
-	0xae, 0x67, 0x5f, 0x50, 0x37, 0xf0, 0x2e, 0x59, 0x2d, 0x48, 0xc3, 0x1b, 0x4f, 0xe0, 0x66, 0x36,
-	0x31, 0x46, 0x90, 0xff, 0xd6, 0xb9, 0x8c, 0x72, 0x84, 0x7f, 0xe2, 0x8f, 0xe2, 0x08, 0xc9, 0x89,
-	0x78, 0xdd, 0xc9, 0x88, 0xf0, 0x28, 0x6c, 0x3e, 0xcf, 0x7d, 0x2a, 0x35, 0x7f, 0x9d, 0x87, 0x9a,
-	0x08, 0x04, 0xe2, 0xda, 0x93, 0x4b, 0x7f, 0xec, 0x8b, 0xb3, 0x2c, 0x05, 0x85, 0x94, 0x0e, 0x8a,
-	0x36, 0xa0, 0x91, 0x1d, 0x38, 0x96, 0x67, 0xbb, 0x67, 0x8e, 0xf5, 0xcc, 0x39, 0x1b, 0xbb, 0xd1,
-	0x6e, 0xd7, 0x65, 0xc7, 0x36, 0xe7, 0x61, 0x9c, 0xa5, 0xc5, 0x39, 0xf0, 0x97, 0xb0, 0x9d, 0x90,
-	0xe2, 0xb8, 0x23, 0x91, 0x93, 0xd7, 0xcb, 0xd8, 0x9c, 0xcb, 0xa0, 0xee, 0x08, 0x3f, 0x86, 0x4d,
-	0x11, 0xd3, 0xd6, 0x70, 0x3a, 0x73, 0x03, 0xbf, 0xbe, 0x21, 0x4c, 0xfd, 0x89, 0x38, 0xf1, 0xca,
-	0x99, 0x42, 0x88, 0x2c, 0x28, 0x5b, 0x97, 0x09, 0xb7, 0x13, 0x77, 0x24, 0xdb, 0x1e, 0xab, 0xd8,
-	0x0b, 0x7c, 0xe3, 0x47, 0x09, 0xde, 0xbb, 0x9e, 0x3e, 0x1d, 0x53, 0xd2, 0x9b, 0xc7, 0x54, 0x2e,
-	0x15, 0x53, 0xf8, 0x2f, 0xa1, 0x3a, 0xcf, 0xd3, 0xf0, 0x4c, 0xc2, 0x24, 0x45, 0xb6, 0x15, 0x67,
-	0xab, 0x50, 0x07, 0x1f, 0x00, 0x5a, 0xa4, 0x7b, 0x44, 0x58, 0x10, 0x84, 0xdb, 0xf3, 0xa4, 0x17,
-	0x94, 0xcd, 0x9f, 0x17, 0x60, 0x2f, 0xa9, 0xfa, 0x9f, 0xa9, 0xa3, 0x53, 0xb6, 0x2e, 0xbc, 0xb9,
-	0xad, 0x8b, 0x69, 0x5b, 0x3f, 0x4b, 0xc5, 0xce, 0xba, 0x88, 0x9d, 0x2f, 0xd2, 0x32, 0x5f, 0x11,
-	0x46, 0xab, 0x77, 0x4d, 0x32, 0x8a, 0x7e, 0x21, 0xc1, 0xdd, 0x6b, 0xc9, 0xf1, 0x29, 0xd4, 0xc2,
-	0x4a, 0x91, 0xbc, 0xd5, 0xa4, 0xd7, 0xba, 0xd5, 0xd0, 0x28, 0x2d, 0x2c, 0x23, 0x7c, 0x72, 0xaf,
-	0x1b, 0x3e, 0xf9, 0xcc, 0xf0, 0xf9, 0x9f, 0x02, 0x60, 0xe3, 0xd2, 0x0f, 0x9c, 0x73, 0x23, 0xb0,
-	0x83, 0x99, 0xcf, 0x9c, 0x8b, 0xa9, 0x17, 0x60, 0x1d, 0xee, 0x2c, 0x2a, 0x9d, 0xef, 0x78, 0x2f,
-	0xc7, 0x43, 0xc7, 0xb2, 0x27, 0xe3, 0x97, 0x8e, 0xeb, 0xf8, 0x7e, 0xa4, 0x7f, 0x35, 0xd2, 0xdf,
-	0x0f, 0x98, 0xe3, 0xcf, 0x26, 0x01, 0xbb, 0x3d, 0xe7, 0x31, 0x42, 0x16, 0x12, 0x73, 0xe0, 0x1e,
-	0x34, 0xec, 0xc8, 0xc6, 0x19, 0xf2, 0x72, 0xd9, 0xf2, 0xea, 0x31, 0xcb, 0x8a, 0xb8, 0xaf, 0x60,
-	0x2f, 0x71, 0x17, 0xad, 0x0a, 0xcc, 0x67, 0x0b, 0x6c, 0x2c, 0x98, 0x56, 0x44, 0x7e, 0x0e, 0xc8,
-	0x0f, 0x6c, 0x2f, 0xb0, 0x16, 0x34, 0x51, 0x18, 0xae, 0x88, 0xa9, 0x0a, 0x42, 0x63, 0x4e, 0x87,
-	0xfb, 0xb0, 0x77, 0x31, 0x9d, 0x4c, 0xac, 0xe7, 0x53, 0x2f, 0xc1, 0x6e, 0x0d, 0xa7, 0xe7, 0x17,
-	0x13, 0x27, 0x08, 0xfb, 0x83, 0x2c, 0x7b, 0x71, 0xa6, 0x93, 0xa9, 0xb7, 0x90, 0x24, 0x47, 0x1c,
-	0x58, 0x81, 0xba, 0xe7, 0x04, 0xde, 0xd8, 0x79, 0xe9, 0x24, 0x25, 0x8e, 0xec, 0xc0, 0x16, 0x0d,
-	0x45, 0x86, 0xb4, 0x9b, 0x31, 0xc3, 0x42, 0x9c, 0x28, 0x00, 0x0a, 0xd4, 0x53, 0x12, 0xac, 0xd8,
-	0xae, 0xa2, 0xfb, 0xc8, 0x12, 0xe5, 0x2f, 0x89, 0x88, 0xb3, 0xa3, 0xf9, 0x1b, 0x09, 0xd0, 0x42,
-	0x7a, 0xcf, 0x11, 0x79, 0x96, 0xd5, 0xc5, 0x65, 0x34, 0x15, 0xb9, 0xcc, 0xa6, 0x22, 0x95, 0xf7,
-	0xf9, 0x37, 0xcf, 0xfb, 0x42, 0x3a, 0xef, 0xef, 0x41, 0xe5, 0xf9, 0xd4, 0x13, 0xe1, 0xc0, 0xbb,
-	0xa1, 0xa2, 0x28, 0x79, 0x20, 0x40, 0xf3, 0x66, 0xc9, 0x9d, 0x86, 0x58, 0x5f, 0x18, 0xb3, 0xc4,
-	0x4a, 0xee, 0x54, 0xe0, 0xfc, 0xe6, 0xef, 0xf2, 0x00, 0x09, 0xcf, 0x66, 0x1d, 0xee, 0x08, 0x76,
-	0x46, 0x33, 0x2f, 0x3c, 0xda, 0xd8, 0xb5, 0xce, 0xc7, 0xee, 0x2c, 0x70, 0xfc, 0x28, 0x13, 0x6b,
-	0x31, 0x4a, 0x71, 0x7b, 0x21, 0x02, 0x3f, 0x80, 0x8a, 0x6f, 0x73, 0xbf, 0x5a, 0x9e, 0x1d, 0x38,
-	0x4b, 0xb1, 0x69, 0x08, 0x38, 0xe3, 0x95, 0x10, 0xfc, 0xf9, 0x37, 0xfe, 0x5b, 0x48, 0x44, 0xaa,
-	0xe0, 0xb2, 0xce, 0x67, 0x93, 0x60, 0x7c, 0x31, 0x19, 0x3b, 0x71, 0x71, 0xbc, 0x1b, 0x0a, 0x98,
-	0x93, 0x71, 0xc6, 0xde, 0x9c, 0x88, 0x25, 0x7c, 0xbe, 0x8c, 0x59, 0x6e, 0xbc, 0x8a, 0xaf, 0xd9,
-	0x78, 0xad, 0x5f, 0xd5, 0x78, 0xfd, 0x1d, 0xdc, 0x48, 0xa8, 0x7a, 0x2e, 0x42, 0x42, 0x74, 0x45,
-	0xe1, 0x55, 0x7d, 0x90, 0xd2, 0xf2, 0x28, 0x1d, 0x3e, 0xf3, 0xa6, 0x68, 0xc7, 0x5f, 0xc5, 0x34,
-	0xfe, 0x1e, 0xea, 0x57, 0x31, 0x64, 0x34, 0x46, 0xf7, 0x97, 0x1b, 0xa3, 0x1b, 0xa9, 0xbd, 0x43,
-	0xfe, 0x64, 0x6b, 0xf4, 0xef, 0x05, 0xd8, 0x5e, 0xe0, 0x15, 0xf7, 0xf9, 0xf4, 0x4f, 0xe4, 0xf0,
-	0x25, 0x9f, 0x14, 0x5e, 0xd3, 0x27, 0xc5, 0xab, 0x7c, 0x72, 0x08, 0x45, 0x3f, 0xe0, 0x3b, 0x87,
-	0x5e, 0xdb, 0x4d, 0xd9, 0x81, 0x57, 0x7a, 0x87, 0x85, 0x24, 0x58, 0x86, 0xb0, 0x9a, 0x59, 0x8b,
-	0x31, 0x68, 0xe3, 0xd5, 0xf7, 0xbf, 0x60, 0x99, 0xaf, 0xf1, 0x17, 0xb0, 0xe5, 0xb8, 0xa3, 0x84,
-	0x88, 0xd2, 0xab, 0xaf, 0x7f, 0xc7, 0x1d, 0x2d, 0x04, 0x7c, 0x04, 0xe8, 0xc2, 0xf1, 0x86, 0x8e,
-	0x1b, 0x2c, 0x8a, 0x66, 0x59, 0xcc, 0x3f, 0xd5, 0x08, 0x3e, 0xaf, 0x8c, 0x87, 0x50, 0x7b, 0x3e,
-	0x76, 0xed, 0x89, 0xe5, 0x8b, 0x0b, 0xcb, 0x12, 0x53, 0x29, 0x08, 0x6f, 0x55, 0x05, 0x22, 0xbc,
-	0xc8, 0xf8, 0x4c, 0x8a, 0x1f, 0xc0, 0xee, 0x12, 0x6d, 0x3c, 0x9a, 0x56, 0x04, 0x39, 0x4e, 0x90,
-	0xf7, 0xa2, 0x29, 0xf5, 0x16, 0xdc, 0x98, 0x5f, 0x09, 0xf2, 0x0b, 0x67, 0xf8, 0x2d, 0xe3, 0x53,
-	0x8b, 0x1f, 0x34, 0xbb, 0x70, 0x33, 0x8d, 0x08, 0x87, 0x5f, 0x7c, 0x04, 0x1b, 0xa3, 0x70, 0x48,
-	0x16, 0x41, 0x53, 0x89, 0xec, 0x9d, 0x1a, 0xa0, 0x59, 0x4c, 0xd4, 0x1c, 0x40, 0x3d, 0x1e, 0x89,
-	0xe6, 0x77, 0x7f, 0xb4, 0x0b, 0xfe, 0x0c, 0xb6, 0x97, 0x26, 0x0c, 0x3b, 0x12, 0x89, 0x57, 0x87,
-	0x0b, 0xb6, 0x95, 0x9c, 0x22, 0xec, 0xe6, 0xcf, 0x24, 0xb8, 0x9d, 0x21, 0x37, 0x52, 0x92, 0x26,
-	0x95, 0xe4, 0x89, 0x79, 0x3f, 0x0e, 0x9b, 0x6c, 0x86, 0xa3, 0x48, 0xed, 0x30, 0x37, 0x63, 0xde,
-	0x46, 0x1f, 0x36, 0x93, 0x88, 0x8c, 0x1c, 0x3c, 0x5c, 0xce, 0xc1, 0x6c, 0x5b, 0x24, 0x52, 0xb0,
-	0x03, 0xbb, 0x6c, 0xe6, 0x26, 0xca, 0x58, 0x64, 0x89, 0x8f, 0x01, 0x12, 0x17, 0x71, 0x68, 0x85,
-	0x6a, 0xba, 0xe4, 0x25, 0x48, 0x9a, 0x1d, 0xb8, 0x91, 0x12, 0xf4, 0x96, 0xfe, 0x91, 0xa1, 0xde,
-	0x71, 0x82, 0xe5, 0xb2, 0x10, 0x6b, 0x95, 0x71, 0xaf, 0x49, 0x59, 0xf7, 0x5a, 0xf3, 0xdf, 0x24,
-	0xb8, 0x9d, 0x21, 0xe5, 0xed, 0x54, 0xc2, 0x7f, 0xb3, 0xb4, 0xed, 0xd8, 0x7d, 0x3e, 0x5d, 0x9a,
-	0xfd, 0x52, 0xbb, 0x24, 0x74, 0xe1, 0xeb, 0xe6, 0x7f, 0xad, 0xc3, 0xad, 0x8e, 0x13, 0x2c, 0x47,
-	0x4f, 0x74, 0xa0, 0xeb, 0xa7, 0x83, 0xd7, 0xbe, 0xc6, 0xb3, 0xc6, 0x88, 0xfc, 0x3b, 0x18, 0x23,
-	0x0a, 0x6f, 0x38, 0x46, 0xbc, 0xdb, 0xbb, 0x2d, 0xd5, 0x9c, 0x6c, 0xbc, 0x79, 0x73, 0x52, 0x4a,
-	0x37, 0x27, 0x99, 0xe3, 0x40, 0xf9, 0x2d, 0xc7, 0x81, 0x16, 0x94, 0x7d, 0xc7, 0xf6, 0x86, 0x2f,
-	0xac, 0x67, 0x97, 0xa2, 0x04, 0x56, 0x8e, 0x3f, 0x08, 0x4f, 0x9b, 0xed, 0xed, 0x23, 0x43, 0x50,
-	0xb7, 0x2e, 0x59, 0xc9, 0x8f, 0xbe, 0x1a, 0xff, 0x92, 0x83, 0x52, 0x0c, 0xe6, 0xca, 0x2f, 0x1c,
-	0x10, 0x87, 0xc3, 0xdc, 0xc0, 0x78, 0x7f, 0xd9, 0x1e, 0x39, 0x81, 0xbf, 0xe6, 0xf4, 0xf9, 0x50,
-	0xc0, 0xe2, 0xf4, 0xf7, 0xb3, 0x4e, 0x5f, 0x10, 0x54, 0xab, 0xa7, 0xbb, 0x93, 0xf6, 0x65, 0x29,
-	0xe1, 0xbc, 0xdd, 0xa4, 0xf3, 0x4a, 0xb1, 0xc3, 0x96, 0x5f, 0xc9, 0x36, 0xae, 0x7d, 0x25, 0x2b,
-	0x2d, 0xbf, 0x92, 0x35, 0xff, 0x59, 0x12, 0x79, 0x9f, 0xb2, 0xdb, 0x5b, 0x26, 0xec, 0x6a, 0x1d,
-	0xcf, 0xbd, 0x6e, 0x1d, 0xff, 0xad, 0x24, 0xb2, 0x75, 0x69, 0x2c, 0xfd, 0x69, 0x66, 0x6b, 0xf3,
-	0x3f, 0x42, 0x93, 0xa7, 0x8e, 0xfa, 0x96, 0x26, 0x3f, 0x81, 0x9d, 0x70, 0xdc, 0x9d, 0xcf, 0x99,
-	0x09, 0xbb, 0xdf, 0xcc, 0x7e, 0x31, 0x62, 0x35, 0x3b, 0x0d, 0x6a, 0xfe, 0x2a, 0x07, 0xcd, 0x8e,
-	0x13, 0x5c, 0xf5, 0x42, 0xf0, 0x13, 0x2d, 0x9c, 0xa9, 0x52, 0x57, 0x7c, 0xf3, 0x52, 0xb7, 0x9e,
-	0x7e, 0x3f, 0xfd, 0xa5, 0x04, 0xef, 0x5f, 0x6b, 0xc8, 0xb7, 0x74, 0xf4, 0x0b, 0xb8, 0x97, 0xd0,
-	0xc2, 0xba, 0xda, 0xe9, 0x7f, 0xf1, 0xca, 0xa7, 0x1e, 0xb6, 0x37, 0xbc, 0x06, 0xdb, 0xfc, 0x0c,
-	0x6e, 0xf2, 0x3b, 0x7c, 0xe9, 0x79, 0x24, 0xf4, 0xfe, 0x3d, 0xa8, 0x0c, 0x27, 0x63, 0xde, 0xae,
-	0x26, 0x7a, 0x00, 0x08, 0x41, 0xe2, 0xfe, 0xff, 0x21, 0xcc, 0xe2, 0x65, 0xde, 0xb7, 0x3c, 0xb0,
-	0x02, 0xbb, 0xbe, 0x90, 0x13, 0xb7, 0xb1, 0x9e, 0x78, 0xa4, 0x89, 0x4e, 0x19, 0xfe, 0xc1, 0xb1,
-	0xfa, 0x86, 0xc3, 0xb0, 0xbf, 0x02, 0x3b, 0xfc, 0x7d, 0x0e, 0x8a, 0xe2, 0x8a, 0xc3, 0x00, 0xeb,
-	0x64, 0x60, 0x98, 0x8a, 0x86, 0xd6, 0x70, 0x09, 0x0a, 0x2d, 0x72, 0x3a, 0x40, 0x12, 0xbe, 0x05,
-	0x3b, 0x32, 0x31, 0x89, 0x3a, 0xd0, 0x9e, 0x10, 0xab, 0x45, 0x98, 0x4c, 0x55, 0x5d, 0x23, 0x28,
-	0x87, 0xb7, 0x01, 0xba, 0xba, 0x7c, 0x4a, 0xb5, 0x2e, 0x55, 0x7a, 0x28, 0x8f, 0xab, 0x50, 0xe9,
-	0x0e, 0xb4, 0x0e, 0x61, 0x3a, 0x53, 0xb4, 0x0e, 0x2a, 0xe0, 0x3a, 0xec, 0x2a, 0x9a, 0x49, 0x99,
-	0x4a, 0x3a, 0xba, 0x61, 0x19, 0x64, 0x60, 0xf5, 0xc9, 0x40, 0xd5, 0x51, 0x91, 0xb3, 0xf6, 0x08,
-	0x53, 0x34, 0x2e, 0xf0, 0x09, 0x5a, 0xc7, 0x5b, 0x50, 0xee, 0x51, 0xb5, 0xa5, 0x0f, 0x98, 0x46,
-	0xd1, 0x06, 0x97, 0xd4, 0xa3, 0x8f, 0x15, 0x59, 0xb7, 0x64, 0xc5, 0x7c, 0x82, 0x4a, 0x02, 0xa0,
-	0x6b, 0x26, 0xb5, 0x64, 0xc2, 0x54, 0x1d, 0x95, 0xf1, 0x26, 0x94, 0x38, 0x80, 0x51, 0xa2, 0x22,
-	0xc0, 0x65, 0x28, 0xf6, 0x74, 0xed, 0x29, 0x41, 0x15, 0xbc, 0x07, 0x75, 0xbe, 0x89, 0xc5, 0x14,
-	0x99, 0xb0, 0xb6, 0xa5, 0x72, 0x16, 0xc3, 0xa4, 0xaa, 0x4a, 0x4d, 0xb4, 0xc9, 0x4f, 0x68, 0x90,
-	0xd3, 0xae, 0xc2, 0xd0, 0x16, 0x17, 0x61, 0x74, 0x89, 0xd6, 0xe9, 0x12, 0x05, 0x6d, 0xf3, 0x1d,
-	0x0c, 0x45, 0xfd, 0x9a, 0x32, 0xc3, 0xd4, 0x35, 0x8a, 0xaa, 0x5c, 0xa6, 0xa1, 0xcb, 0x5d, 0x05,
-	0x21, 0x7c, 0x03, 0x6a, 0x46, 0x9f, 0x58, 0x27, 0x8c, 0x68, 0xb2, 0xce, 0xe4, 0x2e, 0xe9, 0xf5,
-	0x0d, 0x54, 0xc3, 0x77, 0xe0, 0x96, 0xd1, 0x57, 0xa8, 0xda, 0xa2, 0xac, 0x63, 0x31, 0xda, 0xb6,
-	0x5a, 0x03, 0x95, 0x6f, 0xac, 0x75, 0x10, 0x16, 0x3b, 0x0d, 0x9e, 0x0e, 0x4e, 0x09, 0xda, 0xe1,
-	0xa7, 0x7d, 0x42, 0x0c, 0x2b, 0x3c, 0x31, 0xda, 0x3d, 0xfc, 0xbf, 0x1c, 0x94, 0xe2, 0xe6, 0x03,
-	0xd7, 0x60, 0x6b, 0xa0, 0x29, 0x26, 0x6d, 0x5b, 0x86, 0x49, 0x4c, 0x6a, 0xa0, 0x35, 0x4e, 0x4f,
-	0x9e, 0x52, 0xd6, 0x22, 0xca, 0x23, 0xa2, 0x21, 0x09, 0x57, 0x60, 0xc3, 0xe8, 0x13, 0x4d, 0x31,
-	0xba, 0x28, 0xc7, 0x05, 0x77, 0x28, 0xeb, 0x11, 0x0d, 0xe5, 0xb9, 0xd9, 0x42, 0x8b, 0x2b, 0x44,
-	0x43, 0x05, 0xbe, 0x6c, 0x31, 0xf2, 0x54, 0x51, 0xf9, 0xb2, 0xc8, 0x97, 0x86, 0xa2, 0x75, 0x48,
-	0x5f, 0x67, 0x14, 0xad, 0x0b, 0xa9, 0x03, 0xc3, 0x64, 0x44, 0xa0, 0x37, 0xb8, 0x54, 0x61, 0x64,
-	0xa2, 0xa1, 0x12, 0x97, 0xda, 0xd3, 0x35, 0x22, 0x47, 0xb6, 0x95, 0x89, 0x46, 0xda, 0x9c, 0x0c,
-	0x38, 0x99, 0x62, 0x86, 0x3c, 0x15, 0x4e, 0x76, 0xc2, 0xa8, 0x26, 0x77, 0xd1, 0x26, 0x47, 0xb4,
-	0x48, 0x97, 0x11, 0x45, 0x43, 0x5b, 0x7c, 0x21, 0x77, 0x15, 0x8d, 0x1a, 0x14, 0x6d, 0x0b, 0x0c,
-	0x53, 0x4c, 0xae, 0x6f, 0x95, 0x2f, 0xd8, 0xc0, 0x30, 0x38, 0x3f, 0x12, 0x18, 0xaa, 0x76, 0xf8,
-	0xa2, 0xc6, 0xf7, 0x11, 0x0a, 0xf1, 0x15, 0xe6, 0xab, 0x47, 0xa4, 0x4f, 0x84, 0x88, 0x1d, 0xae,
-	0x3b, 0x69, 0x0d, 0xac, 0x76, 0x97, 0xb4, 0x14, 0xb4, 0x7b, 0xf8, 0x9f, 0x12, 0x54, 0x12, 0x49,
-	0xcb, 0xbd, 0x45, 0xd4, 0x7e, 0x97, 0x58, 0x4c, 0xef, 0x51, 0x1d, 0xad, 0x71, 0xc1, 0x27, 0x94,
-	0x31, 0xc2, 0x14, 0x24, 0xf1, 0xd8, 0xed, 0x12, 0x62, 0xa0, 0x9c, 0x38, 0xa3, 0xac, 0x12, 0x46,
-	0xb9, 0xb5, 0x78, 0xcc, 0x50, 0x26, 0xd3, 0x36, 0x35, 0x50, 0x01, 0x23, 0xd8, 0x64, 0x44, 0x56,
-	0xb4, 0x8e, 0xd5, 0xd7, 0x15, 0xcd, 0x44, 0x45, 0xbc, 0x03, 0xd5, 0x85, 0x17, 0x05, 0x0a, 0xad,
-	0xe3, 0x9b, 0x80, 0x0d, 0x79, 0xd0, 0xa6, 0x4c, 0x21, 0x96, 0xa9, 0x33, 0xdd, 0x62, 0xba, 0xa1,
-	0xa3, 0x0d, 0x2e, 0xec, 0x1b, 0x45, 0x55, 0x15, 0xd2, 0x33, 0x50, 0xe9, 0xf0, 0x07, 0x09, 0xf0,
-	0xea, 0xff, 0x89, 0xb8, 0x08, 0x52, 0x07, 0xad, 0x71, 0x6d, 0x4f, 0x3b, 0x56, 0x9f, 0x32, 0xab,
-	0xab, 0x0f, 0x18, 0x92, 0x30, 0x86, 0xed, 0x36, 0xed, 0x30, 0x4a, 0x2d, 0x99, 0xaa, 0xb2, 0x32,
-	0xe0, 0xaa, 0xae, 0x43, 0xae, 0xf7, 0x08, 0xe5, 0xf1, 0x06, 0xe4, 0x1f, 0xf5, 0xb9, 0x82, 0x1b,
-	0x90, 0x67, 0xfd, 0x1e, 0x2a, 0xf2, 0x8f, 0x16, 0x61, 0x68, 0x9d, 0x93, 0x9c, 0x76, 0xd0, 0x06,
-	0x07, 0x9c, 0xf6, 0xbb, 0xa8, 0x24, 0xe2, 0x9e, 0x9a, 0x94, 0xa1, 0x32, 0xf7, 0x0c, 0x8b, 0x5d,
-	0x26, 0xf0, 0x04, 0x55, 0x0e, 0xff, 0xa9, 0x00, 0xb7, 0xaf, 0x6c, 0x1e, 0xb9, 0x71, 0x3a, 0xd6,
-	0x89, 0xce, 0x64, 0x8a, 0xd6, 0x78, 0x8c, 0x47, 0x0b, 0xab, 0xad, 0x30, 0x2a, 0x9b, 0x8a, 0xce,
-	0x43, 0xaf, 0x06, 0x5b, 0x27, 0x03, 0xaa, 0x5a, 0xb2, 0xae, 0x19, 0x83, 0x1e, 0x6d, 0xa3, 0x1c,
-	0x77, 0x8d, 0x00, 0x9d, 0xa8, 0xfa, 0x37, 0x28, 0xcf, 0xcb, 0x03, 0xd5, 0x3a, 0x8a, 0x46, 0x2d,
-	0x59, 0xd7, 0x55, 0xa2, 0x99, 0x96, 0x49, 0x7b, 0x7d, 0x54, 0x48, 0x20, 0x74, 0x45, 0xb5, 0xfa,
-	0x8c, 0x1a, 0xc6, 0x80, 0xd1, 0xd0, 0xce, 0x09, 0x84, 0xa0, 0x16, 0xd1, 0x19, 0x01, 0xf9, 0xa1,
-	0x37, 0xf8, 0xc6, 0x2d, 0x46, 0x4e, 0xa9, 0xc0, 0x5b, 0x27, 0x0c, 0x95, 0xd2, 0x20, 0x15, 0x95,
-	0x53, 0x20, 0xc6, 0x10, 0xa4, 0x41, 0x2a, 0xaa, 0xf0, 0x3a, 0x44, 0x35, 0xca, 0x3a, 0x4f, 0x2c,
-	0xc3, 0xd4, 0x19, 0xe9, 0x50, 0x4b, 0xa5, 0x5f, 0x53, 0x15, 0x6d, 0x86, 0x3a, 0x2e, 0x61, 0x84,
-	0x3a, 0x5b, 0xa2, 0xe0, 0x74, 0x06, 0xa7, 0x96, 0x3e, 0x30, 0xfb, 0x03, 0x33, 0xac, 0x0f, 0xbd,
-	0xce, 0xa0, 0x1b, 0x03, 0xc2, 0xfa, 0xd0, 0xa7, 0xb4, 0x8d, 0x10, 0xde, 0x05, 0x64, 0x2a, 0x8c,
-	0xce, 0xcf, 0xc8, 0xd5, 0xad, 0x65, 0x40, 0x55, 0x84, 0x57, 0xa1, 0x8c, 0xa1, 0x9d, 0x0c, 0xa8,
-	0x8a, 0x76, 0x79, 0x88, 0x0a, 0x68, 0x6c, 0x82, 0x1b, 0x29, 0x88, 0x8a, 0x6e, 0x2e, 0x43, 0x18,
-	0x43, 0xb7, 0x52, 0x10, 0x15, 0xd5, 0x0f, 0x3f, 0x81, 0xcd, 0xe4, 0xdf, 0xf2, 0x3c, 0x8e, 0xf4,
-	0x53, 0xb4, 0xc6, 0x8f, 0x40, 0x19, 0xd3, 0x59, 0x98, 0x32, 0x8a, 0x76, 0xa2, 0xa3, 0x1c, 0xff,
-	0xfa, 0x86, 0x30, 0x0d, 0xe5, 0x0f, 0x1f, 0x00, 0x2c, 0xde, 0x7f, 0x39, 0xbc, 0x4f, 0x0c, 0x23,
-	0xbc, 0x1a, 0x4e, 0x88, 0xa2, 0x22, 0x89, 0x3b, 0x4d, 0xd1, 0x64, 0xbd, 0xd7, 0x57, 0xa9, 0x49,
-	0x51, 0xee, 0x50, 0x4d, 0x3e, 0xcd, 0xa5, 0x9e, 0x18, 0xd7, 0x21, 0xf7, 0xf8, 0x21, 0x5a, 0x13,
-	0xbf, 0xc7, 0x48, 0x12, 0xbf, 0x7f, 0x1d, 0xc6, 0xfd, 0xe3, 0x4f, 0xc3, 0xb8, 0x7f, 0xfc, 0xf0,
-	0x41, 0x18, 0xf7, 0x8f, 0x8f, 0x1f, 0xa0, 0xe2, 0xe1, 0x09, 0xc0, 0xe2, 0x69, 0x4c, 0x14, 0x41,
-	0x66, 0x3d, 0xb4, 0x7a, 0x5c, 0x05, 0x5e, 0xbb, 0x99, 0xf5, 0xf0, 0x01, 0x5f, 0x49, 0xa2, 0xd0,
-	0xf1, 0x95, 0x58, 0x8a, 0x7b, 0x29, 0x5c, 0x8a, 0x75, 0xfe, 0x70, 0x04, 0xd5, 0xd4, 0x43, 0x17,
-	0xb7, 0x91, 0xa2, 0x29, 0xa6, 0x42, 0x54, 0xe5, 0x29, 0xcf, 0x73, 0x91, 0xa3, 0x8a, 0x66, 0xf5,
-	0x99, 0xde, 0xe1, 0x2e, 0x08, 0x85, 0xc6, 0x27, 0xe3, 0x51, 0xbf, 0x03, 0x55, 0x7e, 0x68, 0xda,
-	0xb6, 0x4c, 0x9d, 0x57, 0x6a, 0x66, 0xa2, 0xbc, 0x28, 0x87, 0x02, 0x88, 0x0a, 0xc7, 0xff, 0x9a,
-	0x03, 0x64, 0xa6, 0xfe, 0xeb, 0xc0, 0xa7, 0xb0, 0xbd, 0xfc, 0x42, 0x84, 0x1b, 0x51, 0xd7, 0x99,
-	0xf1, 0x9e, 0xd4, 0xb8, 0x93, 0x89, 0x0b, 0x1d, 0xd7, 0x5c, 0xc3, 0x26, 0xd4, 0x56, 0xde, 0x66,
-	0xf0, 0xdd, 0xab, 0xde, 0x6c, 0x42, 0x91, 0xef, 0x5d, 0xff, 0xa4, 0xd3, 0x5c, 0xc3, 0x5f, 0x01,
-	0x4a, 0x8f, 0x38, 0x78, 0xef, 0xba, 0x89, 0xb1, 0x71, 0xf7, 0x0a, 0x6c, 0x2c, 0xf2, 0xf8, 0xbf,
-	0x73, 0x50, 0x25, 0xcb, 0x7f, 0xd3, 0xbc, 0x5b, 0x4b, 0x84, 0x3a, 0x2f, 0x35, 0x67, 0x0b, 0x9d,
-	0xb3, 0x5a, 0xf3, 0x85, 0xce, 0x99, 0xfd, 0x66, 0x73, 0x0d, 0x7b, 0x70, 0xe7, 0x9a, 0xc6, 0x14,
-	0x7f, 0x18, 0xf3, 0xbf, 0x62, 0x06, 0x68, 0x1c, 0xbc, 0x9a, 0x70, 0x6e, 0xa7, 0x7f, 0xcc, 0x41,
-	0xcd, 0x48, 0xff, 0xfb, 0xf4, 0x6e, 0x2d, 0xd5, 0x85, 0xad, 0xa5, 0x17, 0x30, 0x7c, 0x3b, 0xec,
-	0x2b, 0x33, 0x9e, 0xd7, 0x1a, 0x8d, 0x2c, 0x54, 0x32, 0xfa, 0x56, 0x1e, 0xaf, 0xf0, 0xdc, 0xac,
-	0x99, 0x4f, 0x63, 0x51, 0xf4, 0x5d, 0xf9, 0xe6, 0xd5, 0x5c, 0x3b, 0xfe, 0x7f, 0x09, 0x76, 0x92,
-	0x7d, 0xea, 0x1f, 0xc5, 0x08, 0x1a, 0x54, 0x53, 0x7d, 0x37, 0xbe, 0x33, 0xd7, 0x6c, 0xb5, 0x93,
-	0x6f, 0xec, 0x65, 0x23, 0x63, 0x79, 0xcf, 0xd6, 0xc5, 0xe8, 0xf4, 0x57, 0x7f, 0x08, 0x00, 0x00,
-	0xff, 0xff, 0x14, 0x8f, 0x49, 0x46, 0x47, 0x25, 0x00, 0x00,
+func init() { proto.RegisterFile("FOTAAS.proto", fileDescriptor_FOTAAS_498f4cc372f9039d) }
+
+var fileDescriptor_FOTAAS_498f4cc372f9039d = []byte{
+	// 5588 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x7b, 0xdf, 0x6f, 0xe3, 0x48,
+	0x72, 0xf0, 0x50, 0xbf, 0x5d, 0x92, 0xed, 0x76, 0xdb, 0x63, 0x6b, 0x34, 0x9e, 0x19, 0xaf, 0xf6,
+	0xbb, 0x3b, 0xaf, 0x77, 0xe1, 0x9d, 0x99, 0xdb, 0xfb, 0xbe, 0xbb, 0xfb, 0xee, 0x17, 0x45, 0xd1,
+	0x32, 0xd7, 0x12, 0xa9, 0x6b, 0x4a, 0xbb, 0x33, 0x73, 0x39, 0x10, 0x1c, 0x89, 0xb6, 0x95, 0x91,
+	0x28, 0x1f, 0x49, 0xed, 0x8e, 0x2f, 0x48, 0x82, 0x00, 0xc1, 0x25, 0x01, 0x92, 0x97, 0xe0, 0x5e,
+	0xf3, 0x94, 0x04, 0x08, 0x70, 0x08, 0x12, 0x04, 0x01, 0xb2, 0x40, 0x82, 0x04, 0x08, 0x70, 0xf7,
+	0x98, 0xe7, 0x00, 0x41, 0x90, 0x3c, 0xe6, 0x2d, 0xf9, 0x0b, 0x82, 0xa0, 0xbb, 0x49, 0x8a, 0xa2,
+	0x28, 0x8d, 0x47, 0xbb, 0x9b, 0x4b, 0xee, 0x49, 0xec, 0xaa, 0xea, 0xea, 0xea, 0xea, 0xaa, 0xea,
+	0xea, 0xea, 0x16, 0x94, 0x4e, 0xb4, 0x8e, 0x28, 0xea, 0xc7, 0x57, 0xce, 0xd8, 0x1b, 0xe3, 0xb4,
+	0x79, 0x35, 0xa8, 0x3c, 0xb8, 0x18, 0x8f, 0x2f, 0x86, 0xd6, 0xbb, 0x0c, 0xf4, 0x7c, 0x72, 0xfe,
+	0xae, 0x37, 0x18, 0x59, 0xae, 0x67, 0x8e, 0xae, 0x38, 0x55, 0x95, 0xc0, 0x26, 0xb1, 0xdc, 0xab,
+	0xb1, 0xed, 0x5a, 0x75, 0xcb, 0x33, 0x07, 0x43, 0x17, 0x7f, 0x01, 0x32, 0xbd, 0x71, 0xdf, 0x2a,
+	0x0b, 0x07, 0xc2, 0xe1, 0xc6, 0xe3, 0xad, 0x63, 0xf3, 0x6a, 0x70, 0x1c, 0xd0, 0x48, 0xe3, 0xbe,
+	0x45, 0x18, 0x1a, 0x97, 0x21, 0x3f, 0xb2, 0x5c, 0xd7, 0xbc, 0xb0, 0xca, 0xa9, 0x03, 0xe1, 0x70,
+	0x8d, 0x04, 0xcd, 0xea, 0x27, 0x39, 0xd8, 0xe8, 0x58, 0x43, 0x6b, 0x64, 0x79, 0xce, 0x75, 0xdd,
+	0xf4, 0x26, 0x23, 0x8c, 0x21, 0x33, 0x99, 0x0c, 0xfa, 0x8c, 0xe7, 0x1a, 0x61, 0xdf, 0xf8, 0x3b,
+	0x50, 0xec, 0x5b, 0x6e, 0xcf, 0x19, 0x5c, 0x79, 0x83, 0xb1, 0xcd, 0x98, 0x6c, 0x3c, 0xbe, 0xcf,
+	0x86, 0x9b, 0xed, 0x5d, 0x9f, 0x52, 0x91, 0x68, 0x17, 0xfc, 0x36, 0x64, 0x26, 0xf6, 0xc0, 0x2b,
+	0xa7, 0x59, 0xd7, 0xbd, 0x84, 0xae, 0x5d, 0x7b, 0xe0, 0x11, 0x46, 0x84, 0xbf, 0x0a, 0x6b, 0xe1,
+	0xe4, 0xcb, 0x99, 0x03, 0xe1, 0xb0, 0xf8, 0xb8, 0x72, 0xcc, 0xd5, 0x73, 0x1c, 0xa8, 0xe7, 0xb8,
+	0x13, 0x50, 0x90, 0x29, 0x31, 0xae, 0x40, 0x61, 0x68, 0x7a, 0x03, 0x6f, 0xd2, 0xb7, 0xca, 0xd9,
+	0x03, 0xe1, 0x50, 0x20, 0x61, 0x1b, 0xef, 0xc3, 0xda, 0x70, 0x6c, 0x5f, 0x70, 0x64, 0x8e, 0x21,
+	0xa7, 0x00, 0x8a, 0xb5, 0x86, 0xd6, 0x47, 0x26, 0x9b, 0x60, 0x9e, 0x63, 0x43, 0x00, 0xde, 0x81,
+	0xec, 0x47, 0xe6, 0x70, 0x62, 0x95, 0x0b, 0x0c, 0xc3, 0x1b, 0xf8, 0x1e, 0xc0, 0xe5, 0xe0, 0xe2,
+	0xd2, 0x30, 0x87, 0xa6, 0x33, 0x2a, 0xaf, 0x1d, 0x08, 0x87, 0x05, 0xb2, 0x46, 0x21, 0x22, 0x05,
+	0xe0, 0xbb, 0x74, 0xc0, 0x8f, 0x7d, 0x2c, 0x30, 0x6c, 0x61, 0x38, 0xfe, 0x98, 0x23, 0xf7, 0x61,
+	0xcd, 0x1d, 0x8c, 0x26, 0x43, 0xd3, 0xb3, 0xfa, 0xe5, 0x22, 0xef, 0x1a, 0x02, 0xf0, 0x97, 0x60,
+	0xd3, 0x6f, 0x0c, 0xc6, 0xb6, 0xc1, 0xd6, 0xa3, 0xc4, 0xd6, 0x63, 0x63, 0x0a, 0xee, 0xd2, 0x95,
+	0x69, 0xc1, 0x9b, 0x11, 0x42, 0xcf, 0x31, 0x6d, 0x77, 0x34, 0xf0, 0x0c, 0xd7, 0xfa, 0xc1, 0xc4,
+	0xb2, 0x7b, 0x96, 0x61, 0x4f, 0x46, 0xcf, 0x2d, 0xa7, 0xbc, 0x7e, 0x20, 0x1c, 0x66, 0xc9, 0xc1,
+	0x94, 0xb4, 0xe3, 0x53, 0xea, 0x3e, 0xa1, 0xca, 0xe8, 0xf0, 0x11, 0xac, 0x5d, 0x38, 0xa6, 0x6d,
+	0x5c, 0x39, 0x83, 0x97, 0xe5, 0x0d, 0xb6, 0x56, 0xeb, 0x6c, 0xad, 0x1a, 0x8e, 0x69, 0xb7, 0x9d,
+	0xc1, 0x4b, 0x52, 0xb8, 0xf0, 0xbf, 0xf0, 0x01, 0x64, 0x3d, 0xc7, 0xec, 0xbd, 0x28, 0x6f, 0x32,
+	0x3a, 0xe0, 0x6b, 0x4a, 0x21, 0x84, 0x23, 0xf0, 0x63, 0x28, 0xf6, 0xc6, 0xb6, 0xeb, 0x39, 0x93,
+	0x9e, 0x37, 0x76, 0xca, 0x88, 0xd1, 0x21, 0x46, 0x27, 0x4d, 0xe1, 0x24, 0x4a, 0x44, 0x75, 0xda,
+	0x33, 0x9d, 0x40, 0xee, 0x2d, 0x26, 0xf7, 0x5a, 0xcf, 0x74, 0x7c, 0x01, 0x11, 0xa4, 0x87, 0xe6,
+	0x55, 0x19, 0x33, 0x38, 0xfd, 0xc4, 0xbb, 0x90, 0x73, 0x2d, 0xc6, 0x7f, 0x9b, 0x01, 0xfd, 0x16,
+	0x7e, 0x03, 0x4a, 0x43, 0xf3, 0xca, 0xe8, 0x0f, 0x5c, 0xcf, 0xb4, 0x7b, 0x56, 0x79, 0x87, 0xad,
+	0x5c, 0x71, 0x68, 0x5e, 0xd5, 0x7d, 0x10, 0xf5, 0x8b, 0xde, 0xa5, 0x69, 0xdb, 0xd6, 0xb0, 0x7c,
+	0x9b, 0xfb, 0x85, 0xdf, 0xa4, 0x9d, 0xfd, 0x4f, 0x83, 0x99, 0xed, 0x2e, 0x43, 0x17, 0x7d, 0x18,
+	0x35, 0xd5, 0xea, 0x4f, 0x05, 0x58, 0x8f, 0x5a, 0xb0, 0x89, 0x9f, 0xc2, 0xb6, 0x17, 0x00, 0x8c,
+	0x3e, 0xb5, 0x69, 0x63, 0x64, 0x5e, 0x95, 0xb3, 0x07, 0xe9, 0xc3, 0xe2, 0xe3, 0xb7, 0xe6, 0x4c,
+	0xde, 0x8c, 0x39, 0x40, 0xcb, 0xbc, 0x92, 0x6d, 0xcf, 0xb9, 0x26, 0x5b, 0x5e, 0x1c, 0x5e, 0x79,
+	0x0a, 0xbb, 0xc9, 0xc4, 0x54, 0x21, 0x2f, 0xac, 0x6b, 0xdf, 0x5b, 0xe9, 0x27, 0x7e, 0x2b, 0xb0,
+	0xd5, 0x14, 0xf3, 0x9c, 0xed, 0x04, 0x5f, 0xf3, 0x0d, 0xf8, 0xeb, 0xa9, 0xaf, 0x0a, 0xd5, 0x4f,
+	0x32, 0xb0, 0xc1, 0x4c, 0xb2, 0x73, 0xe9, 0x58, 0xee, 0xe5, 0x78, 0xd8, 0x4f, 0x0c, 0x01, 0x67,
+	0xb0, 0xc5, 0xa7, 0xf4, 0xfa, 0x81, 0x00, 0xf5, 0x63, 0x10, 0xfc, 0x6d, 0x28, 0x8e, 0x3f, 0xb2,
+	0x1c, 0x67, 0xd0, 0xb7, 0x8c, 0xe7, 0xd7, 0x2c, 0x28, 0x14, 0x7d, 0x36, 0xb3, 0xa2, 0x1c, 0x6b,
+	0x3e, 0x59, 0xed, 0x9a, 0xc0, 0x38, 0xfc, 0x8e, 0x5b, 0x56, 0xe6, 0xf5, 0x2d, 0x2b, 0x1b, 0xb7,
+	0xac, 0xd0, 0x9c, 0x73, 0x8b, 0xcc, 0xf9, 0x1d, 0xc0, 0x53, 0x77, 0x37, 0x2c, 0xdb, 0x7c, 0x3e,
+	0xb4, 0xfa, 0x2c, 0x56, 0x14, 0x08, 0x0a, 0xdd, 0x5e, 0xe6, 0x70, 0x7c, 0x08, 0x28, 0x42, 0x1d,
+	0x8d, 0x1e, 0x1b, 0x21, 0xed, 0x07, 0x14, 0x8a, 0x8f, 0x60, 0x2b, 0x8c, 0x13, 0x21, 0x5b, 0x1e,
+	0x4d, 0x36, 0x83, 0x78, 0x11, 0x70, 0xfd, 0x22, 0x6c, 0x4e, 0x69, 0x39, 0x53, 0x60, 0x4c, 0xd7,
+	0x03, 0x4a, 0xc6, 0xb3, 0xd2, 0x03, 0x98, 0xaa, 0x0e, 0x1f, 0xcc, 0xaa, 0x4b, 0x60, 0xbc, 0x97,
+	0x28, 0x27, 0xc5, 0xe3, 0xd1, 0x54, 0x39, 0x3b, 0x81, 0x72, 0xd2, 0x0c, 0xc3, 0x1b, 0xd5, 0x4f,
+	0x04, 0xc8, 0x4b, 0xbe, 0xc7, 0x60, 0xc8, 0xd8, 0xe6, 0xc8, 0x0a, 0x6c, 0x86, 0x7e, 0x53, 0x18,
+	0xf3, 0x1e, 0xbe, 0xe9, 0xb0, 0x6f, 0x1a, 0x14, 0x47, 0x03, 0xdb, 0x17, 0x3d, 0xcd, 0x43, 0xf4,
+	0x68, 0x60, 0x73, 0x4d, 0x50, 0xa4, 0xf9, 0xd2, 0x47, 0x66, 0x7c, 0xa4, 0xf9, 0x92, 0x23, 0x1f,
+	0x42, 0xa1, 0x67, 0x7a, 0xd6, 0xc5, 0xd8, 0xb9, 0x66, 0xab, 0xb7, 0xf1, 0x78, 0x87, 0x2f, 0x38,
+	0x97, 0x40, 0xf2, 0x71, 0x24, 0xa4, 0xc2, 0x77, 0xa0, 0xf0, 0x7c, 0x32, 0x18, 0x7a, 0xc6, 0xc0,
+	0x66, 0xab, 0x5a, 0x20, 0x79, 0xd6, 0x56, 0xec, 0xea, 0x3f, 0xa5, 0x61, 0x8b, 0xa9, 0x4b, 0xb4,
+	0xcd, 0xe1, 0xb5, 0x3b, 0x70, 0x99, 0x07, 0xcf, 0x04, 0x65, 0x21, 0x1e, 0x94, 0xeb, 0x40, 0x2d,
+	0xd9, 0x32, 0x1c, 0xd3, 0xbe, 0xb0, 0x8c, 0xe7, 0xd6, 0xc5, 0xc0, 0x2e, 0xa7, 0x5e, 0xb9, 0x3b,
+	0x6d, 0xd0, 0x3e, 0x84, 0x76, 0xa9, 0xd1, 0x1e, 0xf8, 0x3b, 0xb0, 0x11, 0xe1, 0x62, 0xd9, 0xfd,
+	0x72, 0xfa, 0x95, 0x3c, 0x4a, 0x21, 0x0f, 0xd9, 0xee, 0xe3, 0x27, 0x50, 0xe2, 0xeb, 0xdf, 0x1b,
+	0x4f, 0x6c, 0xcf, 0x2d, 0xe7, 0x59, 0x80, 0xf9, 0xca, 0xd4, 0x7d, 0xa2, 0x73, 0xe2, 0x10, 0x89,
+	0x51, 0xd6, 0xae, 0x23, 0xce, 0x21, 0xda, 0x7d, 0xc9, 0x74, 0x48, 0xd1, 0x9c, 0xe2, 0x2b, 0x3f,
+	0x15, 0xe0, 0xfe, 0x72, 0xfa, 0xb8, 0xe7, 0x09, 0xaf, 0xef, 0x79, 0xa9, 0xb8, 0xe7, 0xcd, 0xd8,
+	0x34, 0x9b, 0x13, 0x53, 0x49, 0x76, 0x6a, 0xd3, 0x4c, 0x9c, 0x98, 0x47, 0x71, 0xc2, 0x0c, 0x23,
+	0x9c, 0x7a, 0x14, 0xa3, 0xac, 0xfe, 0x4d, 0x06, 0xf6, 0xa3, 0xa2, 0xff, 0x2f, 0x5d, 0xe8, 0xcf,
+	0x21, 0xca, 0x3d, 0x8f, 0xd9, 0x4e, 0x8e, 0xd9, 0xce, 0xb7, 0xe3, 0x3c, 0x5f, 0x61, 0x46, 0xf3,
+	0xb9, 0x5e, 0xd4, 0x8a, 0xfe, 0x56, 0x80, 0x7b, 0x4b, 0xc9, 0x93, 0x37, 0x13, 0x61, 0xc5, 0xcd,
+	0x24, 0xc1, 0x7c, 0x52, 0x37, 0x35, 0x9f, 0x74, 0xa2, 0xf9, 0xfc, 0x24, 0x03, 0x58, 0xbf, 0x76,
+	0x3d, 0x6b, 0xa4, 0x7b, 0xa6, 0x37, 0x71, 0x89, 0x75, 0x35, 0x76, 0x3c, 0xac, 0xc1, 0xdd, 0xe9,
+	0xfe, 0xee, 0x5a, 0xce, 0x47, 0x83, 0x9e, 0x65, 0x98, 0xc3, 0xc1, 0x47, 0x96, 0x6d, 0xb9, 0xae,
+	0x2f, 0xff, 0xa6, 0x2f, 0xbf, 0xeb, 0x11, 0xcb, 0x9d, 0x0c, 0x3d, 0x72, 0x27, 0xec, 0xa3, 0xf3,
+	0x2e, 0x62, 0xd0, 0x03, 0xb7, 0xa0, 0x62, 0xfa, 0x3a, 0x4e, 0xe0, 0x97, 0x4a, 0xe6, 0x57, 0x0e,
+	0xba, 0xcc, 0xb1, 0xfb, 0x2e, 0xec, 0x47, 0x72, 0xc1, 0x79, 0x86, 0xe9, 0x64, 0x86, 0x95, 0x69,
+	0xa7, 0x39, 0x96, 0x5f, 0x07, 0xe4, 0x7a, 0xa6, 0xe3, 0x19, 0x53, 0x9a, 0x72, 0x26, 0x99, 0xcd,
+	0x26, 0x23, 0xd4, 0x43, 0x3a, 0xdc, 0x86, 0xfd, 0xab, 0xf1, 0x70, 0x68, 0x9c, 0x8f, 0x9d, 0x48,
+	0x77, 0xa3, 0x37, 0x1e, 0x5d, 0x0d, 0x2d, 0xcf, 0x2a, 0x67, 0x93, 0xf9, 0xdc, 0xa1, 0x9d, 0x4e,
+	0xc6, 0xce, 0x94, 0x93, 0xe4, 0xf7, 0xc0, 0x0a, 0x94, 0x1d, 0xcb, 0x73, 0x06, 0xd6, 0x47, 0x56,
+	0x94, 0x63, 0xdf, 0xf4, 0xcc, 0x72, 0x2e, 0x99, 0xdb, 0x6e, 0xd0, 0x61, 0xca, 0x8e, 0x05, 0x00,
+	0x05, 0xca, 0x31, 0x0e, 0x46, 0xa0, 0xd7, 0x72, 0x7e, 0x01, 0x2b, 0x77, 0x86, 0x45, 0xe0, 0x1d,
+	0xd5, 0x7f, 0x15, 0x00, 0x4d, 0xb9, 0xb7, 0x2c, 0xe6, 0x67, 0x49, 0x29, 0x54, 0x42, 0x52, 0x9f,
+	0x4a, 0x4c, 0xea, 0x63, 0x7e, 0x9f, 0x7e, 0x7d, 0xbf, 0xcf, 0xc4, 0xfd, 0xfe, 0x01, 0x14, 0xcf,
+	0xc7, 0x4e, 0xcf, 0xf2, 0x4f, 0x23, 0x59, 0x16, 0xf2, 0x80, 0x81, 0xc2, 0xc3, 0x8a, 0x3d, 0xe6,
+	0x58, 0xd7, 0xdf, 0x2c, 0x0b, 0xf6, 0x98, 0xe1, 0xdc, 0xea, 0x7f, 0xa6, 0x01, 0x22, 0x2b, 0x9b,
+	0x34, 0xb9, 0x63, 0xd8, 0xee, 0x4f, 0x1c, 0x3e, 0xb5, 0x81, 0x6d, 0x8c, 0x06, 0xf6, 0xc4, 0xb3,
+	0x5c, 0xdf, 0x13, 0xb7, 0x02, 0x94, 0x62, 0xb7, 0x38, 0x02, 0x3f, 0x84, 0xa2, 0x6b, 0xd2, 0x75,
+	0x35, 0x1c, 0xd3, 0xb3, 0x66, 0x6c, 0x53, 0x67, 0x70, 0x42, 0x23, 0x21, 0xb8, 0xe1, 0x37, 0xfe,
+	0x1e, 0x44, 0x2c, 0x95, 0xf5, 0x32, 0x46, 0x93, 0xa1, 0x37, 0xb8, 0x1a, 0x0e, 0xac, 0x20, 0x38,
+	0xde, 0xe3, 0x0c, 0x42, 0x32, 0xda, 0xb1, 0x15, 0x12, 0x91, 0xb2, 0xbb, 0x00, 0x33, 0x7b, 0xf0,
+	0xc9, 0xde, 0xf0, 0xe0, 0xb3, 0x30, 0x53, 0xfc, 0x25, 0xb8, 0x1d, 0x11, 0x75, 0xc4, 0x4c, 0x82,
+	0x9d, 0x05, 0xf8, 0x56, 0x7d, 0x18, 0x93, 0xf2, 0x38, 0x6e, 0x3e, 0xe1, 0x51, 0x60, 0xdb, 0x9d,
+	0xc7, 0x50, 0xf5, 0xbb, 0x96, 0xd5, 0x67, 0xd9, 0x64, 0x9a, 0xb0, 0xef, 0xca, 0xf7, 0xa1, 0xbc,
+	0x88, 0x49, 0xc2, 0x11, 0xe1, 0xed, 0xd9, 0x23, 0xc2, 0xed, 0x98, 0x3c, 0xbc, 0x7f, 0xf4, 0x90,
+	0xf0, 0x8f, 0x19, 0xd8, 0x98, 0xe2, 0x15, 0xfb, 0x7c, 0xfc, 0x73, 0x32, 0x82, 0x99, 0x75, 0xca,
+	0xdc, 0x70, 0x9d, 0xb2, 0x8b, 0xd6, 0xe9, 0x08, 0xb2, 0xae, 0x47, 0x47, 0xce, 0x45, 0xf2, 0xc9,
+	0xe9, 0x3c, 0x69, 0xf4, 0xb7, 0x08, 0x27, 0xc1, 0x12, 0xf0, 0x08, 0x67, 0x4c, 0x4b, 0x13, 0xf9,
+	0x57, 0xe7, 0x04, 0xac, 0x4b, 0xd8, 0xc6, 0xdf, 0x86, 0x75, 0xcb, 0xee, 0x47, 0x58, 0x14, 0x5e,
+	0x9d, 0x12, 0x58, 0x76, 0x7f, 0xca, 0xe0, 0x2d, 0x40, 0x57, 0x96, 0xd3, 0xb3, 0x6c, 0x6f, 0x1a,
+	0x48, 0xd7, 0x58, 0xa2, 0xbc, 0xe9, 0xc3, 0xc3, 0x68, 0x79, 0x04, 0x5b, 0xe7, 0x03, 0xdb, 0x1c,
+	0x1a, 0x2e, 0xdb, 0xc4, 0x0c, 0x56, 0x29, 0x02, 0xb6, 0x5a, 0x9b, 0x0c, 0xc1, 0x37, 0x37, 0x5a,
+	0x27, 0xc2, 0x0f, 0x61, 0x67, 0x86, 0x36, 0x28, 0x17, 0x15, 0x19, 0x39, 0x8e, 0x90, 0xb7, 0x38,
+	0x06, 0x7f, 0x99, 0xd6, 0x94, 0xa8, 0x99, 0xb8, 0xe5, 0x12, 0x33, 0xea, 0x3b, 0x89, 0x46, 0x44,
+	0x4d, 0x85, 0x04, 0x94, 0xa1, 0xe5, 0xae, 0x4f, 0x2d, 0xb7, 0xfa, 0x1f, 0x29, 0xd8, 0x49, 0xea,
+	0x95, 0x68, 0x60, 0xb1, 0xc8, 0x98, 0x7a, 0xfd, 0xc8, 0x98, 0x7e, 0x45, 0x64, 0xcc, 0x2c, 0x8f,
+	0x8c, 0xd9, 0xd9, 0xc8, 0x88, 0xbf, 0x00, 0x1b, 0x0c, 0x63, 0x8c, 0x7b, 0xbd, 0x89, 0xe3, 0x58,
+	0x7d, 0x3f, 0x76, 0xae, 0x33, 0xa8, 0xe6, 0x03, 0xf1, 0xff, 0x85, 0x3d, 0x4e, 0x36, 0x9f, 0xf6,
+	0xe4, 0xd9, 0xf4, 0x6e, 0x33, 0xf4, 0x5c, 0xa2, 0x74, 0x08, 0x28, 0xda, 0x8f, 0x9d, 0xa6, 0x0a,
+	0x7c, 0xcf, 0x98, 0x76, 0xa0, 0xe5, 0x08, 0xba, 0xda, 0x51, 0x4a, 0xee, 0xde, 0xbe, 0x65, 0x4c,
+	0x49, 0xd9, 0x49, 0xaa, 0xba, 0x07, 0xb7, 0xc3, 0x2d, 0x5e, 0xba, 0xb4, 0x7a, 0x2f, 0x08, 0xad,
+	0x02, 0xb9, 0x5e, 0xf5, 0x14, 0x76, 0xe3, 0x08, 0x5e, 0x4c, 0xc4, 0xc7, 0x90, 0xef, 0xf3, 0xa2,
+	0x23, 0x5b, 0x8f, 0xa2, 0xef, 0x2b, 0xb1, 0x82, 0x24, 0x09, 0x88, 0xaa, 0xbf, 0x06, 0xe5, 0xa0,
+	0xc4, 0x14, 0xe6, 0x72, 0xfe, 0x28, 0xf8, 0x6b, 0xb0, 0x31, 0x53, 0x27, 0x31, 0x7d, 0x96, 0x78,
+	0xbe, 0x44, 0x42, 0xd6, 0xa3, 0xb5, 0x10, 0x13, 0x7f, 0x09, 0x0a, 0x66, 0xef, 0x85, 0x31, 0xa2,
+	0xa6, 0xcc, 0x17, 0xbf, 0xc4, 0x3a, 0x89, 0xbd, 0x17, 0x2d, 0x5a, 0xef, 0xcc, 0x9b, 0xfc, 0xa3,
+	0xfa, 0x97, 0x02, 0xdc, 0x49, 0x10, 0xc0, 0x9f, 0x8d, 0x1c, 0x9d, 0x0d, 0x35, 0xde, 0xb7, 0x83,
+	0xd8, 0x90, 0xdc, 0xe1, 0xd8, 0x9f, 0x1f, 0x0f, 0xca, 0x41, 0xdf, 0x4a, 0x1b, 0x4a, 0x51, 0x44,
+	0x42, 0xa0, 0x3d, 0x9a, 0x0d, 0xb4, 0xc9, 0x4a, 0x8b, 0x16, 0x63, 0x04, 0xb8, 0x3f, 0x27, 0x85,
+	0xee, 0x39, 0x96, 0x39, 0x0a, 0xb4, 0xf7, 0x18, 0x6e, 0x3f, 0x37, 0xbd, 0xde, 0xe5, 0x5c, 0x8d,
+	0x4f, 0x60, 0x4e, 0xb5, 0xcd, 0x90, 0xb1, 0xb2, 0xde, 0xbc, 0xc6, 0x53, 0xab, 0x68, 0x3c, 0xbd,
+	0x4c, 0xe3, 0x3f, 0x4a, 0xc3, 0x56, 0xc8, 0xa9, 0x46, 0x85, 0x10, 0x7b, 0x2f, 0xf0, 0x37, 0xe1,
+	0xee, 0xf9, 0xc0, 0x71, 0x3d, 0x63, 0x99, 0xcc, 0x65, 0x46, 0x52, 0x4b, 0x10, 0xfc, 0xff, 0x43,
+	0x65, 0x68, 0x2e, 0xec, 0x9d, 0x62, 0xbd, 0xf7, 0x86, 0x66, 0x72, 0xe7, 0x07, 0x50, 0xe4, 0xce,
+	0x10, 0xcd, 0xf5, 0x81, 0x81, 0xf8, 0x89, 0x20, 0x62, 0xd4, 0x99, 0x1b, 0x18, 0x35, 0x6e, 0xc1,
+	0x7a, 0xe0, 0xbf, 0xbc, 0x57, 0x36, 0xb2, 0x9d, 0xcf, 0xcd, 0xfd, 0xd8, 0xf7, 0xe6, 0x88, 0xe5,
+	0x94, 0xfa, 0x11, 0x50, 0xa5, 0x0b, 0x5b, 0x73, 0x24, 0x9f, 0x81, 0x0d, 0xfd, 0xb6, 0x00, 0x0f,
+	0x16, 0xda, 0xd0, 0x6a, 0xee, 0x8c, 0xbf, 0x02, 0xc0, 0x97, 0xc0, 0xec, 0xbd, 0xa0, 0xfb, 0x39,
+	0x9d, 0xf6, 0x6e, 0xf2, 0xb4, 0xc9, 0xda, 0x73, 0xff, 0xcb, 0xad, 0x36, 0x60, 0x87, 0x4c, 0xec,
+	0x48, 0x3a, 0xe6, 0xdb, 0xf0, 0xbb, 0x00, 0x91, 0x03, 0x05, 0x97, 0x60, 0x33, 0x9e, 0xba, 0x45,
+	0x48, 0xaa, 0x0d, 0xb8, 0x1d, 0x63, 0xb4, 0x62, 0x5c, 0x92, 0xa0, 0xdc, 0xb0, 0xbc, 0xd9, 0x54,
+	0x26, 0x90, 0x2a, 0x21, 0x3f, 0x17, 0x92, 0xf2, 0xf3, 0xea, 0xef, 0x08, 0x70, 0x27, 0x81, 0xcb,
+	0x8a, 0xba, 0xfd, 0xc6, 0xcc, 0xb0, 0x03, 0xfb, 0x7c, 0x3c, 0x53, 0xb9, 0x8d, 0x8d, 0xb2, 0xe1,
+	0xce, 0xb4, 0xab, 0x35, 0xd8, 0x93, 0x68, 0x31, 0x7b, 0x38, 0xaf, 0xe5, 0x1b, 0xcf, 0xe7, 0x7d,
+	0x28, 0xcf, 0xf3, 0x58, 0x51, 0xc1, 0x22, 0xec, 0xb6, 0xcd, 0x89, 0x6b, 0x7d, 0x0a, 0x71, 0x14,
+	0xd8, 0x9b, 0x63, 0xb1, 0xa2, 0x34, 0x35, 0xd8, 0xa3, 0xa7, 0xb7, 0x91, 0xf5, 0xe9, 0xb4, 0x33,
+	0xcf, 0x63, 0x45, 0x79, 0xfe, 0x25, 0x0d, 0xbb, 0xcd, 0x81, 0x1b, 0x31, 0x1d, 0x37, 0x90, 0xe7,
+	0x1d, 0xc8, 0xb1, 0x44, 0x93, 0x6f, 0x49, 0x8b, 0x92, 0x51, 0x9f, 0x66, 0x36, 0x0f, 0x4e, 0x1d,
+	0xa4, 0x97, 0xe5, 0xc1, 0x55, 0xc8, 0xb1, 0x74, 0x97, 0x56, 0x00, 0xd2, 0xb1, 0x44, 0xd8, 0xc7,
+	0xe0, 0xf7, 0xa0, 0x14, 0xc9, 0x99, 0x68, 0x3c, 0x4c, 0x27, 0x66, 0x56, 0x33, 0x54, 0xb8, 0x16,
+	0xcd, 0x89, 0x8d, 0x73, 0x67, 0xcc, 0x4f, 0x96, 0xcb, 0x13, 0xda, 0xf5, 0x30, 0x27, 0x3e, 0x71,
+	0xc6, 0x23, 0xfc, 0x2d, 0x58, 0x8f, 0xf0, 0xf0, 0xc6, 0xe5, 0xdc, 0x2b, 0x39, 0x14, 0x43, 0x0e,
+	0x9d, 0x31, 0x7e, 0x04, 0x79, 0x77, 0xec, 0x78, 0xf4, 0x1e, 0x81, 0x1f, 0xdc, 0xcb, 0x71, 0xc5,
+	0x8d, 0x1d, 0xef, 0x64, 0x60, 0x0d, 0xfb, 0x24, 0x47, 0x09, 0x6b, 0xd7, 0xf8, 0x3e, 0x00, 0xcd,
+	0xc0, 0x2c, 0xbb, 0x3f, 0xb0, 0x2f, 0x58, 0x3e, 0x55, 0x20, 0x11, 0x08, 0xcd, 0xf8, 0xae, 0xcc,
+	0x0b, 0x5a, 0x63, 0xf8, 0x21, 0xcf, 0xa1, 0xb2, 0xa4, 0x40, 0x01, 0xfa, 0xe0, 0x87, 0xec, 0xd2,
+	0x8f, 0x21, 0xbd, 0xf1, 0x0b, 0xcb, 0xf6, 0xf3, 0x69, 0x46, 0xde, 0xa1, 0x80, 0xea, 0x9f, 0x08,
+	0xb0, 0x37, 0xb7, 0xc2, 0x2b, 0x47, 0xdd, 0xe2, 0xd4, 0x16, 0x83, 0xb0, 0x9b, 0x18, 0x15, 0xa2,
+	0x74, 0xb4, 0x20, 0x66, 0x5b, 0x2f, 0x3d, 0x23, 0x22, 0x66, 0x9a, 0x89, 0xb9, 0x4e, 0xc1, 0xed,
+	0x50, 0xd4, 0x3f, 0x5c, 0x83, 0xbd, 0x86, 0xe5, 0xcd, 0x6e, 0xff, 0xbe, 0x35, 0x2e, 0x2f, 0x90,
+	0xde, 0xb8, 0x92, 0x91, 0x54, 0x49, 0x4d, 0x7f, 0x06, 0x95, 0xd4, 0xcc, 0x6b, 0x56, 0x52, 0x3f,
+	0xdb, 0xe3, 0x7d, 0xec, 0x14, 0x92, 0x7f, 0xfd, 0x53, 0x48, 0x21, 0x7e, 0x0a, 0x49, 0xac, 0x88,
+	0xae, 0xad, 0x58, 0x11, 0xad, 0xc1, 0x9a, 0x6b, 0x99, 0x4e, 0xef, 0x92, 0x3a, 0x05, 0x30, 0x55,
+	0x7d, 0x81, 0xcf, 0x36, 0x79, 0xb5, 0x8f, 0x75, 0x46, 0x5d, 0xbb, 0x26, 0x05, 0xd7, 0xff, 0x9a,
+	0xf5, 0x81, 0xe2, 0x52, 0x1f, 0x28, 0xc5, 0x7c, 0x60, 0x2e, 0x98, 0xac, 0xdf, 0x28, 0x98, 0x3c,
+	0x80, 0xe2, 0x54, 0x43, 0x6e, 0x79, 0xe3, 0x20, 0x4d, 0xd3, 0xb5, 0x50, 0x45, 0x34, 0xfd, 0xc2,
+	0x73, 0x3a, 0x72, 0xcb, 0x9b, 0x07, 0xe9, 0x1b, 0x28, 0x69, 0x2b, 0xae, 0x24, 0x37, 0xb8, 0x4a,
+	0x46, 0x49, 0x57, 0xc9, 0x5b, 0x33, 0x57, 0xc9, 0x18, 0x32, 0x43, 0xf3, 0xca, 0x2d, 0x63, 0x26,
+	0x12, 0xfb, 0xa6, 0x77, 0xc7, 0x1c, 0xeb, 0x96, 0xb7, 0x19, 0x38, 0x68, 0xd2, 0x37, 0x08, 0xfe,
+	0x3d, 0xb1, 0x5b, 0xde, 0x39, 0x48, 0x1f, 0xae, 0x91, 0xb0, 0x5d, 0xf9, 0xf3, 0x14, 0x14, 0x02,
+	0x65, 0x53, 0x2d, 0x4e, 0xcd, 0x3a, 0x70, 0xb2, 0xd0, 0x6c, 0xe3, 0x97, 0x76, 0xa9, 0x57, 0x5d,
+	0xda, 0xa5, 0xe3, 0x97, 0x76, 0x6f, 0x27, 0xd9, 0x14, 0x3f, 0xdf, 0xce, 0xdb, 0xcc, 0xdd, 0xb8,
+	0x87, 0x14, 0x22, 0x2e, 0xb1, 0x13, 0x75, 0x89, 0xe0, 0xfa, 0x2f, 0xf6, 0xfc, 0x21, 0xbf, 0xf4,
+	0xf9, 0x43, 0x21, 0xf6, 0xfc, 0xc1, 0x57, 0x3e, 0xbf, 0xe5, 0x8c, 0x29, 0x9f, 0x3f, 0x95, 0xf0,
+	0x5b, 0xd5, 0x3f, 0x15, 0x58, 0xca, 0x16, 0xb3, 0xdb, 0x15, 0x23, 0xea, 0xa7, 0x38, 0x08, 0xdd,
+	0x34, 0xaa, 0xfe, 0x28, 0x05, 0x77, 0xf4, 0xc9, 0x73, 0xaa, 0xd9, 0xe7, 0xd6, 0xdc, 0xd9, 0xf7,
+	0xa6, 0x59, 0xc7, 0x9c, 0x0f, 0xa5, 0x56, 0xf1, 0xa1, 0xf4, 0x0d, 0x7d, 0x28, 0xb3, 0xaa, 0x0f,
+	0x45, 0x6d, 0x3d, 0x3b, 0x6b, 0xeb, 0xd5, 0xbf, 0x12, 0xa0, 0x92, 0xa4, 0x88, 0xff, 0xfe, 0xa5,
+	0xa3, 0x65, 0x49, 0x67, 0x7c, 0x75, 0x65, 0xf5, 0x8d, 0xf8, 0x81, 0x30, 0x4d, 0xb6, 0x7c, 0x54,
+	0x3d, 0x3c, 0x17, 0x56, 0xff, 0x48, 0x80, 0x5d, 0xf9, 0x25, 0xbd, 0xf3, 0x99, 0x5b, 0xbf, 0xf7,
+	0x20, 0x77, 0x3e, 0x18, 0x7a, 0xfe, 0xd1, 0xb5, 0xf8, 0x78, 0x7f, 0x59, 0x5c, 0x25, 0x3e, 0x2d,
+	0x7e, 0x0b, 0x72, 0xe7, 0x63, 0x67, 0x64, 0x7a, 0xe5, 0x54, 0xe4, 0xa5, 0x16, 0x1f, 0xe2, 0x84,
+	0x21, 0x88, 0x4f, 0x40, 0x49, 0x87, 0xe6, 0xf5, 0x78, 0x12, 0x3c, 0x95, 0x8a, 0x92, 0x36, 0x19,
+	0x82, 0xf8, 0x04, 0x55, 0x03, 0xf6, 0xe6, 0xa4, 0x5c, 0x51, 0xb9, 0x3b, 0x90, 0xed, 0x5d, 0x4e,
+	0xec, 0x17, 0x4c, 0xbe, 0x12, 0xe1, 0x0d, 0x5a, 0x27, 0x6a, 0x58, 0x9e, 0x62, 0x5f, 0x58, 0xae,
+	0x47, 0xd3, 0xcf, 0x20, 0x57, 0xad, 0xfe, 0x7d, 0x1a, 0x8a, 0x11, 0x30, 0x35, 0xbb, 0x1f, 0x4c,
+	0xac, 0x89, 0x65, 0xf4, 0xad, 0x2b, 0xef, 0x92, 0x0d, 0x99, 0x25, 0xc0, 0x40, 0x75, 0x0a, 0xa1,
+	0x65, 0x32, 0x4e, 0xd0, 0x33, 0xaf, 0xcc, 0xde, 0xc0, 0xbb, 0x0e, 0xae, 0xe8, 0x18, 0x54, 0xf2,
+	0x81, 0xf8, 0x4d, 0x58, 0x3f, 0x1f, 0x4e, 0xdc, 0x4b, 0xe3, 0xe3, 0xb1, 0xf3, 0x82, 0x1b, 0x30,
+	0xa5, 0x2a, 0x31, 0xe0, 0x87, 0x1c, 0xc6, 0x0a, 0x76, 0x8c, 0x68, 0x7a, 0x03, 0x9c, 0x26, 0xc0,
+	0x40, 0xc1, 0xb1, 0x7e, 0x9b, 0xb5, 0x62, 0xcb, 0x9d, 0xe5, 0xcb, 0xed, 0xa3, 0xa6, 0xcb, 0x4d,
+	0xdf, 0x75, 0x9c, 0x9b, 0x83, 0x61, 0x8c, 0x3c, 0xc7, 0xc8, 0x11, 0xc7, 0x44, 0xa8, 0x1f, 0xc2,
+	0x8e, 0x63, 0xfd, 0xb2, 0xd5, 0xf3, 0x62, 0xf4, 0x79, 0x46, 0x8f, 0x03, 0x5c, 0xa4, 0xc7, 0x23,
+	0xb8, 0xcd, 0x8a, 0x18, 0x5c, 0xea, 0xa1, 0xe9, 0x59, 0x76, 0xef, 0xda, 0x18, 0xb9, 0xfe, 0x73,
+	0x10, 0x4c, 0x91, 0x27, 0x14, 0xd7, 0xe4, 0xa8, 0x96, 0x4b, 0xbb, 0x8c, 0x2c, 0xd3, 0x9e, 0xef,
+	0xc2, 0x2b, 0x7a, 0x98, 0x22, 0x63, 0x5d, 0xde, 0x85, 0x1d, 0xfa, 0x76, 0x62, 0xae, 0x07, 0x7f,
+	0x1e, 0xb2, 0x35, 0x32, 0x5f, 0xce, 0x76, 0xa8, 0xfe, 0x2a, 0xec, 0xc6, 0x57, 0x77, 0x45, 0xeb,
+	0xf9, 0x32, 0x94, 0x06, 0x8c, 0x0d, 0x2b, 0x1f, 0xbb, 0xbe, 0x63, 0xf2, 0x58, 0x15, 0xe5, 0x5f,
+	0x1c, 0x4c, 0x1b, 0xd5, 0x87, 0x70, 0xbb, 0x3d, 0x71, 0x2e, 0xe6, 0x43, 0xe4, 0x1e, 0xe4, 0xfb,
+	0xce, 0xb5, 0xe1, 0x4c, 0x6c, 0x7f, 0x4f, 0xcc, 0xf5, 0x9d, 0x6b, 0x32, 0xb1, 0xab, 0x7f, 0x27,
+	0xc0, 0x6e, 0xbc, 0xcb, 0x8a, 0x12, 0x47, 0xc6, 0x48, 0x45, 0xc7, 0xa0, 0xd5, 0xb5, 0x30, 0xcd,
+	0x4d, 0x08, 0x16, 0xdb, 0x21, 0x32, 0xb2, 0xbe, 0x87, 0x80, 0x1c, 0xcb, 0x1c, 0xce, 0x90, 0x73,
+	0xab, 0xdc, 0xa0, 0xf0, 0x48, 0x60, 0xf9, 0x07, 0x01, 0xee, 0x45, 0x63, 0x85, 0x78, 0x71, 0xe1,
+	0x58, 0x17, 0xa6, 0x67, 0xb9, 0x9f, 0x2e, 0xbe, 0x1c, 0xc3, 0xf6, 0xf3, 0x49, 0xef, 0x85, 0xe5,
+	0x19, 0x1f, 0x0f, 0xfa, 0xde, 0xa5, 0x31, 0x1a, 0x0c, 0x87, 0x03, 0xd7, 0xaf, 0x8f, 0x6d, 0x71,
+	0xd4, 0x87, 0x14, 0xd3, 0x62, 0x08, 0xdc, 0x80, 0x6d, 0x33, 0x18, 0xda, 0x38, 0x9f, 0xd8, 0x3d,
+	0xbe, 0x0d, 0xf0, 0xe3, 0x21, 0xaf, 0xeb, 0x84, 0xa2, 0x9d, 0xf8, 0x68, 0x82, 0xcd, 0x38, 0xc8,
+	0xad, 0xfe, 0xb5, 0x00, 0xe5, 0xf9, 0xd9, 0xd4, 0xd8, 0x80, 0xf8, 0x9b, 0x50, 0xf2, 0xa5, 0xe2,
+	0x89, 0xbf, 0xf0, 0xea, 0x83, 0x1d, 0xa7, 0xe7, 0x59, 0x3f, 0x8d, 0x49, 0xe1, 0x6d, 0x7e, 0x9a,
+	0xf0, 0x06, 0x4d, 0x1c, 0x46, 0xfe, 0x21, 0x42, 0x20, 0xf4, 0x93, 0x41, 0xcc, 0x97, 0xfe, 0x73,
+	0x21, 0xfa, 0x49, 0xf3, 0x35, 0xea, 0x20, 0xfe, 0x0b, 0x50, 0xf6, 0xcd, 0x73, 0x38, 0xd7, 0xf3,
+	0x1f, 0x7e, 0xb2, 0xef, 0xea, 0x3f, 0xa7, 0x92, 0xa4, 0xd7, 0x2d, 0x67, 0x60, 0xb9, 0x9f, 0xc7,
+	0x43, 0x97, 0xc4, 0x24, 0x3f, 0xbd, 0x62, 0x92, 0x1f, 0xbc, 0xa8, 0xcd, 0xdc, 0xe4, 0x45, 0xed,
+	0xff, 0x83, 0x3c, 0x57, 0x6d, 0x50, 0xb3, 0xbc, 0x37, 0x4b, 0x1f, 0x5b, 0x3a, 0x12, 0x50, 0x47,
+	0x9f, 0x48, 0xe6, 0x96, 0x3f, 0x91, 0xcc, 0xcf, 0x3f, 0x91, 0xfc, 0x2d, 0x01, 0xee, 0x2f, 0x32,
+	0xf7, 0x95, 0x8f, 0xc4, 0x39, 0x97, 0xad, 0x4f, 0x39, 0xb5, 0x74, 0x1e, 0x7c, 0x11, 0x89, 0x4f,
+	0x5c, 0xfd, 0x1e, 0xdc, 0x95, 0x1c, 0xcb, 0xf4, 0xac, 0xd9, 0xe7, 0x85, 0x81, 0xd7, 0x7d, 0x03,
+	0xf8, 0x1d, 0x89, 0xe1, 0x05, 0x18, 0x5f, 0x9a, 0xed, 0x84, 0x37, 0x89, 0xfe, 0xd5, 0x4b, 0xd8,
+	0xae, 0xfe, 0xae, 0x00, 0xfb, 0xc9, 0xdc, 0x57, 0xaf, 0x08, 0xc6, 0xc5, 0x49, 0xdd, 0x5c, 0x9c,
+	0x63, 0x96, 0x2f, 0x27, 0x4f, 0x34, 0xe1, 0x4e, 0x2d, 0xa8, 0x66, 0xfe, 0x8f, 0x90, 0xfd, 0x05,
+	0x54, 0x68, 0xf1, 0x64, 0x96, 0x2a, 0x0c, 0x8e, 0xc9, 0xc9, 0xab, 0xb0, 0x62, 0xf2, 0x5a, 0xfd,
+	0x3d, 0x01, 0xee, 0x26, 0x8e, 0xb6, 0xe2, 0xd4, 0xbf, 0x05, 0x28, 0x36, 0xf5, 0xd9, 0x9a, 0x4d,
+	0x6c, 0xee, 0x9b, 0xb3, 0x73, 0x67, 0x46, 0xda, 0xbd, 0xea, 0x7f, 0x8e, 0x46, 0x9a, 0xcc, 0xfd,
+	0xe7, 0xb2, 0xd0, 0x8f, 0xe0, 0x6e, 0xdd, 0x1a, 0x5a, 0x9e, 0x75, 0x73, 0x3b, 0x55, 0x61, 0x3f,
+	0xb9, 0xcb, 0x8a, 0xb5, 0xd8, 0xef, 0xc0, 0x2e, 0xb1, 0x2e, 0x06, 0xae, 0x67, 0x39, 0xfe, 0x13,
+	0xd2, 0x60, 0xf4, 0x2f, 0x4e, 0x83, 0x1e, 0xe7, 0x54, 0x8a, 0x3e, 0x34, 0x0d, 0x43, 0x60, 0xf5,
+	0x07, 0xb0, 0x37, 0xc7, 0x61, 0x45, 0x6d, 0x46, 0x86, 0x4c, 0x2d, 0x1b, 0xf2, 0x0c, 0xb6, 0xa9,
+	0xc9, 0xfa, 0xf0, 0x48, 0xda, 0x00, 0xfe, 0xab, 0xd7, 0x41, 0xac, 0x80, 0x1c, 0x7f, 0x1d, 0x1b,
+	0xa1, 0xab, 0x5e, 0xc1, 0xce, 0x2c, 0xb3, 0x15, 0x85, 0x3f, 0x8c, 0x9c, 0x02, 0xb9, 0xc1, 0xcf,
+	0x4a, 0x3f, 0x3d, 0x13, 0xfe, 0x44, 0x80, 0x6d, 0xc9, 0x74, 0x82, 0xeb, 0xa9, 0xb6, 0x33, 0xbe,
+	0x70, 0x2c, 0xf7, 0x73, 0xd9, 0x6c, 0x45, 0xb8, 0xc7, 0xb2, 0xee, 0x85, 0xff, 0x89, 0xe0, 0x67,
+	0x0b, 0x76, 0xbf, 0x98, 0xfc, 0x6f, 0x88, 0xaa, 0x0c, 0x95, 0x86, 0x15, 0x22, 0x03, 0x61, 0x5f,
+	0xfb, 0x02, 0xe1, 0x57, 0xe0, 0x6e, 0x22, 0x9b, 0x15, 0xb5, 0xfd, 0x0e, 0x64, 0x7a, 0xa6, 0x13,
+	0x68, 0x9a, 0x57, 0xbb, 0x13, 0x74, 0x4a, 0x18, 0x55, 0xf5, 0xdf, 0x04, 0x56, 0xe4, 0x9d, 0x79,
+	0xd0, 0xf9, 0x8b, 0x59, 0xe4, 0xad, 0xfe, 0xbe, 0x00, 0xe5, 0xf9, 0xa9, 0xae, 0xa8, 0xe5, 0x13,
+	0xd8, 0xe6, 0xe1, 0x2d, 0x7c, 0xa1, 0x19, 0xa9, 0x39, 0xec, 0x26, 0xbf, 0xb5, 0x26, 0x5b, 0x66,
+	0x1c, 0x54, 0xfd, 0x59, 0x0a, 0xaa, 0x0d, 0xcb, 0x5b, 0xf4, 0xb6, 0xf6, 0x17, 0xb4, 0xde, 0x1e,
+	0xf3, 0xe7, 0xec, 0xeb, 0xfb, 0x73, 0x2e, 0xe6, 0xcf, 0xf4, 0xf4, 0xf7, 0xe6, 0x52, 0x45, 0xae,
+	0xb8, 0xd0, 0x97, 0xf0, 0x20, 0x22, 0x85, 0xb1, 0x78, 0xd1, 0xdf, 0x78, 0xe5, 0x23, 0x69, 0xb2,
+	0xdf, 0x5b, 0x82, 0xad, 0x7e, 0x8d, 0x1d, 0xb8, 0x67, 0x1f, 0x16, 0xf3, 0xd5, 0xa7, 0x65, 0xbb,
+	0xe1, 0xc0, 0xb2, 0xbd, 0x68, 0x18, 0x01, 0x0e, 0x62, 0x21, 0xe4, 0xc7, 0xdc, 0x8b, 0x67, 0xfb,
+	0xae, 0x38, 0x61, 0x05, 0x76, 0x5c, 0xc6, 0x27, 0x78, 0xec, 0xe5, 0xb0, 0xe7, 0xcd, 0xfe, 0x2c,
+	0xf9, 0x41, 0x62, 0xfe, 0xf5, 0x33, 0xc1, 0xee, 0x1c, 0xec, 0xe8, 0xdf, 0x53, 0x90, 0x65, 0x37,
+	0x23, 0x18, 0x20, 0x27, 0x76, 0xf5, 0x8e, 0xa2, 0xa2, 0x5b, 0xb8, 0x00, 0x99, 0x9a, 0x78, 0xd6,
+	0x45, 0x02, 0xde, 0x83, 0x6d, 0x49, 0xec, 0x88, 0xcd, 0xae, 0xfa, 0x54, 0x34, 0x6a, 0x22, 0x91,
+	0xe4, 0xa6, 0xa6, 0x8a, 0x28, 0x85, 0x37, 0x00, 0x4e, 0x35, 0xe9, 0x4c, 0x56, 0x4f, 0x65, 0xa5,
+	0x85, 0xd2, 0x78, 0x13, 0x8a, 0xa7, 0x5d, 0xb5, 0x21, 0x12, 0x8d, 0x28, 0x6a, 0x03, 0x65, 0x70,
+	0x19, 0x76, 0x14, 0xb5, 0x23, 0x93, 0xa6, 0xd8, 0xd0, 0x74, 0x43, 0x17, 0xbb, 0x46, 0x5b, 0xec,
+	0x36, 0x35, 0x94, 0xa5, 0x5d, 0x5b, 0x22, 0x51, 0x54, 0xca, 0xf0, 0x29, 0xca, 0xe1, 0x75, 0x58,
+	0x6b, 0xc9, 0xcd, 0x9a, 0xd6, 0x25, 0xaa, 0x8c, 0xf2, 0x94, 0x53, 0x4b, 0x7e, 0xa2, 0x48, 0x9a,
+	0x21, 0x29, 0x9d, 0xa7, 0xa8, 0xc0, 0x00, 0x9a, 0xda, 0x91, 0x0d, 0x49, 0x24, 0x4d, 0x0d, 0xad,
+	0xe1, 0x12, 0x14, 0x28, 0x80, 0xc8, 0x62, 0x13, 0x01, 0x5e, 0x83, 0x6c, 0x4b, 0x53, 0x9f, 0x89,
+	0xa8, 0x88, 0xf7, 0xa1, 0x4c, 0x07, 0x31, 0x88, 0x22, 0x89, 0xa4, 0x6e, 0x34, 0x69, 0x17, 0xbd,
+	0x23, 0x37, 0x9b, 0x72, 0x07, 0x95, 0xe8, 0x0c, 0x75, 0xf1, 0xec, 0x54, 0x21, 0x68, 0x9d, 0xb2,
+	0xd0, 0x4f, 0x45, 0xb5, 0x71, 0x2a, 0x2a, 0x68, 0x83, 0x8e, 0xa0, 0x2b, 0xcd, 0x0f, 0x64, 0xa2,
+	0x77, 0x34, 0x55, 0x46, 0x9b, 0x94, 0xa7, 0xae, 0x49, 0xa7, 0x0a, 0x42, 0xf8, 0x36, 0x6c, 0xe9,
+	0x6d, 0xd1, 0x38, 0x21, 0xa2, 0x2a, 0x69, 0x44, 0x3a, 0x15, 0x5b, 0x6d, 0x1d, 0x6d, 0xe1, 0xbb,
+	0xb0, 0xa7, 0xb7, 0x15, 0xb9, 0x59, 0x93, 0x49, 0xc3, 0x20, 0x72, 0xdd, 0xa8, 0x75, 0x9b, 0x74,
+	0x60, 0xb5, 0x81, 0x30, 0x1b, 0xa9, 0xfb, 0xac, 0x7b, 0x26, 0xa2, 0x6d, 0x3a, 0xdb, 0xa7, 0xa2,
+	0x6e, 0xf0, 0x19, 0xa3, 0x9d, 0xa3, 0x3f, 0x4b, 0x41, 0x21, 0xb8, 0xb3, 0xc2, 0x5b, 0xb0, 0xde,
+	0x55, 0x95, 0x8e, 0x5c, 0x37, 0xf4, 0x8e, 0xd8, 0x91, 0x75, 0x74, 0x8b, 0xd2, 0x8b, 0xcf, 0x64,
+	0x52, 0x13, 0x95, 0xf7, 0x45, 0x15, 0x09, 0xb8, 0x08, 0x79, 0xbd, 0x2d, 0xaa, 0x8a, 0x7e, 0x8a,
+	0x52, 0x94, 0x71, 0x43, 0x26, 0x2d, 0x51, 0x45, 0x69, 0xaa, 0x36, 0xae, 0x71, 0x45, 0x54, 0x51,
+	0x86, 0x36, 0x6b, 0x44, 0x7c, 0xa6, 0x34, 0x69, 0x33, 0x4b, 0x9b, 0xba, 0xa2, 0x36, 0xc4, 0xb6,
+	0x46, 0x64, 0x94, 0x63, 0x5c, 0xbb, 0x7a, 0x87, 0x88, 0x0c, 0x9d, 0xa7, 0x5c, 0x99, 0x92, 0x45,
+	0x15, 0x15, 0x28, 0xd7, 0x96, 0xa6, 0x8a, 0x92, 0xaf, 0x5b, 0x49, 0x54, 0xc5, 0x3a, 0x25, 0x03,
+	0x4a, 0xa6, 0x74, 0x78, 0x9f, 0x22, 0x25, 0x3b, 0x21, 0xb2, 0x2a, 0x9d, 0xa2, 0x12, 0x45, 0xd4,
+	0xc4, 0x53, 0x22, 0x2a, 0x2a, 0x5a, 0xa7, 0x0d, 0xe9, 0x54, 0x51, 0x65, 0x5d, 0x46, 0x1b, 0x0c,
+	0x43, 0x94, 0x0e, 0x95, 0x77, 0x93, 0x36, 0x48, 0x57, 0xd7, 0x69, 0x7f, 0xc4, 0x30, 0x72, 0xb3,
+	0x41, 0x1b, 0x5b, 0x74, 0x1c, 0x26, 0x10, 0x6d, 0x61, 0xda, 0x7a, 0x5f, 0x6c, 0x8b, 0x8c, 0xc5,
+	0x36, 0x95, 0x5d, 0xac, 0x75, 0x8d, 0xfa, 0xa9, 0x58, 0x53, 0xd0, 0xce, 0xd1, 0x1f, 0x08, 0x50,
+	0x8c, 0x38, 0x2d, 0x5d, 0x2d, 0xb1, 0xd9, 0x3e, 0x15, 0x0d, 0xa2, 0xb5, 0x64, 0x0d, 0xdd, 0xa2,
+	0x8c, 0x4f, 0x64, 0x42, 0x44, 0xa2, 0x20, 0x81, 0xda, 0xee, 0xa9, 0x28, 0xea, 0x28, 0xc5, 0xe6,
+	0x28, 0x35, 0x45, 0x22, 0x53, 0x6d, 0x51, 0x9b, 0x91, 0x89, 0x24, 0xd7, 0x65, 0x1d, 0x65, 0x30,
+	0x82, 0x12, 0x11, 0x25, 0x45, 0x6d, 0x18, 0x6d, 0x4d, 0x51, 0x3b, 0x28, 0x8b, 0xb7, 0x61, 0x73,
+	0xba, 0x8a, 0x0c, 0x85, 0x72, 0x78, 0x17, 0xb0, 0x2e, 0x75, 0xeb, 0x32, 0x51, 0x44, 0xa3, 0xa3,
+	0x11, 0xcd, 0x20, 0x9a, 0xae, 0xa1, 0x3c, 0x65, 0xf6, 0xa1, 0xd2, 0x6c, 0x2a, 0x62, 0x4b, 0x47,
+	0x85, 0xa3, 0x1f, 0x0b, 0x80, 0xe7, 0xcf, 0xed, 0x38, 0x0b, 0x42, 0x03, 0xdd, 0xa2, 0xd2, 0x9e,
+	0x35, 0x8c, 0xb6, 0x4c, 0x8c, 0x53, 0xad, 0x4b, 0x90, 0x80, 0x31, 0x6c, 0xd4, 0xe5, 0x06, 0x91,
+	0x65, 0x43, 0x92, 0x9b, 0x92, 0xd2, 0xa5, 0xa2, 0xe6, 0x20, 0xd5, 0x7a, 0x1f, 0xa5, 0x71, 0x1e,
+	0xd2, 0xef, 0xb7, 0xa9, 0x80, 0x79, 0x48, 0x93, 0x76, 0x0b, 0x65, 0xe9, 0x47, 0x4d, 0x24, 0x28,
+	0x47, 0x49, 0xce, 0x1a, 0x28, 0x4f, 0x01, 0x67, 0xed, 0x53, 0x54, 0x60, 0x76, 0x2f, 0x77, 0x64,
+	0x82, 0xd6, 0xe8, 0xca, 0x90, 0x60, 0xc9, 0x18, 0x5e, 0x44, 0xc5, 0xa3, 0xdf, 0xcc, 0xc0, 0x9d,
+	0x85, 0xa7, 0x29, 0xaa, 0x9c, 0x86, 0x71, 0xa2, 0x11, 0x49, 0x46, 0xb7, 0xa8, 0x8d, 0xfb, 0x0d,
+	0xa3, 0xae, 0x10, 0x59, 0xea, 0x28, 0x1a, 0x35, 0xbd, 0x2d, 0x58, 0x3f, 0xe9, 0xca, 0x4d, 0x43,
+	0xd2, 0x54, 0xbd, 0xdb, 0x92, 0xeb, 0x28, 0x45, 0x97, 0x86, 0x81, 0x4e, 0x9a, 0xda, 0x87, 0x28,
+	0x4d, 0xc3, 0x83, 0xac, 0x36, 0x14, 0x55, 0x36, 0x24, 0x4d, 0x6b, 0x8a, 0x6a, 0xc7, 0xe8, 0xc8,
+	0xad, 0x36, 0xca, 0x44, 0x10, 0x9a, 0xd2, 0x34, 0xda, 0x44, 0xd6, 0xf5, 0x2e, 0x91, 0xb9, 0x9e,
+	0x23, 0x08, 0x46, 0xcd, 0xac, 0xd3, 0x07, 0xd2, 0x49, 0xe7, 0xe9, 0xc0, 0x35, 0x22, 0x9e, 0xc9,
+	0x0c, 0x6f, 0x9c, 0x10, 0x54, 0x88, 0x83, 0x9a, 0x68, 0x2d, 0x06, 0x22, 0x04, 0x41, 0x1c, 0xd4,
+	0x44, 0x45, 0x1a, 0x87, 0x64, 0x55, 0x26, 0x8d, 0xa7, 0x86, 0xde, 0xd1, 0x88, 0xd8, 0x90, 0x8d,
+	0xa6, 0xfc, 0x81, 0xdc, 0x44, 0x25, 0x2e, 0xe3, 0x0c, 0x86, 0x89, 0xb3, 0xce, 0x02, 0x4e, 0xa3,
+	0x7b, 0x66, 0x68, 0xdd, 0x4e, 0xbb, 0xdb, 0xe1, 0xf1, 0xa1, 0xd5, 0xe8, 0x9e, 0x06, 0x00, 0x1e,
+	0x1f, 0xda, 0xb2, 0x5c, 0x47, 0x08, 0xef, 0x00, 0xea, 0x28, 0x44, 0x0e, 0xe7, 0x48, 0xc5, 0xdd,
+	0x4a, 0x80, 0x36, 0x11, 0x9e, 0x87, 0x12, 0x82, 0xb6, 0x13, 0xa0, 0x4d, 0xb4, 0x43, 0x4d, 0x94,
+	0x41, 0x03, 0x15, 0xdc, 0x8e, 0x41, 0x9a, 0x68, 0x77, 0x16, 0x42, 0x08, 0xda, 0x8b, 0x41, 0x9a,
+	0xa8, 0x7c, 0xf4, 0x31, 0x6c, 0xc6, 0x4e, 0x10, 0x3c, 0xea, 0x48, 0x62, 0x47, 0x6e, 0x68, 0x44,
+	0x79, 0x26, 0xd7, 0xb9, 0x0b, 0x49, 0xa7, 0xa2, 0xae, 0x2b, 0x3a, 0x12, 0xe8, 0x72, 0xb4, 0xb5,
+	0x0f, 0x65, 0x62, 0xd0, 0xd8, 0x84, 0x52, 0x7c, 0xcd, 0x98, 0xa2, 0x88, 0x2c, 0x69, 0x1f, 0xc8,
+	0xe4, 0x29, 0x4a, 0x53, 0x3f, 0xa3, 0x96, 0x80, 0x32, 0xd4, 0xfa, 0x98, 0xd2, 0x75, 0x94, 0xa5,
+	0x8a, 0xa1, 0xe3, 0xeb, 0x28, 0x77, 0xf4, 0x1b, 0x02, 0x94, 0xa2, 0x7f, 0x65, 0xa7, 0x16, 0xac,
+	0x9d, 0xa1, 0x5b, 0x94, 0x46, 0x26, 0x44, 0x23, 0xdc, 0x59, 0x15, 0xf5, 0x44, 0x43, 0x29, 0xfa,
+	0xf5, 0xa1, 0x48, 0xfc, 0xb8, 0x56, 0xef, 0xb6, 0x9b, 0x0a, 0x15, 0x10, 0x65, 0x58, 0x40, 0xd2,
+	0xd4, 0x93, 0xa6, 0x22, 0x75, 0x78, 0x58, 0x53, 0xb5, 0x8e, 0x71, 0xa2, 0x75, 0xd5, 0x3a, 0xca,
+	0xd1, 0xe9, 0xd6, 0x44, 0xe9, 0x2c, 0xb4, 0x2f, 0xe6, 0x9a, 0xa2, 0x24, 0xc9, 0xed, 0x8e, 0x5c,
+	0x47, 0x85, 0xa3, 0x87, 0x00, 0xd3, 0xff, 0x7f, 0xd0, 0x31, 0xda, 0xa2, 0xae, 0xf3, 0x0d, 0xee,
+	0x44, 0x54, 0x9a, 0x7c, 0xae, 0x8a, 0x2a, 0x69, 0xad, 0x76, 0x53, 0xee, 0xc8, 0x28, 0x75, 0xd4,
+	0x8c, 0x3e, 0xc3, 0x8f, 0xfd, 0xc5, 0x20, 0x07, 0xa9, 0x27, 0x8f, 0xd0, 0x2d, 0xf6, 0xfb, 0x18,
+	0x09, 0xec, 0xf7, 0x3d, 0xee, 0xbd, 0x4f, 0xbe, 0xca, 0xbd, 0xf7, 0xc9, 0xa3, 0x87, 0xdc, 0x7b,
+	0x9f, 0x3c, 0x7e, 0x88, 0xb2, 0x47, 0x27, 0x00, 0xd3, 0x67, 0xf0, 0x2c, 0x94, 0x13, 0xe3, 0x91,
+	0xd1, 0xa2, 0x22, 0xd0, 0x1d, 0x88, 0x18, 0x8f, 0x1e, 0xd2, 0x96, 0xc0, 0xc2, 0x35, 0x6d, 0xb1,
+	0x26, 0xdb, 0x5d, 0x79, 0x93, 0xb5, 0xd3, 0x47, 0xbf, 0x0e, 0x9b, 0xb1, 0x77, 0x44, 0x74, 0xea,
+	0x8a, 0xaa, 0x74, 0x14, 0xb1, 0xa9, 0x3c, 0x53, 0x54, 0x3f, 0xd2, 0x28, 0xaa, 0xd1, 0x26, 0x5a,
+	0x83, 0xaa, 0x83, 0x33, 0x0d, 0x66, 0x56, 0xe7, 0xcb, 0x48, 0x27, 0x2d, 0xd7, 0x8d, 0x8e, 0x46,
+	0xf7, 0x1b, 0xd2, 0x41, 0x69, 0x16, 0xd4, 0x19, 0x90, 0x6f, 0x21, 0x92, 0xa8, 0x4a, 0x74, 0xbf,
+	0xac, 0xa3, 0x2c, 0x45, 0xb5, 0xc5, 0xae, 0x2e, 0xd7, 0x51, 0xee, 0x68, 0x08, 0xdb, 0x09, 0xef,
+	0x71, 0x28, 0x4b, 0xc6, 0xc8, 0xe8, 0x28, 0x2d, 0x59, 0xef, 0x88, 0xad, 0x36, 0xba, 0x45, 0xcd,
+	0x4b, 0x56, 0xeb, 0x11, 0x10, 0x4b, 0x23, 0xea, 0x5d, 0x22, 0xd2, 0xb8, 0x62, 0x28, 0xaa, 0xd1,
+	0x52, 0xd4, 0x2e, 0xdd, 0xed, 0x52, 0xd4, 0xf6, 0xdb, 0x34, 0x2c, 0xab, 0x1d, 0x23, 0x5c, 0x84,
+	0xf4, 0x51, 0x1d, 0xb6, 0xe6, 0xaa, 0xd7, 0x54, 0xa9, 0x2d, 0x96, 0x9d, 0xd0, 0x0f, 0xf1, 0x09,
+	0xb7, 0x9e, 0x96, 0x2c, 0xaa, 0xdc, 0x7a, 0x9a, 0xa2, 0x4e, 0xe7, 0xb3, 0x06, 0x59, 0x49, 0xeb,
+	0xaa, 0x1d, 0x94, 0x39, 0x7a, 0x0f, 0x4a, 0xd1, 0x0b, 0x3a, 0xda, 0x4f, 0xd2, 0x3f, 0xe0, 0x5b,
+	0xec, 0xfb, 0xba, 0xa6, 0x1a, 0x4d, 0xba, 0x67, 0xf1, 0x2d, 0xb6, 0x2d, 0x92, 0xef, 0x76, 0xe5,
+	0x0e, 0x4a, 0x1d, 0x55, 0xa1, 0x14, 0xbd, 0xab, 0x63, 0xac, 0x35, 0xa6, 0x5f, 0x6a, 0xa2, 0x4a,
+	0x5d, 0x46, 0xc2, 0xd1, 0x3b, 0x90, 0xf7, 0x5f, 0xcf, 0xb2, 0xcd, 0x48, 0x3a, 0x33, 0xe8, 0xec,
+	0x6a, 0x4d, 0x1a, 0x4b, 0x11, 0x94, 0x28, 0x20, 0x34, 0x42, 0xe1, 0xf1, 0x27, 0x25, 0x40, 0x9d,
+	0xd8, 0x5f, 0xc8, 0xf0, 0x19, 0x6c, 0xcc, 0x3e, 0xd4, 0xc6, 0x15, 0xff, 0x48, 0x92, 0xf0, 0xac,
+	0xbb, 0x72, 0x37, 0x11, 0xc7, 0x7d, 0xab, 0x7a, 0x0b, 0x77, 0x60, 0x6b, 0xee, 0xbd, 0x28, 0xbe,
+	0xb7, 0xe8, 0x45, 0x34, 0x67, 0x79, 0x7f, 0xf9, 0x83, 0xe9, 0xea, 0x2d, 0x7c, 0x09, 0x7b, 0x0b,
+	0x5e, 0xa1, 0xe2, 0x37, 0x93, 0x3b, 0xcf, 0xbc, 0x73, 0xae, 0xfc, 0x9f, 0xe5, 0x44, 0xc1, 0x38,
+	0x87, 0x02, 0xfe, 0x2e, 0xa0, 0xf8, 0x05, 0x09, 0x5e, 0x7a, 0x6f, 0x52, 0xb9, 0xb7, 0x00, 0x1b,
+	0x0a, 0xff, 0x01, 0x6c, 0xf3, 0x81, 0x3e, 0x4b, 0xae, 0x0f, 0x05, 0xdc, 0x83, 0xdd, 0x28, 0x7e,
+	0x5a, 0x10, 0xc7, 0xd5, 0xb9, 0xce, 0x73, 0x97, 0x43, 0x95, 0x37, 0x97, 0xd2, 0x84, 0xc2, 0x3f,
+	0x05, 0x3c, 0x7f, 0xef, 0x8e, 0xf9, 0x8a, 0x2d, 0x7c, 0x99, 0x50, 0x79, 0xb0, 0x10, 0x1f, 0x91,
+	0xbf, 0x0d, 0x9b, 0xb1, 0x2b, 0x67, 0x7c, 0x37, 0x72, 0x41, 0x3d, 0xc7, 0x74, 0x3f, 0x19, 0x19,
+	0xe1, 0x78, 0x06, 0x1b, 0xb3, 0x77, 0x7a, 0xbe, 0x25, 0x27, 0xde, 0x0d, 0x56, 0xee, 0x26, 0xe2,
+	0xc2, 0x99, 0x9f, 0xc1, 0xc6, 0xec, 0x95, 0xa6, 0xcf, 0x2c, 0xf1, 0x16, 0xbb, 0x72, 0x37, 0x11,
+	0x17, 0x32, 0xfb, 0x3e, 0xec, 0x24, 0x55, 0xf5, 0xf1, 0x01, 0x3f, 0x07, 0x2e, 0xbe, 0x4e, 0xa8,
+	0xbc, 0xb1, 0x84, 0x22, 0xea, 0x75, 0x73, 0x55, 0x77, 0x1c, 0x9a, 0x50, 0x32, 0xe3, 0xfb, 0x8b,
+	0xd0, 0x21, 0xd7, 0x67, 0xbc, 0x3e, 0x38, 0x8b, 0x77, 0x31, 0x5f, 0xdc, 0xc5, 0xa5, 0xf5, 0xca,
+	0xc1, 0x62, 0x82, 0xa8, 0x42, 0x92, 0x2a, 0xc8, 0xbe, 0x42, 0x96, 0x94, 0xae, 0x2b, 0x6f, 0x2c,
+	0xa1, 0x88, 0xb2, 0x4f, 0xaa, 0xef, 0xfa, 0xec, 0x97, 0x54, 0x8b, 0x2b, 0x6f, 0x2c, 0xa1, 0x08,
+	0xd9, 0xab, 0xb0, 0x19, 0x2b, 0xd6, 0xfa, 0xa6, 0x9b, 0x5c, 0x04, 0xae, 0xec, 0x27, 0x23, 0x43,
+	0x7e, 0x32, 0x94, 0xa2, 0xc5, 0x53, 0x5c, 0x0e, 0x35, 0x18, 0x2b, 0xce, 0x56, 0xee, 0x24, 0x60,
+	0xa2, 0x0b, 0x96, 0x50, 0x1c, 0xf4, 0x17, 0x6c, 0x71, 0xf5, 0xb1, 0x72, 0xb0, 0x98, 0x20, 0xe0,
+	0xfd, 0xf8, 0x8f, 0x53, 0xb0, 0x29, 0xce, 0xfe, 0x5b, 0xf8, 0xb3, 0xdd, 0x39, 0x78, 0xe4, 0x9d,
+	0xa9, 0x74, 0x4c, 0x63, 0x64, 0x52, 0x9d, 0xab, 0x72, 0x6f, 0x01, 0x36, 0x64, 0xe9, 0xb0, 0x62,
+	0xe9, 0xa2, 0x2a, 0x0b, 0xfe, 0x52, 0xd0, 0xff, 0x15, 0x05, 0xb5, 0xca, 0xe1, 0xab, 0x09, 0x43,
+	0x3d, 0xfd, 0x2c, 0x03, 0x5b, 0x7a, 0xfc, 0x4f, 0xd0, 0x9f, 0xad, 0xa6, 0x4e, 0x61, 0x7d, 0xe6,
+	0x0f, 0x0c, 0x98, 0x1b, 0x45, 0xd2, 0xbf, 0x23, 0x2a, 0x95, 0x24, 0x54, 0x2c, 0x6e, 0xc4, 0xfe,
+	0x8c, 0x19, 0xaa, 0x35, 0xf1, 0x9f, 0x0d, 0x95, 0xfb, 0x8b, 0xd0, 0xd1, 0x95, 0x8c, 0xff, 0x05,
+	0xc0, 0x5f, 0xc9, 0x05, 0xff, 0x2e, 0xa8, 0xdc, 0x5b, 0x80, 0x8d, 0x3a, 0x5c, 0xec, 0x19, 0xbf,
+	0xef, 0x70, 0xc9, 0xff, 0x0f, 0xa8, 0xec, 0x27, 0x23, 0xa3, 0x22, 0xc6, 0xdf, 0xe1, 0xe3, 0xc0,
+	0x49, 0x13, 0x9f, 0xf8, 0x57, 0xee, 0x2d, 0xc0, 0x46, 0x45, 0x8c, 0xbd, 0xd5, 0xf6, 0x45, 0x4c,
+	0x7e, 0xa3, 0x5f, 0xd9, 0x4f, 0x46, 0x86, 0x86, 0xf4, 0x17, 0x02, 0x6c, 0x47, 0x4b, 0x67, 0x9f,
+	0x8b, 0x29, 0xa9, 0xb0, 0x19, 0x2b, 0x05, 0xe2, 0x70, 0x27, 0x4b, 0x28, 0x2e, 0x56, 0xf6, 0x93,
+	0x91, 0x01, 0xbf, 0xe7, 0x39, 0x56, 0xcd, 0xfd, 0xf2, 0x7f, 0x0d, 0x00, 0xad, 0x1d, 0xcc, 0x17,
+	0x94, 0x4c, 0x00, 0x00,
 }
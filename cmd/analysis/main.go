@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"log"
 	"net"
@@ -15,6 +16,7 @@ import (
 	"github.com/bburch01/FOTAAS/internal/app/analysis"
 	"github.com/bburch01/FOTAAS/internal/app/analysis/models"
 	"github.com/bburch01/FOTAAS/internal/pkg/logging"
+	"github.com/bburch01/FOTAAS/internal/pkg/migrate"
 	"github.com/joho/godotenv"
 	"github.com/openzipkin/zipkin-go"
 	"go.uber.org/zap"
@@ -47,6 +49,28 @@ func init() {
 		log.Panicf("failed to initialize logging subsystem with error: %v", err)
 	}
 
+	migrateFlags := migrate.RegisterFlags(flag.CommandLine)
+	flag.Parse()
+
+	if migrateFlags.Enabled() {
+		if err = models.OpenDB(); err != nil {
+			logger.Fatal(fmt.Sprintf("failed to initialize database driver with error: %v", err))
+		}
+		state := "applied"
+		if migrateFlags.DryRun {
+			state = "pending"
+		}
+		migrations, err := models.MigrateDB(migrateFlags.DryRun)
+		for _, m := range migrations {
+			logger.Info(fmt.Sprintf("%v schema migration %v: %v", state, m.Version, m.Description))
+		}
+		if err != nil {
+			logger.Fatal(fmt.Sprintf("failed to migrate database with error: %v", err))
+		}
+		logger.Info(fmt.Sprintf("%v schema migrations %v", len(migrations), state))
+		os.Exit(0)
+	}
+
 	if err = models.InitDB(); err != nil {
 		logger.Fatal(fmt.Sprintf("failed to initialize database driver with error: %v", err))
 	}
@@ -0,0 +1,223 @@
+// Copyright © 2019 NAME HERE <EMAIL ADDRESS>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+func init() {
+
+	// Here you will define your flags and configuration settings.
+
+	// Cobra supports Persistent Flags which will work for this command
+	// and all subcommands, e.g.:
+	// checkServiceAlivenessCmd.PersistentFlags().String("foo", "", "A help for foo")
+
+	// Cobra supports local flags which will only run when this command
+	// is called directly, e.g.:
+	// checkServiceAlivenessCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+
+	rootCmd.AddCommand(createAlarmThresholdCmd)
+	addAlarmThresholdFlags(createAlarmThresholdCmd)
+
+	// Loads values from .env into the system.
+	// NOTE: the .env file must be present in execution directory which is a
+	// deployment issue that will be handled via docker/k8s in production but
+	// the .env file may need to be manually copied into the execution directory
+	// during testing.
+	if err := godotenv.Load(); err != nil {
+		log.Panicf("failed to load environment variables with error: %v", err)
+	}
+}
+
+var createAlarmThresholdCmd = &cobra.Command{
+	Use:   "createAlarmThreshold",
+	Short: "Adds an alarm threshold to the telemetry service alarm threshold registry.",
+	Long: `Adds an alarm threshold for a telemetry datum description to the alarm threshold registry. A
+	 threshold without --constructor, --car-number or --track is the default for its description, with
+	 any of them it overrides the default for that constructor, car and/or track.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+
+		threshold := &api.AlarmThreshold{OverrideBy: &api.AlarmThreshold_OverrideBy{}}
+		if !cmd.Flags().Changed("description") {
+			return errors.New("a telemetry datum description is required")
+		}
+		if err := applyAlarmThresholdFlags(cmd, threshold); err != nil {
+			return err
+		}
+
+		var resp *api.CreateAlarmThresholdResponse
+		err := callTelemetryService(func(ctx context.Context, client api.TelemetryServiceClient) error {
+			var err error
+			resp, err = client.CreateAlarmThreshold(ctx, &api.CreateAlarmThresholdRequest{AlarmThreshold: threshold})
+			return err
+		})
+		if err != nil {
+			log.Printf("create alarm threshold service call failed with error: %v", err)
+			return nil
+		}
+
+		log.Printf("create alarm threshold response code   : %v", resp.Details.Code)
+		log.Printf("create alarm threshold response message: %s", resp.Details.Message)
+		if resp.AlarmThreshold != nil {
+			logAlarmThreshold(resp.AlarmThreshold)
+		}
+		return nil
+	},
+}
+
+// addAlarmThresholdFlags adds the flags that set the fields of an alarm threshold to cmd.
+func addAlarmThresholdFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("description", "t", "", "telemetry datum description (e.g. BRAKE_TEMP_FL)")
+	cmd.Flags().StringP("constructor", "c", "", "override for a constructor (e.g. FERRARI), ANY removes the override")
+	cmd.Flags().Int32P("car-number", "n", 0, "override for a car number, 0 removes the override")
+	cmd.Flags().StringP("track", "r", "", "override for a track (e.g. MONTE_CARLO), ANY removes the override")
+	cmd.Flags().Float64("high", 0, "high alarm value, a value at or above it raises a high alarm")
+	cmd.Flags().Float64("low", 0, "low alarm value, a value at or below it raises a low alarm")
+	cmd.Flags().Bool("no-high", false, "disable the high alarm")
+	cmd.Flags().Bool("no-low", false, "disable the low alarm")
+}
+
+// applyAlarmThresholdFlags sets the fields of threshold for the alarm threshold flags that were set
+// on the command line.
+func applyAlarmThresholdFlags(cmd *cobra.Command, threshold *api.AlarmThreshold) error {
+
+	if threshold.OverrideBy == nil {
+		threshold.OverrideBy = &api.AlarmThreshold_OverrideBy{}
+	}
+
+	if cmd.Flags().Changed("description") {
+		v, _ := cmd.Flags().GetString("description")
+		ordinal, ok := api.TelemetryDatumDescription_value[strings.ToUpper(v)]
+		if !ok {
+			return fmt.Errorf("invalid telemetry datum description specified: %v", v)
+		}
+		threshold.DatumDescription = api.TelemetryDatumDescription(ordinal)
+	}
+
+	if cmd.Flags().Changed("constructor") {
+		v, _ := cmd.Flags().GetString("constructor")
+		if strings.ToUpper(v) == "ANY" {
+			threshold.OverrideBy.Constructor = false
+		} else {
+			ordinal, ok := api.Constructor_value[strings.ToUpper(v)]
+			if !ok {
+				return errors.New("invalid constructor specified, valid constructors are: alpha_romeo, ferrari, haas, mclaren, mercedes, racing_point, red_bull_racing, scuderia_toro_roso, williams")
+			}
+			threshold.OverrideBy.Constructor = true
+			threshold.Constructor = api.Constructor(ordinal)
+		}
+	}
+
+	if cmd.Flags().Changed("car-number") {
+		threshold.CarNumber, _ = cmd.Flags().GetInt32("car-number")
+		threshold.OverrideBy.CarNumber = threshold.CarNumber != 0
+	}
+
+	if cmd.Flags().Changed("track") {
+		v, _ := cmd.Flags().GetString("track")
+		if strings.ToUpper(v) == "ANY" {
+			threshold.OverrideBy.Track = false
+		} else {
+			ordinal, ok := api.Track_value[strings.ToUpper(v)]
+			if !ok {
+				return fmt.Errorf("invalid track specified: %v", v)
+			}
+			threshold.OverrideBy.Track = true
+			threshold.Track = api.Track(ordinal)
+		}
+	}
+
+	if cmd.Flags().Changed("high") {
+		threshold.HighAlarmEnabled = true
+		threshold.HighAlarmValue, _ = cmd.Flags().GetFloat64("high")
+	}
+	if noHigh, _ := cmd.Flags().GetBool("no-high"); noHigh {
+		threshold.HighAlarmEnabled = false
+	}
+
+	if cmd.Flags().Changed("low") {
+		threshold.LowAlarmEnabled = true
+		threshold.LowAlarmValue, _ = cmd.Flags().GetFloat64("low")
+	}
+	if noLow, _ := cmd.Flags().GetBool("no-low"); noLow {
+		threshold.LowAlarmEnabled = false
+	}
+
+	return nil
+}
+
+func logAlarmThreshold(t *api.AlarmThreshold) {
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%v %v", t.Uuid, t.DatumDescription.String()))
+	if t.OverrideBy != nil && t.OverrideBy.Constructor {
+		sb.WriteString(fmt.Sprintf(" constructor: %v", t.Constructor.String()))
+	}
+	if t.OverrideBy != nil && t.OverrideBy.CarNumber {
+		sb.WriteString(fmt.Sprintf(" car number: %v", t.CarNumber))
+	}
+	if t.OverrideBy != nil && t.OverrideBy.Track {
+		sb.WriteString(fmt.Sprintf(" track: %v", t.Track.String()))
+	}
+	if t.HighAlarmEnabled {
+		sb.WriteString(fmt.Sprintf(" high: %v", t.HighAlarmValue))
+	} else {
+		sb.WriteString(" high: disabled")
+	}
+	if t.LowAlarmEnabled {
+		sb.WriteString(fmt.Sprintf(" low: %v", t.LowAlarmValue))
+	} else {
+		sb.WriteString(" low: disabled")
+	}
+
+	log.Print(sb.String())
+}
+
+// callTelemetryService dials the telemetry service and calls fn with a telemetry service client.
+func callTelemetryService(fn func(ctx context.Context, client api.TelemetryServiceClient) error) error {
+
+	var sb strings.Builder
+	sb.WriteString(os.Getenv("TELEMETRY_SERVICE_HOST"))
+	sb.WriteString(":")
+	sb.WriteString(os.Getenv("TELEMETRY_SERVICE_PORT"))
+	telemetrySvcEndpoint := sb.String()
+
+	conn, err := grpc.Dial(telemetrySvcEndpoint, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// TODO: determine what the appropriate deadline should be for this service call.
+	clientDeadline := time.Now().Add(time.Duration(300) * time.Second)
+	ctx, cancel := context.WithDeadline(context.Background(), clientDeadline)
+
+	defer cancel()
+
+	return fn(ctx, api.NewTelemetryServiceClient(conn))
+}
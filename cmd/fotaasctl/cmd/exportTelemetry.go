@@ -0,0 +1,184 @@
+// Copyright © 2019 NAME HERE <EMAIL ADDRESS>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+func init() {
+
+	// Here you will define your flags and configuration settings.
+
+	// Cobra supports Persistent Flags which will work for this command
+	// and all subcommands, e.g.:
+	// checkServiceAlivenessCmd.PersistentFlags().String("foo", "", "A help for foo")
+
+	// Cobra supports local flags which will only run when this command
+	// is called directly, e.g.:
+	// checkServiceAlivenessCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+
+	rootCmd.AddCommand(exportTelemetryCmd)
+	exportTelemetryCmd.Flags().StringP("start-date", "s", "", "telemetry data start date (yyyy-mm-dd)")
+	exportTelemetryCmd.Flags().StringP("end-date", "e", "", "telemetry data end date (yyyy-mm-dd)")
+	exportTelemetryCmd.Flags().StringSliceP("constructor", "c", nil, "comma separated constructors (e.g. MERCEDES,FERRARI)")
+	exportTelemetryCmd.Flags().IntSliceP("car-number", "n", nil, "comma separated car numbers (e.g. 44,77)")
+	exportTelemetryCmd.Flags().IntSlice("lap", nil, "comma separated lap numbers (e.g. 12,13)")
+	exportTelemetryCmd.Flags().IntSlice("sector", nil, "comma separated lap sectors, 1 to 3 (e.g. 2)")
+	exportTelemetryCmd.Flags().StringSliceP("description", "t", nil, "comma separated telemetry datum descriptions (e.g. BRAKE_TEMP_FL,BRAKE_TEMP_FR)")
+	exportTelemetryCmd.Flags().StringSlice("channel", nil, "comma separated channel registry names (e.g. TURBO_BOOST_PRESSURE)")
+	exportTelemetryCmd.Flags().BoolP("simulated", "i", false, "export simulated telemetry data")
+	exportTelemetryCmd.Flags().StringP("simulation-id", "d", "", "export telemetry data for a specific simulation uuid")
+	exportTelemetryCmd.Flags().BoolP("alarms-only", "a", false, "only export telemetry data with a high or low alarm")
+	exportTelemetryCmd.Flags().Int32P("page-size", "p", 0, "number of telemetry datum per page read by the telemetry service (default is the telemetry service default)")
+	exportTelemetryCmd.Flags().StringP("format", "f", "csv", "export file format, csv, jsonl or parquet")
+	exportTelemetryCmd.Flags().StringP("layout", "l", "long", "export file layout, long (one row per telemetry datum) or wide (one row per car and timestamp)")
+	exportTelemetryCmd.Flags().StringP("output", "o", "", "export file path (required)")
+
+	// Loads values from .env into the system.
+	// NOTE: the .env file must be present in execution directory which is a
+	// deployment issue that will be handled via docker/k8s in production but
+	// the .env file may need to be manually copied into the execution directory
+	// during testing.
+	if err := godotenv.Load(); err != nil {
+		log.Panicf("failed to load environment variables with error: %v", err)
+	}
+}
+
+var exportFormats = map[string]api.ExportFormat{
+	"csv":     api.ExportFormat_CSV,
+	"jsonl":   api.ExportFormat_JSON_LINES,
+	"parquet": api.ExportFormat_PARQUET,
+}
+
+var exportTelemetryCmd = &cobra.Command{
+	Use:   "exportTelemetry",
+	Short: "Exports telemetry data to a CSV, JSON Lines or Parquet file.",
+	Long: `Exports telemetry data from the telemetry service to a local CSV, JSON Lines or Apache Parquet file,
+	 in long (one row per telemetry datum) or wide (one column per telemetry datum description) layout. The data
+	 can be filtered the same as getTelemetryData.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+
+		filter, err := newGetTelemetryDataRequest(cmd)
+		if err != nil {
+			return err
+		}
+
+		req := &api.ExportTelemetryRequest{Filter: filter}
+
+		format, _ := cmd.Flags().GetString("format")
+		var ok bool
+		if req.Format, ok = exportFormats[strings.ToLower(format)]; !ok {
+			return fmt.Errorf("invalid format specified: %v, valid formats are: csv, jsonl, parquet", format)
+		}
+
+		layout, _ := cmd.Flags().GetString("layout")
+		layoutOrdinal, ok := api.ExportLayout_value[strings.ToUpper(layout)]
+		if !ok {
+			return fmt.Errorf("invalid layout specified: %v, valid layouts are: long, wide", layout)
+		}
+		req.Layout = api.ExportLayout(layoutOrdinal)
+
+		output, _ := cmd.Flags().GetString("output")
+		if output == "" {
+			return fmt.Errorf("an output file must be specified")
+		}
+
+		file, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+
+		msg, err := exportTelemetry(req, file)
+		if cerr := file.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			// Don't leave an incomplete export file behind.
+			os.Remove(output)
+			log.Printf("export telemetry service call failed with error: %v", err)
+			return nil
+		}
+
+		log.Printf("%v to %v", msg, output)
+
+		return nil
+	},
+}
+
+// exportTelemetry writes the exported file chunks to w and returns the telemetry service's final
+// message.
+func exportTelemetry(req *api.ExportTelemetryRequest, w io.Writer) (string, error) {
+
+	var sb strings.Builder
+	sb.WriteString(os.Getenv("TELEMETRY_SERVICE_HOST"))
+	sb.WriteString(":")
+	sb.WriteString(os.Getenv("TELEMETRY_SERVICE_PORT"))
+	telemetrySvcEndpoint := sb.String()
+
+	conn, err := grpc.Dial(telemetrySvcEndpoint, grpc.WithInsecure())
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	// Exports of whole races take a while, allow more time than the other telemetry data calls.
+	clientDeadline := time.Now().Add(time.Duration(30) * time.Minute)
+	ctx, cancel := context.WithDeadline(context.Background(), clientDeadline)
+
+	defer cancel()
+
+	var client = api.NewTelemetryServiceClient(conn)
+
+	stream, err := client.ExportTelemetry(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	var msg string
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return msg, nil
+		}
+		if err != nil {
+			return "", err
+		}
+
+		if resp.Details.Code != api.ResponseCode_OK {
+			return "", fmt.Errorf("telemetry service response code: %v message: %v",
+				resp.Details.Code.String(), resp.Details.Message)
+		}
+
+		if len(resp.Chunk) == 0 {
+			msg = resp.Details.Message
+			continue
+		}
+		if _, err = w.Write(resp.Chunk); err != nil {
+			return "", err
+		}
+	}
+}
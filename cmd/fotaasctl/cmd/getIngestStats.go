@@ -0,0 +1,122 @@
+// Copyright © 2019 NAME HERE <EMAIL ADDRESS>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+func init() {
+
+	// Here you will define your flags and configuration settings.
+
+	// Cobra supports Persistent Flags which will work for this command
+	// and all subcommands, e.g.:
+	// checkServiceAlivenessCmd.PersistentFlags().String("foo", "", "A help for foo")
+
+	// Cobra supports local flags which will only run when this command
+	// is called directly, e.g.:
+	// checkServiceAlivenessCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+
+	rootCmd.AddCommand(getIngestStatsCmd)
+
+	// Loads values from .env into the system.
+	// NOTE: the .env file must be present in execution directory which is a
+	// deployment issue that will be handled via docker/k8s in production but
+	// the .env file may need to be manually copied into the execution directory
+	// during testing.
+	if err := godotenv.Load(); err != nil {
+		log.Panicf("failed to load environment variables with error: %v", err)
+	}
+}
+
+var getIngestStatsCmd = &cobra.Command{
+	Use:   "getIngestStats",
+	Short: "Retrieves the telemetry service ingest queue statistics.",
+	Long: `Retrieves the telemetry service ingest queue depth and capacity, the number of telemetry datum
+	 flushed, failed and refused with BACKPRESSURE, and the flush latencies.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+
+		resp, err := getIngestStats()
+		if err != nil {
+			log.Printf("get ingest stats service call failed with error: %v", err)
+			return nil
+		}
+
+		log.Printf("get ingest stats response code   : %v", resp.Details.Code)
+		log.Printf("get ingest stats response message: %s", resp.Details.Message)
+
+		if resp.Details.Code != api.ResponseCode_OK {
+			return nil
+		}
+
+		stats := resp.IngestStats
+		log.Printf("queue depth         : %v", stats.QueueDepth)
+		log.Printf("queue capacity      : %v", stats.QueueCapacity)
+		log.Printf("flush workers       : %v", stats.FlushWorkers)
+		log.Printf("flush count         : %v", stats.FlushCount)
+		log.Printf("flushed datum count : %v", stats.FlushedDatumCount)
+		log.Printf("failed datum count  : %v", stats.FailedDatumCount)
+		log.Printf("rejected datum count: %v", stats.RejectedDatumCount)
+		log.Printf("last flush latency  : %.3f ms", stats.LastFlushLatencyMs)
+		log.Printf("mean flush latency  : %.3f ms", stats.MeanFlushLatencyMs)
+		log.Printf("max flush latency   : %.3f ms", stats.MaxFlushLatencyMs)
+
+		return nil
+	},
+}
+
+func getIngestStats() (*api.GetIngestStatsResponse, error) {
+
+	req := new(api.GetIngestStatsRequest)
+
+	var sb strings.Builder
+	sb.WriteString(os.Getenv("TELEMETRY_SERVICE_HOST"))
+	sb.WriteString(":")
+	sb.WriteString(os.Getenv("TELEMETRY_SERVICE_PORT"))
+	telemetrySvcEndpoint := sb.String()
+
+	conn, err := grpc.Dial(telemetrySvcEndpoint, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	// TODO: determine what the appropriate deadline should be for this service call.
+	clientDeadline := time.Now().Add(time.Duration(30) * time.Second)
+	ctx, cancel := context.WithDeadline(context.Background(), clientDeadline)
+
+	defer cancel()
+
+	var client = api.NewTelemetryServiceClient(conn)
+
+	var resp *api.GetIngestStatsResponse
+	resp, err = client.GetIngestStats(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+
+}
@@ -87,8 +87,13 @@ var getSimulationInfoCmd = &cobra.Command{
 				log.Printf("\nstart timestamp     : %v ", ipbts.TimestampString(resp.SimulationInfo.StartTimestamp))
 				log.Printf("\nend timestamp       : %v ", ipbts.TimestampString(resp.SimulationInfo.EndTimestamp))
 				log.Printf("\npercent complete    : %v ", resp.SimulationInfo.PercentComplete)
+				log.Printf("\nseed                : %v ", resp.SimulationInfo.Seed)
 				log.Printf("\nfinal info code   : %v ", resp.SimulationInfo.FinalStatusCode)
 				log.Printf("\nfinal info message: %v ", resp.SimulationInfo.FinalStatusMessage)
+				for _, m := range resp.SimulationInfo.Members {
+					log.Printf("\nmember              : %v #%v %v alarm occurred: %v ", m.Constructor, m.CarNumber, m.Uuid,
+						m.AlarmOccurred)
+				}
 				log.Print("\n")
 
 			}
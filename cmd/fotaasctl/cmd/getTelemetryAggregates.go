@@ -0,0 +1,139 @@
+// Copyright © 2019 NAME HERE <EMAIL ADDRESS>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	ipbts "github.com/bburch01/FOTAAS/internal/pkg/protobuf/timestamp"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+func init() {
+
+	// Here you will define your flags and configuration settings.
+
+	// Cobra supports Persistent Flags which will work for this command
+	// and all subcommands, e.g.:
+	// checkServiceAlivenessCmd.PersistentFlags().String("foo", "", "A help for foo")
+
+	// Cobra supports local flags which will only run when this command
+	// is called directly, e.g.:
+	// checkServiceAlivenessCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+
+	rootCmd.AddCommand(getTelemetryAggregatesCmd)
+	getTelemetryAggregatesCmd.Flags().StringP("start-date", "s", "", "telemetry data start date (yyyy-mm-dd)")
+	getTelemetryAggregatesCmd.Flags().StringP("end-date", "e", "", "telemetry data end date (yyyy-mm-dd)")
+	getTelemetryAggregatesCmd.Flags().StringSliceP("constructor", "c", nil, "comma separated constructors (e.g. MERCEDES,FERRARI)")
+	getTelemetryAggregatesCmd.Flags().IntSliceP("car-number", "n", nil, "comma separated car numbers (e.g. 44,77)")
+	getTelemetryAggregatesCmd.Flags().StringSliceP("description", "t", nil, "comma separated telemetry datum descriptions (e.g. BRAKE_TEMP_FL,BRAKE_TEMP_FR)")
+	getTelemetryAggregatesCmd.Flags().StringSlice("channel", nil, "comma separated channel registry names (e.g. TURBO_BOOST_PRESSURE)")
+	getTelemetryAggregatesCmd.Flags().BoolP("simulated", "i", false, "aggregate simulated telemetry data")
+	getTelemetryAggregatesCmd.Flags().StringP("simulation-id", "d", "", "aggregate telemetry data for a specific simulation uuid")
+	getTelemetryAggregatesCmd.Flags().BoolP("alarms-only", "a", false, "only aggregate telemetry data with a high or low alarm")
+	getTelemetryAggregatesCmd.Flags().Int64P("bucket-width", "b", 1000, "bucket width in milliseconds")
+	getTelemetryAggregatesCmd.Flags().StringSliceP("functions", "f", nil, "comma separated aggregate functions, min, max, mean, last, count (default is all)")
+
+	// Loads values from .env into the system.
+	// NOTE: the .env file must be present in execution directory which is a
+	// deployment issue that will be handled via docker/k8s in production but
+	// the .env file may need to be manually copied into the execution directory
+	// during testing.
+	if err := godotenv.Load(); err != nil {
+		log.Panicf("failed to load environment variables with error: %v", err)
+	}
+}
+
+var getTelemetryAggregatesCmd = &cobra.Command{
+	Use:   "getTelemetryAggregates",
+	Short: "Gets time bucketed telemetry aggregates from the telemetry service.",
+	Long: `Gets the min, max, mean, last value and count of telemetry data per time bucket for each car and
+	 telemetry datum description. The data can be filtered the same way as with getTelemetryData.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+
+		filter, err := newGetTelemetryDataRequest(cmd)
+		if err != nil {
+			return err
+		}
+
+		req := new(api.GetTelemetryAggregatesRequest)
+		req.Filter = filter
+		req.BucketWidthMillis, _ = cmd.Flags().GetInt64("bucket-width")
+
+		functions, _ := cmd.Flags().GetStringSlice("functions")
+		for _, v := range functions {
+			functionOrdinal, ok := api.AggregateFunction_value[strings.ToUpper(v)]
+			if !ok {
+				return fmt.Errorf("invalid aggregate function specified: %v, valid functions are: min, max, mean, last, count", v)
+			}
+			req.AggregateFunctions = append(req.AggregateFunctions, api.AggregateFunction(functionOrdinal))
+		}
+
+		var sb strings.Builder
+		sb.WriteString(os.Getenv("TELEMETRY_SERVICE_HOST"))
+		sb.WriteString(":")
+		sb.WriteString(os.Getenv("TELEMETRY_SERVICE_PORT"))
+		telemetrySvcEndpoint := sb.String()
+
+		conn, err := grpc.Dial(telemetrySvcEndpoint, grpc.WithInsecure())
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		// TODO: determine what the appropriate deadline should be for this service call.
+		clientDeadline := time.Now().Add(time.Duration(300) * time.Second)
+		ctx, cancel := context.WithDeadline(context.Background(), clientDeadline)
+
+		defer cancel()
+
+		var client = api.NewTelemetryServiceClient(conn)
+
+		resp, err := client.GetTelemetryAggregates(ctx, req)
+		if err != nil {
+			log.Printf("get telemetry aggregates service call failed with error: %v", err)
+			return nil
+		}
+
+		if resp.Details.Code != api.ResponseCode_OK {
+			log.Printf("telemetry service response code: %v", resp.Details.Code.String())
+			log.Printf("telemetry service response message: %v", resp.Details.Message)
+			return nil
+		}
+
+		for _, s := range resp.Series {
+			name, unit := s.DatumDescription.String(), s.Unit.String()
+			if s.Channel != "" {
+				name, unit = s.Channel, s.ChannelUnit
+			}
+			log.Printf("%v #%v %v (%v):", s.Constructor.String(), s.CarNumber, name, unit)
+			for _, b := range s.Buckets {
+				log.Printf("  %v count: %v min: %v max: %v mean: %v last: %v", ipbts.TimestampString(b.BucketBegin),
+					b.Count, b.Min, b.Max, b.Mean, b.Last)
+			}
+		}
+
+		return nil
+	},
+}
@@ -0,0 +1,252 @@
+// Copyright © 2019 NAME HERE <EMAIL ADDRESS>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	ipbts "github.com/bburch01/FOTAAS/internal/pkg/protobuf/timestamp"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/google/uuid"
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+func init() {
+
+	// Here you will define your flags and configuration settings.
+
+	// Cobra supports Persistent Flags which will work for this command
+	// and all subcommands, e.g.:
+	// checkServiceAlivenessCmd.PersistentFlags().String("foo", "", "A help for foo")
+
+	// Cobra supports local flags which will only run when this command
+	// is called directly, e.g.:
+	// checkServiceAlivenessCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+
+	rootCmd.AddCommand(getTelemetryDataCmd)
+	getTelemetryDataCmd.Flags().StringP("start-date", "s", "", "telemetry data start date (yyyy-mm-dd)")
+	getTelemetryDataCmd.Flags().StringP("end-date", "e", "", "telemetry data end date (yyyy-mm-dd)")
+	getTelemetryDataCmd.Flags().StringSliceP("constructor", "c", nil, "comma separated constructors (e.g. MERCEDES,FERRARI)")
+	getTelemetryDataCmd.Flags().IntSliceP("car-number", "n", nil, "comma separated car numbers (e.g. 44,77)")
+	getTelemetryDataCmd.Flags().IntSlice("lap", nil, "comma separated lap numbers (e.g. 12,13)")
+	getTelemetryDataCmd.Flags().IntSlice("sector", nil, "comma separated lap sectors, 1 to 3 (e.g. 2)")
+	getTelemetryDataCmd.Flags().StringSliceP("description", "t", nil, "comma separated telemetry datum descriptions (e.g. BRAKE_TEMP_FL,BRAKE_TEMP_FR)")
+	getTelemetryDataCmd.Flags().StringSlice("channel", nil, "comma separated channel registry names (e.g. TURBO_BOOST_PRESSURE)")
+	getTelemetryDataCmd.Flags().BoolP("simulated", "i", false, "get simulated telemetry data")
+	getTelemetryDataCmd.Flags().StringP("simulation-id", "d", "", "get telemetry data for a specific simulation uuid")
+	getTelemetryDataCmd.Flags().BoolP("alarms-only", "a", false, "only get telemetry data with a high or low alarm")
+	getTelemetryDataCmd.Flags().Int32P("page-size", "p", 0, "number of telemetry datum per streamed page (default is the telemetry service default)")
+	getTelemetryDataCmd.Flags().BoolP("count-only", "o", false, "only print the number of telemetry datum found")
+
+	// Loads values from .env into the system.
+	// NOTE: the .env file must be present in execution directory which is a
+	// deployment issue that will be handled via docker/k8s in production but
+	// the .env file may need to be manually copied into the execution directory
+	// during testing.
+	if err := godotenv.Load(); err != nil {
+		log.Panicf("failed to load environment variables with error: %v", err)
+	}
+}
+
+var getTelemetryDataCmd = &cobra.Command{
+	Use:   "getTelemetryData",
+	Short: "Streams telemetry data from the telemetry service.",
+	Long: `Streams telemetry data from the telemetry service page by page. The data can be filtered by
+	 date range, simulation, constructor, car number, lap, sector, telemetry datum description, registered
+	 channel and alarm state.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+
+		req, err := newGetTelemetryDataRequest(cmd)
+		if err != nil {
+			return err
+		}
+
+		countOnly, _ := cmd.Flags().GetBool("count-only")
+
+		datumCount, err := streamTelemetryData(req, func(v *api.TelemetryDatum) {
+			if countOnly {
+				return
+			}
+			name, unit := datumChannel(v)
+			log.Printf("%v %v #%v lap %v sector %v %v: %v %v high alarm: %v low alarm: %v", ipbts.TimestampString(v.Timestamp),
+				v.Constructor.String(), v.CarNumber, v.Lap, v.Sector, name, v.Value, unit, v.HighAlarm, v.LowAlarm)
+		})
+		if err != nil {
+			log.Printf("get telemetry data service call failed with error: %v", err)
+			return nil
+		}
+
+		log.Printf("telemetry datum count: %v", datumCount)
+
+		return nil
+	},
+}
+
+// newGetTelemetryDataRequest builds a GetTelemetryDataRequest from the telemetry data filter flags
+// shared by the commands that retrieve telemetry data.
+func newGetTelemetryDataRequest(cmd *cobra.Command) (*api.GetTelemetryDataRequest, error) {
+
+	req := new(api.GetTelemetryDataRequest)
+	req.SearchBy = new(api.GetTelemetryDataRequest_SearchBy)
+
+	startDate, _ := cmd.Flags().GetString("start-date")
+	endDate, _ := cmd.Flags().GetString("end-date")
+	if startDate != "" || endDate != "" {
+		startTime, err := time.Parse(time.RFC3339, startDate+"T00:00:00Z")
+		if err != nil {
+			return nil, errors.New("invalid start-date specified, format is yyyy-mm-dd")
+		}
+		endTime, err := time.Parse(time.RFC3339, endDate+"T23:59:59Z")
+		if err != nil {
+			return nil, errors.New("invalid end-date specified, format is yyyy-mm-dd")
+		}
+		if req.DateRangeBegin, err = ipbts.TimestampProto(startTime); err != nil {
+			return nil, err
+		}
+		if req.DateRangeEnd, err = ipbts.TimestampProto(endTime); err != nil {
+			return nil, err
+		}
+		req.SearchBy.DateRange = true
+	}
+
+	req.Simulated, _ = cmd.Flags().GetBool("simulated")
+
+	simID, _ := cmd.Flags().GetString("simulation-id")
+	if simID != "" {
+		if _, err := uuid.Parse(simID); err != nil {
+			return nil, fmt.Errorf("invalid simulation id: %v", err)
+		}
+		req.SimulationUuid = simID
+	}
+
+	constructors, _ := cmd.Flags().GetStringSlice("constructor")
+	for _, v := range constructors {
+		constructorOrdinal, ok := api.Constructor_value[strings.ToUpper(v)]
+		if !ok {
+			return nil, errors.New("invalid constructor specified, valid constructors are: alpha_romeo, ferrari, haas, mclaren, mercedes, racing_point, red_bull_racing, scuderia_toro_roso, williams")
+		}
+		req.Constructors = append(req.Constructors, api.Constructor(constructorOrdinal))
+	}
+
+	carNumbers, _ := cmd.Flags().GetIntSlice("car-number")
+	for _, v := range carNumbers {
+		req.CarNumbers = append(req.CarNumbers, int32(v))
+	}
+
+	laps, _ := cmd.Flags().GetIntSlice("lap")
+	for _, v := range laps {
+		req.Laps = append(req.Laps, int32(v))
+	}
+
+	sectors, _ := cmd.Flags().GetIntSlice("sector")
+	for _, v := range sectors {
+		if v < 1 || v > 3 {
+			return nil, fmt.Errorf("invalid sector specified: %v, valid sectors are 1 to 3", v)
+		}
+		req.Sectors = append(req.Sectors, int32(v))
+	}
+
+	descriptions, _ := cmd.Flags().GetStringSlice("description")
+	for _, v := range descriptions {
+		descriptionOrdinal, ok := api.TelemetryDatumDescription_value[strings.ToUpper(v)]
+		if !ok {
+			return nil, fmt.Errorf("invalid telemetry datum description specified: %v", v)
+		}
+		req.DatumDescriptions = append(req.DatumDescriptions, api.TelemetryDatumDescription(descriptionOrdinal))
+	}
+
+	req.Channels, _ = cmd.Flags().GetStringSlice("channel")
+
+	if alarmsOnly, _ := cmd.Flags().GetBool("alarms-only"); alarmsOnly {
+		req.SearchBy.HighAlarm = true
+		req.SearchBy.LowAlarm = true
+	}
+
+	req.PageSize, _ = cmd.Flags().GetInt32("page-size")
+
+	return req, nil
+}
+
+// datumChannel returns the channel name and unit of v, its description and unit for a built in
+// channel.
+func datumChannel(v *api.TelemetryDatum) (string, string) {
+	if v.Channel != "" {
+		return v.Channel, v.ChannelUnit
+	}
+	return v.Description.String(), v.Unit.String()
+}
+
+func streamTelemetryData(req *api.GetTelemetryDataRequest, fn func(*api.TelemetryDatum)) (int, error) {
+
+	var datumCount int
+
+	var sb strings.Builder
+	sb.WriteString(os.Getenv("TELEMETRY_SERVICE_HOST"))
+	sb.WriteString(":")
+	sb.WriteString(os.Getenv("TELEMETRY_SERVICE_PORT"))
+	telemetrySvcEndpoint := sb.String()
+
+	conn, err := grpc.Dial(telemetrySvcEndpoint, grpc.WithInsecure())
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	// TODO: determine what the appropriate deadline should be for this service call.
+	clientDeadline := time.Now().Add(time.Duration(300) * time.Second)
+	ctx, cancel := context.WithDeadline(context.Background(), clientDeadline)
+
+	defer cancel()
+
+	var client = api.NewTelemetryServiceClient(conn)
+
+	stream, err := client.StreamTelemetryData(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return datumCount, nil
+		}
+		if err != nil {
+			return datumCount, err
+		}
+
+		if resp.Details.Code != api.ResponseCode_OK {
+			return datumCount, fmt.Errorf("telemetry service response code: %v message: %v",
+				resp.Details.Code.String(), resp.Details.Message)
+		}
+
+		if resp.TelemetryData == nil {
+			continue
+		}
+		for _, v := range resp.TelemetryData.TelemetryDatumMap {
+			fn(v)
+			datumCount++
+		}
+	}
+}
@@ -0,0 +1,369 @@
+// Copyright © 2019 NAME HERE <EMAIL ADDRESS>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/bburch01/FOTAAS/internal/app/telemetry/channel"
+	"github.com/bburch01/FOTAAS/internal/app/telemetry/importer"
+	"github.com/google/uuid"
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+func init() {
+
+	// Here you will define your flags and configuration settings.
+
+	// Cobra supports Persistent Flags which will work for this command
+	// and all subcommands, e.g.:
+	// checkServiceAlivenessCmd.PersistentFlags().String("foo", "", "A help for foo")
+
+	// Cobra supports local flags which will only run when this command
+	// is called directly, e.g.:
+	// checkServiceAlivenessCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+
+	rootCmd.AddCommand(importTelemetryCmd)
+	importTelemetryCmd.Flags().StringP("file", "f", "", "telemetry data file to import (required)")
+	importTelemetryCmd.Flags().String("format", "", "file format, csv or jsonl (default is from the file extension)")
+	importTelemetryCmd.Flags().StringSliceP("map", "m", nil, "comma separated field=column mappings for columns not named after their telemetry datum field (e.g. description=channel,timestamp=time)")
+	importTelemetryCmd.Flags().IntP("batch-size", "b", 500, "number of telemetry datum per TransmitTelemetry call")
+	importTelemetryCmd.Flags().StringP("gran-prix", "g", "", "gran prix of the rows without a gran_prix column")
+	importTelemetryCmd.Flags().StringP("track", "r", "", "track of the rows without a track column")
+	importTelemetryCmd.Flags().StringP("constructor", "c", "", "constructor of the rows without a constructor column")
+	importTelemetryCmd.Flags().IntP("car-number", "n", 0, "car number of the rows without a car_number column")
+	importTelemetryCmd.Flags().BoolP("simulated", "i", false, "mark the rows without a simulated column as simulated telemetry data")
+	importTelemetryCmd.Flags().StringP("simulation-id", "d", "", "simulation uuid of the rows without a simulation_uuid column")
+	importTelemetryCmd.Flags().Bool("dry-run", false, "only read and validate the file, nothing is transmitted")
+
+	// Loads values from .env into the system.
+	// NOTE: the .env file must be present in execution directory which is a
+	// deployment issue that will be handled via docker/k8s in production but
+	// the .env file may need to be manually copied into the execution directory
+	// during testing.
+	if err := godotenv.Load(); err != nil {
+		log.Panicf("failed to load environment variables with error: %v", err)
+	}
+}
+
+// maxRejectReasons is the number of distinct reject reasons printed by the import summary.
+const maxRejectReasons = 20
+
+// maxRejectLines is the number of line numbers printed per reject reason.
+const maxRejectLines = 10
+
+// importSummary counts the rows of an import by outcome and collects the lines of the rejected rows
+// by reason.
+type importSummary struct {
+	rows      int
+	imported  int
+	duplicate int
+	rejected  int
+	reasons   map[string][]int
+}
+
+func (s *importSummary) reject(reason string, lines ...int) {
+	s.rejected += len(lines)
+	s.reasons[reason] = append(s.reasons[reason], lines...)
+}
+
+func (s *importSummary) print(dryRun bool) {
+
+	if dryRun {
+		log.Printf("%v rows read, %v valid, %v rejected (dry run, nothing transmitted)", s.rows, s.rows-s.rejected, s.rejected)
+	} else {
+		log.Printf("%v rows read, %v imported, %v duplicate, %v rejected", s.rows, s.imported, s.duplicate, s.rejected)
+	}
+
+	if len(s.reasons) == 0 {
+		return
+	}
+
+	reasons := make([]string, 0, len(s.reasons))
+	for k := range s.reasons {
+		reasons = append(reasons, k)
+	}
+	sort.Slice(reasons, func(i, j int) bool {
+		if len(s.reasons[reasons[i]]) != len(s.reasons[reasons[j]]) {
+			return len(s.reasons[reasons[i]]) > len(s.reasons[reasons[j]])
+		}
+		return reasons[i] < reasons[j]
+	})
+
+	log.Printf("rejected rows:")
+	for i, reason := range reasons {
+		if i == maxRejectReasons {
+			log.Printf("  ... and %v more reasons", len(reasons)-maxRejectReasons)
+			break
+		}
+		lines := s.reasons[reason]
+		sort.Ints(lines)
+		shown := make([]string, 0, maxRejectLines)
+		for _, v := range lines {
+			if len(shown) == maxRejectLines {
+				shown = append(shown, "...")
+				break
+			}
+			shown = append(shown, strconv.Itoa(v))
+		}
+		log.Printf("  %v rows: %v (lines %v)", len(lines), reason, strings.Join(shown, ", "))
+	}
+}
+
+var importTelemetryCmd = &cobra.Command{
+	Use:   "importTelemetry",
+	Short: "Imports recorded telemetry data from a CSV or JSON Lines file.",
+	Long: `Imports recorded telemetry data from a CSV or JSON Lines file into the telemetry service. File columns are
+	 mapped to telemetry datum fields (by default a column has the name of its field, e.g. a long layout export), the
+	 rows are validated the same as the telemetry service validates them and are transmitted in batches. Importing the
+	 same file again reports the rows as duplicate. A summary of the rejected rows is printed at the end.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+
+		path, _ := cmd.Flags().GetString("file")
+		if path == "" {
+			return fmt.Errorf("a file must be specified")
+		}
+
+		var format importer.Format
+		var err error
+		if v, _ := cmd.Flags().GetString("format"); v != "" {
+			format, err = importer.FormatForString(v)
+		} else {
+			format, err = importer.FormatForPath(path)
+		}
+		if err != nil {
+			return err
+		}
+
+		entries, _ := cmd.Flags().GetStringSlice("map")
+		mapping, err := importer.ParseMapping(entries)
+		if err != nil {
+			return err
+		}
+
+		batchSize, _ := cmd.Flags().GetInt("batch-size")
+		if batchSize <= 0 {
+			return fmt.Errorf("invalid batch size %v, must be greater than 0", batchSize)
+		}
+
+		defaults := make(map[string]string)
+		defaults[importer.FieldGranPrix], _ = cmd.Flags().GetString("gran-prix")
+		defaults[importer.FieldTrack], _ = cmd.Flags().GetString("track")
+		defaults[importer.FieldConstructor], _ = cmd.Flags().GetString("constructor")
+		if carNumber, _ := cmd.Flags().GetInt("car-number"); carNumber != 0 {
+			defaults[importer.FieldCarNumber] = strconv.Itoa(carNumber)
+		}
+		if simulated, _ := cmd.Flags().GetBool("simulated"); simulated {
+			defaults[importer.FieldSimulated] = "true"
+		}
+		if simID, _ := cmd.Flags().GetString("simulation-id"); simID != "" {
+			if _, err := uuid.Parse(simID); err != nil {
+				return fmt.Errorf("invalid simulation id: %v", err)
+			}
+			defaults[importer.FieldSimulationUUID] = simID
+		}
+
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		// The rows are validated against the telemetry service's channel registry, a dry run can do
+		// without the telemetry service and validate against the built in channels.
+		var registered []*api.Channel
+		resp, err := listChannels(new(api.ListChannelsRequest))
+		switch {
+		case err == nil && resp.Details.Code == api.ResponseCode_OK:
+			registered = resp.Channels
+		case dryRun:
+			log.Print("failed to list the telemetry service channel registry, validating against the built in channels only")
+		case err != nil:
+			return fmt.Errorf("failed to list the telemetry service channel registry with error: %v", err)
+		default:
+			return fmt.Errorf("failed to list the telemetry service channel registry: %v", resp.Details.Message)
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		r, err := importer.NewReader(file, format, mapping, defaults, channel.NewRegistry(registered))
+		if err != nil {
+			return err
+		}
+
+		summary := &importSummary{reasons: make(map[string][]int)}
+
+		if err = importTelemetry(r, batchSize, dryRun, summary); err != nil {
+			log.Printf("import telemetry failed with error: %v", err)
+		}
+
+		summary.print(dryRun)
+
+		return nil
+	},
+}
+
+// importTelemetry reads the rows of r and, unless dryRun is set, transmits the valid rows to the
+// telemetry service in batches of batchSize datum.
+func importTelemetry(r *importer.Reader, batchSize int, dryRun bool, summary *importSummary) error {
+
+	var client api.TelemetryServiceClient
+
+	if !dryRun {
+		var sb strings.Builder
+		sb.WriteString(os.Getenv("TELEMETRY_SERVICE_HOST"))
+		sb.WriteString(":")
+		sb.WriteString(os.Getenv("TELEMETRY_SERVICE_PORT"))
+		telemetrySvcEndpoint := sb.String()
+
+		conn, err := grpc.Dial(telemetrySvcEndpoint, grpc.WithInsecure())
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		client = api.NewTelemetryServiceClient(conn)
+	}
+
+	// The lines of the datum in the batch by uuid, identical rows share a uuid.
+	batch := make(map[string][]int)
+	data := &api.TelemetryData{TelemetryDatumMap: make(map[string]*api.TelemetryDatum)}
+
+	flush := func() error {
+		if len(data.TelemetryDatumMap) == 0 {
+			return nil
+		}
+		err := transmitImportBatch(client, data, batch, summary)
+		batch = make(map[string][]int)
+		data = &api.TelemetryData{TelemetryDatumMap: make(map[string]*api.TelemetryDatum)}
+		return err
+	}
+
+	for {
+		row, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		summary.rows++
+
+		if row.Err != nil {
+			summary.reject(row.Err.Error(), row.Line)
+			continue
+		}
+		if dryRun {
+			continue
+		}
+
+		batch[row.Datum.Uuid] = append(batch[row.Datum.Uuid], row.Line)
+		data.TelemetryDatumMap[row.Datum.Uuid] = row.Datum
+
+		if len(data.TelemetryDatumMap) >= batchSize {
+			if err = flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if dryRun {
+		return nil
+	}
+	return flush()
+}
+
+const (
+	// maxImportAttempts is the number of times a batch refused with BACKPRESSURE is transmitted.
+	maxImportAttempts = 8
+	importRetryDelay  = 250 * time.Millisecond
+)
+
+func transmitImportBatch(client api.TelemetryServiceClient, data *api.TelemetryData, lines map[string][]int,
+	summary *importSummary) error {
+
+	var resp *api.TransmitTelemetryResponse
+	var err error
+
+	// A batch refused because the telemetry service ingest queue is full is retried with an
+	// exponential backoff.
+	delay := importRetryDelay
+	for attempt := 1; ; attempt++ {
+		resp, err = transmitImportRequest(client, data)
+		if err != nil || attempt == maxImportAttempts || !backpressure(resp) {
+			break
+		}
+		time.Sleep(delay)
+		delay *= 2
+	}
+	if err != nil {
+		for _, v := range lines {
+			summary.reject("not transmitted, the telemetry service call failed", v...)
+		}
+		return err
+	}
+
+	for id, v := range lines {
+		details, ok := resp.Details[id]
+		if !ok {
+			summary.reject("no telemetry service response for the row", v...)
+			continue
+		}
+		switch details.Code {
+		case api.ResponseCode_OK, api.ResponseCode_WARN:
+			// The first row with a uuid is imported, identical rows after it are duplicates.
+			summary.imported++
+			summary.duplicate += len(v) - 1
+		case api.ResponseCode_DUPLICATE:
+			summary.duplicate += len(v)
+		default:
+			summary.reject(details.Message, v...)
+		}
+	}
+
+	return nil
+}
+
+func transmitImportRequest(client api.TelemetryServiceClient, data *api.TelemetryData) (*api.TransmitTelemetryResponse, error) {
+
+	// TODO: determine what the appropriate deadline should be for this service call.
+	clientDeadline := time.Now().Add(time.Duration(60) * time.Second)
+	ctx, cancel := context.WithDeadline(context.Background(), clientDeadline)
+
+	defer cancel()
+
+	return client.TransmitTelemetry(ctx, &api.TransmitTelemetryRequest{TelemetryData: data})
+}
+
+func backpressure(resp *api.TransmitTelemetryResponse) bool {
+	for _, v := range resp.Details {
+		if v.Code == api.ResponseCode_BACKPRESSURE {
+			return true
+		}
+	}
+	return false
+}
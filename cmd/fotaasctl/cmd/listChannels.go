@@ -0,0 +1,95 @@
+// Copyright © 2019 NAME HERE <EMAIL ADDRESS>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+
+	// Here you will define your flags and configuration settings.
+
+	// Cobra supports Persistent Flags which will work for this command
+	// and all subcommands, e.g.:
+	// checkServiceAlivenessCmd.PersistentFlags().String("foo", "", "A help for foo")
+
+	// Cobra supports local flags which will only run when this command
+	// is called directly, e.g.:
+	// checkServiceAlivenessCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+
+	rootCmd.AddCommand(listChannelsCmd)
+	listChannelsCmd.Flags().StringSliceP("category", "g", nil, "comma separated channel categories (e.g. BRAKES,TIRES)")
+
+	// Loads values from .env into the system.
+	// NOTE: the .env file must be present in execution directory which is a
+	// deployment issue that will be handled via docker/k8s in production but
+	// the .env file may need to be manually copied into the execution directory
+	// during testing.
+	if err := godotenv.Load(); err != nil {
+		log.Panicf("failed to load environment variables with error: %v", err)
+	}
+}
+
+var listChannelsCmd = &cobra.Command{
+	Use:   "listChannels",
+	Short: "Lists the telemetry service channel registry.",
+	Long: `Lists the built in and registered channels in the telemetry service channel registry, optionally
+	 only those in the given categories.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+
+		req := new(api.ListChannelsRequest)
+		categories, _ := cmd.Flags().GetStringSlice("category")
+		for _, v := range categories {
+			ordinal, ok := api.ChannelCategory_value[strings.ToUpper(v)]
+			if !ok {
+				return fmt.Errorf("invalid channel category specified: %v", v)
+			}
+			req.Categories = append(req.Categories, api.ChannelCategory(ordinal))
+		}
+
+		resp, err := listChannels(req)
+		if err != nil {
+			log.Printf("list channels service call failed with error: %v", err)
+			return nil
+		}
+
+		log.Printf("list channels response code   : %v", resp.Details.Code)
+		log.Printf("list channels response message: %s", resp.Details.Message)
+		for _, v := range resp.Channels {
+			logChannel(v)
+		}
+		return nil
+	},
+}
+
+func listChannels(req *api.ListChannelsRequest) (*api.ListChannelsResponse, error) {
+
+	var resp *api.ListChannelsResponse
+	err := callTelemetryService(func(ctx context.Context, client api.TelemetryServiceClient) error {
+		var err error
+		resp, err = client.ListChannels(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
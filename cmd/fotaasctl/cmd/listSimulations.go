@@ -0,0 +1,199 @@
+// Copyright © 2019 NAME HERE <EMAIL ADDRESS>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	ipbts "github.com/bburch01/FOTAAS/internal/pkg/protobuf/timestamp"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+
+	// Here you will define your flags and configuration settings.
+
+	// Cobra supports Persistent Flags which will work for this command
+	// and all subcommands, e.g.:
+	// checkServiceAlivenessCmd.PersistentFlags().String("foo", "", "A help for foo")
+
+	// Cobra supports local flags which will only run when this command
+	// is called directly, e.g.:
+	// checkServiceAlivenessCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+
+	rootCmd.AddCommand(listSimulationsCmd)
+	listSimulationsCmd.Flags().StringSlice("state", nil, "comma separated simulation states (e.g. IN_PROGRESS,PAUSED)")
+	listSimulationsCmd.Flags().StringSliceP("gran-prix", "g", nil, "comma separated gran prix (e.g. BRITISH,ITALIAN)")
+	listSimulationsCmd.Flags().StringSliceP("track", "t", nil, "comma separated tracks (e.g. SILVERSTONE,MONZA)")
+	listSimulationsCmd.Flags().StringSliceP("constructor", "c", nil, "comma separated constructors with a simulation member (e.g. MERCEDES,FERRARI)")
+	listSimulationsCmd.Flags().StringP("start-date", "s", "", "earliest simulation start date (yyyy-mm-dd)")
+	listSimulationsCmd.Flags().StringP("end-date", "e", "", "latest simulation start date (yyyy-mm-dd)")
+	listSimulationsCmd.Flags().String("sort", "start_timestamp", "sort field: start_timestamp, end_timestamp, duration_in_minutes or percent_complete")
+	listSimulationsCmd.Flags().Bool("descending", false, "sort in descending order")
+	listSimulationsCmd.Flags().Int32P("page-size", "p", 0, "number of simulations per page (default is the simulation service default)")
+
+	// Loads values from .env into the system.
+	// NOTE: the .env file must be present in execution directory which is a
+	// deployment issue that will be handled via docker/k8s in production but
+	// the .env file may need to be manually copied into the execution directory
+	// during testing.
+	if err := godotenv.Load(); err != nil {
+		log.Panicf("failed to load environment variables with error: %v", err)
+	}
+}
+
+var listSimulationsCmd = &cobra.Command{
+	Use:   "listSimulations",
+	Short: "Lists FOTAAS simulations.",
+	Long: `Lists FOTAAS simulations (running or not) along with their members. The simulations can be
+	 filtered by state, gran prix, track, constructor and start date and sorted by start time, end time,
+	 duration or percent complete.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+
+		req, err := newListSimulationsRequest(cmd)
+		if err != nil {
+			return err
+		}
+
+		var count int
+		for {
+			var resp *api.ListSimulationsResponse
+			err = callSimulationService(func(ctx context.Context, client api.SimulationServiceClient) error {
+				var err error
+				resp, err = client.ListSimulations(ctx, req)
+				return err
+			})
+			if err != nil {
+				log.Printf("list simulations service call failed with error: %v", err)
+				return nil
+			}
+			if resp.Details.Code != api.ResponseCode_OK {
+				log.Printf("list simulations response code   : %v", resp.Details.Code)
+				log.Printf("list simulations response message: %s", resp.Details.Message)
+				return nil
+			}
+
+			for _, v := range resp.Simulations {
+				logSimulation(v)
+			}
+			count += len(resp.Simulations)
+
+			if resp.NextPageToken == "" {
+				break
+			}
+			req.PageToken = resp.NextPageToken
+		}
+
+		log.Printf("simulation count: %v", count)
+
+		return nil
+	},
+}
+
+// newListSimulationsRequest builds a ListSimulationsRequest from the listSimulations flags.
+func newListSimulationsRequest(cmd *cobra.Command) (*api.ListSimulationsRequest, error) {
+
+	req := new(api.ListSimulationsRequest)
+
+	states, _ := cmd.Flags().GetStringSlice("state")
+	for _, v := range states {
+		ordinal, ok := api.SimulationState_value[strings.ToUpper(v)]
+		if !ok {
+			return nil, fmt.Errorf("invalid simulation state specified: %v", v)
+		}
+		req.States = append(req.States, api.SimulationState(ordinal))
+	}
+
+	granPrix, _ := cmd.Flags().GetStringSlice("gran-prix")
+	for _, v := range granPrix {
+		ordinal, ok := api.GranPrix_value[strings.ToUpper(v)]
+		if !ok {
+			return nil, fmt.Errorf("invalid gran prix specified: %v", v)
+		}
+		req.GranPrix = append(req.GranPrix, api.GranPrix(ordinal))
+	}
+
+	tracks, _ := cmd.Flags().GetStringSlice("track")
+	for _, v := range tracks {
+		ordinal, ok := api.Track_value[strings.ToUpper(v)]
+		if !ok {
+			return nil, fmt.Errorf("invalid track specified: %v", v)
+		}
+		req.Tracks = append(req.Tracks, api.Track(ordinal))
+	}
+
+	constructors, _ := cmd.Flags().GetStringSlice("constructor")
+	for _, v := range constructors {
+		ordinal, ok := api.Constructor_value[strings.ToUpper(v)]
+		if !ok {
+			return nil, errors.New("invalid constructor specified, valid constructors are: alpha_romeo, ferrari, haas, mclaren, mercedes, racing_point, red_bull_racing, scuderia_toro_roso, williams")
+		}
+		req.Constructors = append(req.Constructors, api.Constructor(ordinal))
+	}
+
+	// The start date range covers the whole of both days.
+	if startDate, _ := cmd.Flags().GetString("start-date"); startDate != "" {
+		t, err := time.Parse(time.RFC3339, startDate+"T00:00:00Z")
+		if err != nil {
+			return nil, errors.New("invalid start-date specified, format is yyyy-mm-dd")
+		}
+		if req.StartTimeFrom, err = ipbts.TimestampProto(t); err != nil {
+			return nil, err
+		}
+	}
+	if endDate, _ := cmd.Flags().GetString("end-date"); endDate != "" {
+		t, err := time.Parse(time.RFC3339, endDate+"T00:00:00Z")
+		if err != nil {
+			return nil, errors.New("invalid end-date specified, format is yyyy-mm-dd")
+		}
+		if req.StartTimeTo, err = ipbts.TimestampProto(t.AddDate(0, 0, 1)); err != nil {
+			return nil, err
+		}
+	}
+
+	sortBy, _ := cmd.Flags().GetString("sort")
+	ordinal, ok := api.SimulationSortField_value[strings.ToUpper(sortBy)]
+	if !ok {
+		return nil, fmt.Errorf("invalid sort field specified: %v", sortBy)
+	}
+	req.SortBy = api.SimulationSortField(ordinal)
+	req.Descending, _ = cmd.Flags().GetBool("descending")
+
+	req.PageSize, _ = cmd.Flags().GetInt32("page-size")
+
+	return req, nil
+}
+
+func logSimulation(v *api.SimulationInfo) {
+	log.Printf("%v %v %v %v minutes state: %v started: %v ended: %v %v%% complete seed: %v", v.Uuid, v.GranPrix, v.Track,
+		v.DurationInMinutes, v.State, ipbts.TimestampString(v.StartTimestamp), ipbts.TimestampString(v.EndTimestamp),
+		v.PercentComplete, v.Seed)
+	for _, m := range v.Members {
+		if m.AlarmOccurred {
+			log.Printf("    %v #%v %v alarm: %v %v %v", m.Constructor, m.CarNumber, m.Uuid, m.AlarmDatumDescription,
+				m.AlarmDatumValue, m.AlarmDatumUnit)
+			continue
+		}
+		log.Printf("    %v #%v %v", m.Constructor, m.CarNumber, m.Uuid)
+	}
+}
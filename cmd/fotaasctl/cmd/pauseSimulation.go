@@ -0,0 +1,81 @@
+// Copyright © 2019 NAME HERE <EMAIL ADDRESS>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"log"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/google/uuid"
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+
+	// Here you will define your flags and configuration settings.
+
+	// Cobra supports Persistent Flags which will work for this command
+	// and all subcommands, e.g.:
+	// checkServiceAlivenessCmd.PersistentFlags().String("foo", "", "A help for foo")
+
+	// Cobra supports local flags which will only run when this command
+	// is called directly, e.g.:
+	// checkServiceAlivenessCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+
+	rootCmd.AddCommand(pauseSimulationCmd)
+	pauseSimulationCmd.Flags().StringP("id", "i", "", "simulation id")
+	pauseSimulationCmd.MarkFlagRequired("id")
+
+	// Loads values from .env into the system.
+	// NOTE: the .env file must be present in execution directory which is a
+	// deployment issue that will be handled via docker/k8s in production but
+	// the .env file may need to be manually copied into the execution directory
+	// during testing.
+	if err := godotenv.Load(); err != nil {
+		log.Panicf("failed to load environment variables with error: %v", err)
+	}
+}
+
+var pauseSimulationCmd = &cobra.Command{
+	Use:   "pauseSimulation",
+	Short: "Pauses a running FOTAAS simulation.",
+	Long:  `Pauses a running FOTAAS simulation, it transmits no telemetry until it is resumed.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+
+		id, _ := cmd.Flags().GetString("id")
+
+		if _, err := uuid.Parse(id); err != nil {
+			log.Printf("invalid simulation id: %v", err)
+			return nil
+		}
+
+		var resp *api.PauseSimulationResponse
+		err := callSimulationService(func(ctx context.Context, client api.SimulationServiceClient) error {
+			var err error
+			resp, err = client.PauseSimulation(ctx, &api.PauseSimulationRequest{SimulationUuid: id})
+			return err
+		})
+		if err != nil {
+			log.Printf("pause simulation service call failed with error: %v", err)
+			return nil
+		}
+
+		log.Printf("pause simulation response code   : %v", resp.Details.Code)
+		log.Printf("pause simulation response message: %s", resp.Details.Message)
+		return nil
+	},
+}
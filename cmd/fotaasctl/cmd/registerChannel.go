@@ -0,0 +1,117 @@
+// Copyright © 2019 NAME HERE <EMAIL ADDRESS>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+
+	// Here you will define your flags and configuration settings.
+
+	// Cobra supports Persistent Flags which will work for this command
+	// and all subcommands, e.g.:
+	// checkServiceAlivenessCmd.PersistentFlags().String("foo", "", "A help for foo")
+
+	// Cobra supports local flags which will only run when this command
+	// is called directly, e.g.:
+	// checkServiceAlivenessCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+
+	rootCmd.AddCommand(registerChannelCmd)
+	registerChannelCmd.Flags().StringP("name", "m", "", "channel name, upper case letters, digits and underscores (e.g. TURBO_BOOST_PRESSURE)")
+	registerChannelCmd.Flags().StringP("unit", "u", "", "channel unit (e.g. kPa)")
+	registerChannelCmd.Flags().Float64("min", 0, "lowest valid value")
+	registerChannelCmd.Flags().Float64("max", 0, "highest valid value, without --min and --max the channel has no valid range")
+	registerChannelCmd.Flags().StringP("category", "g", "uncategorized", "channel category (e.g. POWER_UNIT)")
+
+	// Loads values from .env into the system.
+	// NOTE: the .env file must be present in execution directory which is a
+	// deployment issue that will be handled via docker/k8s in production but
+	// the .env file may need to be manually copied into the execution directory
+	// during testing.
+	if err := godotenv.Load(); err != nil {
+		log.Panicf("failed to load environment variables with error: %v", err)
+	}
+}
+
+var registerChannelCmd = &cobra.Command{
+	Use:   "registerChannel",
+	Short: "Adds a channel to the telemetry service channel registry.",
+	Long: `Adds a telemetry channel to the channel registry. Telemetry data of the channel can be transmitted
+	 once it is registered, with the channel name in place of a telemetry datum description. A value outside
+	 of the channel's valid range is rejected.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+
+		c := new(api.Channel)
+		c.Name, _ = cmd.Flags().GetString("name")
+		c.Unit, _ = cmd.Flags().GetString("unit")
+		if c.Name == "" || c.Unit == "" {
+			return errors.New("a channel name and unit are required")
+		}
+		c.MinValue, _ = cmd.Flags().GetFloat64("min")
+		c.MaxValue, _ = cmd.Flags().GetFloat64("max")
+		if c.MaxValue < c.MinValue {
+			return fmt.Errorf("invalid valid range %v to %v", c.MinValue, c.MaxValue)
+		}
+
+		category, _ := cmd.Flags().GetString("category")
+		ordinal, ok := api.ChannelCategory_value[strings.ToUpper(category)]
+		if !ok {
+			return fmt.Errorf("invalid channel category specified: %v", category)
+		}
+		c.Category = api.ChannelCategory(ordinal)
+
+		var resp *api.RegisterChannelResponse
+		err := callTelemetryService(func(ctx context.Context, client api.TelemetryServiceClient) error {
+			var err error
+			resp, err = client.RegisterChannel(ctx, &api.RegisterChannelRequest{Channel: c})
+			return err
+		})
+		if err != nil {
+			log.Printf("register channel service call failed with error: %v", err)
+			return nil
+		}
+
+		log.Printf("register channel response code   : %v", resp.Details.Code)
+		log.Printf("register channel response message: %s", resp.Details.Message)
+		if resp.Channel != nil {
+			logChannel(resp.Channel)
+		}
+		return nil
+	},
+}
+
+func logChannel(c *api.Channel) {
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%v unit: %v category: %v", c.Name, c.Unit, c.Category.String()))
+	if c.MaxValue > c.MinValue {
+		sb.WriteString(fmt.Sprintf(" range: %v to %v", c.MinValue, c.MaxValue))
+	}
+	if c.BuiltIn {
+		sb.WriteString(" (built in)")
+	}
+
+	log.Print(sb.String())
+}
@@ -46,6 +46,7 @@ func init() {
 	//checkServiceAlivenessCmd.Flags().StringP("name", "n", "", "run health check on a FOTAAS service by name")
 	//checkServiceAlivenessCmd.Flags().BoolP("all", "a", false, "run health check on all FOTAAS services")
 	startSimulationCmd.Flags().BoolP("alarm", "a", false, "force an alarm during the simulation")
+	startSimulationCmd.Flags().Int64P("seed", "s", 0, "seed of the simulated telemetry data, a simulation run again with the same seed generates the same telemetry data (default is a seed picked by the simulation service)")
 
 	// Loads values from .env into the system.
 	// NOTE: the .env file must be present in execution directory which is a
@@ -63,7 +64,8 @@ var startSimulationCmd = &cobra.Command{
 	Long:  `Starts a FOTAAS simulation that will generate and persist telemetry data`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		forceAlarm, _ := cmd.Flags().GetBool("alarm")
-		resp, err := startSimulation(forceAlarm)
+		seed, _ := cmd.Flags().GetInt64("seed")
+		resp, err := startSimulation(forceAlarm, seed)
 		if err != nil {
 			log.Printf("start simulation service call failed with error: %v", err)
 		} else {
@@ -74,7 +76,7 @@ var startSimulationCmd = &cobra.Command{
 	},
 }
 
-func startSimulation(forceAlarm bool) (*api.RunSimulationResponse, error) {
+func startSimulation(forceAlarm bool, seed int64) (*api.RunSimulationResponse, error) {
 
 	var forceAlarmFlag, noAlarmsFlag bool
 	if forceAlarm {
@@ -141,7 +143,7 @@ func startSimulation(forceAlarm bool) (*api.RunSimulationResponse, error) {
 
 	sim := api.Simulation{Uuid: simID, DurationInMinutes: int32(1), SampleRate: api.SampleRate_SR_1000_MS,
 		SimulationRateMultiplier: api.SimulationRateMultiplier_X1, GranPrix: api.GranPrix_UNITED_STATES,
-		Track: api.Track_AUSTIN, SimulationMemberMap: simMemberMap}
+		Track: api.Track_AUSTIN, SimulationMemberMap: simMemberMap, Seed: seed}
 
 	req := new(api.RunSimulationRequest)
 	req.Simulation = &sim
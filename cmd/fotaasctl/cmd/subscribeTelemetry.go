@@ -0,0 +1,162 @@
+// Copyright © 2019 NAME HERE <EMAIL ADDRESS>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	ipbts "github.com/bburch01/FOTAAS/internal/pkg/protobuf/timestamp"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/google/uuid"
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+func init() {
+
+	// Here you will define your flags and configuration settings.
+
+	// Cobra supports Persistent Flags which will work for this command
+	// and all subcommands, e.g.:
+	// checkServiceAlivenessCmd.PersistentFlags().String("foo", "", "A help for foo")
+
+	// Cobra supports local flags which will only run when this command
+	// is called directly, e.g.:
+	// checkServiceAlivenessCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+
+	rootCmd.AddCommand(subscribeTelemetryCmd)
+	subscribeTelemetryCmd.Flags().StringP("simulation-id", "d", "", "only watch telemetry data for a specific simulation uuid")
+	subscribeTelemetryCmd.Flags().StringSliceP("constructor", "c", nil, "comma separated constructors (e.g. MERCEDES,FERRARI)")
+	subscribeTelemetryCmd.Flags().IntSliceP("car-number", "n", nil, "comma separated car numbers (e.g. 44,77)")
+	subscribeTelemetryCmd.Flags().StringSliceP("description", "t", nil, "comma separated telemetry datum descriptions (e.g. BRAKE_TEMP_FL,BRAKE_TEMP_FR)")
+	subscribeTelemetryCmd.Flags().StringSlice("channel", nil, "comma separated channel registry names (e.g. TURBO_BOOST_PRESSURE)")
+
+	// Loads values from .env into the system.
+	// NOTE: the .env file must be present in execution directory which is a
+	// deployment issue that will be handled via docker/k8s in production but
+	// the .env file may need to be manually copied into the execution directory
+	// during testing.
+	if err := godotenv.Load(); err != nil {
+		log.Panicf("failed to load environment variables with error: %v", err)
+	}
+}
+
+var subscribeTelemetryCmd = &cobra.Command{
+	Use:   "subscribeTelemetry",
+	Short: "Watches telemetry data as it is ingested by the telemetry service.",
+	Long: `Subscribes to the telemetry service and prints each matching telemetry datum as it is ingested
+	 until interrupted. The data can be filtered by simulation, constructor, car number and telemetry datum description.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+
+		req := new(api.SubscribeTelemetryRequest)
+
+		simID, _ := cmd.Flags().GetString("simulation-id")
+		if simID != "" {
+			if _, err := uuid.Parse(simID); err != nil {
+				return fmt.Errorf("invalid simulation id: %v", err)
+			}
+			req.SimulationUuid = simID
+		}
+
+		constructors, _ := cmd.Flags().GetStringSlice("constructor")
+		for _, v := range constructors {
+			constructorOrdinal, ok := api.Constructor_value[strings.ToUpper(v)]
+			if !ok {
+				return errors.New("invalid constructor specified, valid constructors are: alpha_romeo, ferrari, haas, mclaren, mercedes, racing_point, red_bull_racing, scuderia_toro_roso, williams")
+			}
+			req.Constructors = append(req.Constructors, api.Constructor(constructorOrdinal))
+		}
+
+		carNumbers, _ := cmd.Flags().GetIntSlice("car-number")
+		for _, v := range carNumbers {
+			req.CarNumbers = append(req.CarNumbers, int32(v))
+		}
+
+		descriptions, _ := cmd.Flags().GetStringSlice("description")
+		for _, v := range descriptions {
+			descriptionOrdinal, ok := api.TelemetryDatumDescription_value[strings.ToUpper(v)]
+			if !ok {
+				return fmt.Errorf("invalid telemetry datum description specified: %v", v)
+			}
+			req.DatumDescriptions = append(req.DatumDescriptions, api.TelemetryDatumDescription(descriptionOrdinal))
+		}
+
+		req.Channels, _ = cmd.Flags().GetStringSlice("channel")
+
+		var sb strings.Builder
+		sb.WriteString(os.Getenv("TELEMETRY_SERVICE_HOST"))
+		sb.WriteString(":")
+		sb.WriteString(os.Getenv("TELEMETRY_SERVICE_PORT"))
+		telemetrySvcEndpoint := sb.String()
+
+		conn, err := grpc.Dial(telemetrySvcEndpoint, grpc.WithInsecure())
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		// No deadline, the subscription runs until the command is interrupted.
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var client = api.NewTelemetryServiceClient(conn)
+
+		stream, err := client.SubscribeTelemetry(ctx, req)
+		if err != nil {
+			log.Printf("subscribe telemetry service call failed with error: %v", err)
+			return nil
+		}
+
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				log.Printf("telemetry subscription failed with error: %v", err)
+				return nil
+			}
+
+			if resp.Details.Code != api.ResponseCode_OK {
+				log.Printf("telemetry service response code: %v", resp.Details.Code.String())
+				log.Printf("telemetry service response message: %v", resp.Details.Message)
+				return nil
+			}
+
+			if resp.DroppedDatumCount > 0 {
+				log.Printf("telemetry service dropped %v telemetry datum for this subscription", resp.DroppedDatumCount)
+			}
+
+			if resp.TelemetryData == nil {
+				log.Printf("%v", resp.Details.Message)
+				continue
+			}
+
+			for _, v := range resp.TelemetryData.TelemetryDatumMap {
+				name, unit := datumChannel(v)
+				log.Printf("%v %v #%v %v: %v %v high alarm: %v low alarm: %v", ipbts.TimestampString(v.Timestamp),
+					v.Constructor.String(), v.CarNumber, name, v.Value, unit, v.HighAlarm, v.LowAlarm)
+			}
+		}
+	},
+}
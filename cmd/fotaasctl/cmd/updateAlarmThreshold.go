@@ -0,0 +1,94 @@
+// Copyright © 2019 NAME HERE <EMAIL ADDRESS>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"log"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+
+	// Here you will define your flags and configuration settings.
+
+	// Cobra supports Persistent Flags which will work for this command
+	// and all subcommands, e.g.:
+	// checkServiceAlivenessCmd.PersistentFlags().String("foo", "", "A help for foo")
+
+	// Cobra supports local flags which will only run when this command
+	// is called directly, e.g.:
+	// checkServiceAlivenessCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+
+	rootCmd.AddCommand(updateAlarmThresholdCmd)
+	updateAlarmThresholdCmd.Flags().StringP("id", "i", "", "alarm threshold uuid")
+	updateAlarmThresholdCmd.MarkFlagRequired("id")
+	addAlarmThresholdFlags(updateAlarmThresholdCmd)
+
+	// Loads values from .env into the system.
+	// NOTE: the .env file must be present in execution directory which is a
+	// deployment issue that will be handled via docker/k8s in production but
+	// the .env file may need to be manually copied into the execution directory
+	// during testing.
+	if err := godotenv.Load(); err != nil {
+		log.Panicf("failed to load environment variables with error: %v", err)
+	}
+}
+
+var updateAlarmThresholdCmd = &cobra.Command{
+	Use:   "updateAlarmThreshold",
+	Short: "Updates an alarm threshold in the telemetry service alarm threshold registry.",
+	Long: `Updates an alarm threshold, by uuid, in the telemetry service alarm threshold registry. Only
+	 the fields whose flags are given are changed.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+
+		id, _ := cmd.Flags().GetString("id")
+
+		var resp *api.UpdateAlarmThresholdResponse
+		err := callTelemetryService(func(ctx context.Context, client api.TelemetryServiceClient) error {
+
+			getResp, err := client.GetAlarmThreshold(ctx, &api.GetAlarmThresholdRequest{Uuid: id})
+			if err != nil {
+				return err
+			}
+			if getResp.Details.Code != api.ResponseCode_OK {
+				resp = &api.UpdateAlarmThresholdResponse{Details: getResp.Details}
+				return nil
+			}
+
+			threshold := getResp.AlarmThreshold
+			if err = applyAlarmThresholdFlags(cmd, threshold); err != nil {
+				return err
+			}
+
+			resp, err = client.UpdateAlarmThreshold(ctx, &api.UpdateAlarmThresholdRequest{AlarmThreshold: threshold})
+			return err
+		})
+		if err != nil {
+			log.Printf("update alarm threshold service call failed with error: %v", err)
+			return nil
+		}
+
+		log.Printf("update alarm threshold response code   : %v", resp.Details.Code)
+		log.Printf("update alarm threshold response message: %s", resp.Details.Message)
+		if resp.AlarmThreshold != nil {
+			logAlarmThreshold(resp.AlarmThreshold)
+		}
+		return nil
+	},
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"log"
 	"net"
@@ -13,8 +14,10 @@ import (
 
 	"github.com/bburch01/FOTAAS/api"
 	"github.com/bburch01/FOTAAS/internal/app/simulation"
+	"github.com/bburch01/FOTAAS/internal/app/simulation/control"
 	"github.com/bburch01/FOTAAS/internal/app/simulation/models"
 	"github.com/bburch01/FOTAAS/internal/pkg/logging"
+	"github.com/bburch01/FOTAAS/internal/pkg/migrate"
 	"github.com/google/uuid"
 	"github.com/joho/godotenv"
 	"github.com/openzipkin/zipkin-go"
@@ -24,6 +27,9 @@ import (
 
 var logger *zap.Logger
 
+// recoveryPolicy is applied at startup to the simulations orphaned by a restart of the service.
+var recoveryPolicy = simulation.RecoveryFail
+
 type server struct{}
 
 func init() {
@@ -40,9 +46,35 @@ func init() {
 	if logger, err = logging.NewLogger(lm, os.Getenv("LOG_DIR"), os.Getenv("LOG_FILE_NAME")); err != nil {
 		log.Panicf("failed to initialize logging subsystem with error: %v", err)
 	}
+	migrateFlags := migrate.RegisterFlags(flag.CommandLine)
+	flag.Parse()
+
+	if migrateFlags.Enabled() {
+		if err = models.OpenDB(); err != nil {
+			logger.Fatal(fmt.Sprintf("failed to initialize database driver with error: %v", err))
+		}
+		state := "applied"
+		if migrateFlags.DryRun {
+			state = "pending"
+		}
+		migrations, err := models.MigrateDB(migrateFlags.DryRun)
+		for _, m := range migrations {
+			logger.Info(fmt.Sprintf("%v schema migration %v: %v", state, m.Version, m.Description))
+		}
+		if err != nil {
+			logger.Fatal(fmt.Sprintf("failed to migrate database with error: %v", err))
+		}
+		logger.Info(fmt.Sprintf("%v schema migrations %v", len(migrations), state))
+		os.Exit(0)
+	}
 	if err = models.InitDB(); err != nil {
 		logger.Fatal(fmt.Sprintf("failed to initialize database driver with error: %v", err))
 	}
+	if v := os.Getenv("SIMULATION_RECOVERY_POLICY"); v != "" {
+		if recoveryPolicy, err = simulation.RecoveryPolicyForString(v); err != nil {
+			logger.Fatal(fmt.Sprintf("failed to initialize simulation recovery with error: %v", err))
+		}
+	}
 }
 
 func main() {
@@ -80,6 +112,13 @@ func main() {
 
 	api.RegisterSimulationServiceServer(svr, &server{})
 
+	// The orphaned simulations are recovered before this service runs any simulation.
+	recovered, err := simulation.RecoverOrphanedSimulations(recoveryPolicy)
+	if err != nil {
+		logger.Fatal(fmt.Sprintf("failed to recover orphaned simulations with error: %v", err))
+	}
+	logger.Info(fmt.Sprintf("%v orphaned simulations recovered with policy: %v", recovered, recoveryPolicy))
+
 	if err := svr.Serve(listener); err != nil {
 		logger.Fatal(fmt.Sprintf("failed to serve on simulation service port %v with error: %v", simulationSvcPort, err))
 	}
@@ -130,9 +169,21 @@ func (s *server) RunSimulation(ctx context.Context, req *api.RunSimulationReques
 	// object wrap the protobuf object and redeclare all of the enums.
 	var sim *models.Simulation = models.NewFromRunSimulationRequest(*req)
 
+	ctl, err := control.Register(sim.ID)
+	if err != nil {
+		resp.Details.Code = api.ResponseCode_CONFLICT
+		resp.Details.Message = fmt.Sprintf("failed to start simulation %v with error: %v", sim.ID, err)
+		logger.Error(fmt.Sprintf("failed to start simulation %v with error: %v", sim.ID, err))
+		// protoc generated code requires error in the return params, return nil here so that clients
+		// of this service can process this FOTAAS error differently than other system errors (e.g.
+		// if this service is not available). Intercept this error and handle it via response code &
+		// message.
+		return &resp, nil
+	}
+
 	// Start the simulation asynchronously (i.e. don't wait on a response from the goroutine).
 	// Simulation progress/status is persisted to the FOTAAS simulation db.
-	go simulation.StartSimulation(sim)
+	go simulation.StartSimulation(sim, ctl)
 
 	return &resp, nil
 
@@ -174,6 +225,71 @@ func (s *server) GetSimulationInfo(ctx context.Context, req *api.GetSimulationIn
 	return resp, nil
 }
 
+func (s *server) ListSimulations(ctx context.Context, req *api.ListSimulationsRequest) (*api.ListSimulationsResponse, error) {
+
+	resp := new(api.ListSimulationsResponse)
+
+	simulations, nextPageToken, err := models.ListSimulations(*req)
+	if err != nil {
+		resp.Details = &api.ResponseDetails{Code: api.ResponseCode_ERROR,
+			Message: fmt.Sprintf("failed to list simulations with error: %v", err)}
+		logger.Error(fmt.Sprintf("failed to list simulations with error: %v", err))
+		// protoc generated code requires error in the return params, return nil here so that clients
+		// of this service can process this FOTAAS error differently than other system errors (e.g.
+		// if this service is not available). Intercept this error and handle it via response code &
+		// message.
+		return resp, nil
+	}
+
+	resp.Details = &api.ResponseDetails{Code: api.ResponseCode_OK,
+		Message: fmt.Sprintf("%v simulations successfully retrieved", len(simulations))}
+	resp.Simulations = simulations
+	resp.NextPageToken = nextPageToken
+
+	return resp, nil
+}
+
+func (s *server) CancelSimulation(ctx context.Context, req *api.CancelSimulationRequest) (*api.CancelSimulationResponse, error) {
+
+	resp := &api.CancelSimulationResponse{Details: controlSimulation("cancel", req.SimulationUuid, control.Cancel)}
+	return resp, nil
+}
+
+func (s *server) PauseSimulation(ctx context.Context, req *api.PauseSimulationRequest) (*api.PauseSimulationResponse, error) {
+
+	resp := &api.PauseSimulationResponse{Details: controlSimulation("pause", req.SimulationUuid, control.Pause)}
+	return resp, nil
+}
+
+func (s *server) ResumeSimulation(ctx context.Context, req *api.ResumeSimulationRequest) (*api.ResumeSimulationResponse, error) {
+
+	resp := &api.ResumeSimulationResponse{Details: controlSimulation("resume", req.SimulationUuid, control.Resume)}
+	return resp, nil
+}
+
+// controlSimulation applies fn, one of the control package's Cancel, Pause or Resume, to the running
+// simulation simID. The simulation records the change of state itself when it next transmits.
+func controlSimulation(action string, simID string, fn func(string) error) *api.ResponseDetails {
+
+	if _, err := uuid.Parse(simID); err != nil {
+		return &api.ResponseDetails{Code: api.ResponseCode_ERROR, Message: fmt.Sprintf("invalid simulation id: %v", err)}
+	}
+
+	err := fn(simID)
+	switch err {
+	case nil:
+		logger.Info(fmt.Sprintf("%v requested for simulation %v", action, simID))
+		return &api.ResponseDetails{Code: api.ResponseCode_OK,
+			Message: fmt.Sprintf("%v requested for simulation %v", action, simID)}
+	case control.ErrNotRunning:
+		return &api.ResponseDetails{Code: api.ResponseCode_NOT_FOUND,
+			Message: fmt.Sprintf("failed to %v simulation %v with error: %v", action, simID, err)}
+	default:
+		return &api.ResponseDetails{Code: api.ResponseCode_CONFLICT,
+			Message: fmt.Sprintf("failed to %v simulation %v with error: %v", action, simID, err)}
+	}
+}
+
 func validate(simMember models.SimulationMember) error {
 	if _, err := uuid.Parse(simMember.ID); err != nil {
 		return err
@@ -49,7 +49,8 @@ func init() {
 		log.Panicf("failed to initialize logging subsystem with error: %v", err)
 	}
 
-	if err = models.InitDB(); err != nil {
+	// The telemetry database belongs to the telemetry service, which migrates it.
+	if err = models.OpenDB(); err != nil {
 		logger.Fatal(fmt.Sprintf("failed to initialize database driver with error: %v", err))
 	}
 
@@ -2,18 +2,31 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
+	"io"
 	"log"
 	"net"
 	"os"
+	"os/signal"
+	"strconv"
 	"strings"
+	"syscall"
+	"time"
 
 	zgrpc "github.com/openzipkin/zipkin-go/middleware/grpc"
 	zhttp "github.com/openzipkin/zipkin-go/reporter/http"
 
 	"github.com/bburch01/FOTAAS/api"
+	"github.com/bburch01/FOTAAS/internal/app/telemetry"
+	"github.com/bburch01/FOTAAS/internal/app/telemetry/alarm"
+	"github.com/bburch01/FOTAAS/internal/app/telemetry/channel"
+	"github.com/bburch01/FOTAAS/internal/app/telemetry/export"
+	"github.com/bburch01/FOTAAS/internal/app/telemetry/hub"
+	"github.com/bburch01/FOTAAS/internal/app/telemetry/ingest"
 	"github.com/bburch01/FOTAAS/internal/app/telemetry/models"
 	"github.com/bburch01/FOTAAS/internal/pkg/logging"
+	"github.com/bburch01/FOTAAS/internal/pkg/migrate"
 	"github.com/google/uuid"
 	"github.com/joho/godotenv"
 	"github.com/openzipkin/zipkin-go"
@@ -22,6 +35,25 @@ import (
 )
 
 var logger *zap.Logger
+var telemetryHub *hub.Hub
+var retentionPolicy models.RetentionPolicy
+var alarmEvaluator *alarm.Evaluator
+var channels *channel.Registry
+var ingestQueue *ingest.Queue
+var ingestStreamWait time.Duration
+
+const (
+	defaultSubscriberBufferSize = 1024
+	// maxSubscribeBatchSize is the maximum number of datum sent in a single subscription response.
+	maxSubscribeBatchSize = 500
+
+	defaultIngestQueueCapacity = 100000
+	defaultIngestWorkers       = 4
+	defaultIngestMaxBatchSize  = 5000
+	// defaultIngestStreamWaitMs is how long a telemetry stream batch waits for room in a full ingest
+	// queue before it is refused with BACKPRESSURE, a TransmitTelemetry request does not wait.
+	defaultIngestStreamWaitMs = 5000
+)
 
 type server struct{}
 
@@ -47,10 +79,109 @@ func init() {
 		log.Panicf("failed to initialize logging subsystem with error: %v", err)
 	}
 
+	migrateFlags := migrate.RegisterFlags(flag.CommandLine)
+	flag.Parse()
+
+	if migrateFlags.Enabled() {
+		if err = models.OpenDB(); err != nil {
+			logger.Fatal(fmt.Sprintf("failed to initialize database driver with error: %v", err))
+		}
+		state := "applied"
+		if migrateFlags.DryRun {
+			state = "pending"
+		}
+		migrations, err := models.MigrateDB(migrateFlags.DryRun)
+		for _, m := range migrations {
+			logger.Info(fmt.Sprintf("%v schema migration %v: %v", state, m.Version, m.Description))
+		}
+		if err != nil {
+			logger.Fatal(fmt.Sprintf("failed to migrate database with error: %v", err))
+		}
+		logger.Info(fmt.Sprintf("%v schema migrations %v", len(migrations), state))
+		os.Exit(0)
+	}
+
 	if err = models.InitDB(); err != nil {
 		logger.Fatal(fmt.Sprintf("failed to initialize database driver with error: %v", err))
 	}
 
+	bufferSize := defaultSubscriberBufferSize
+	if v := os.Getenv("TELEMETRY_SUBSCRIBER_BUFFER_SIZE"); v != "" {
+		if bufferSize, err = strconv.Atoi(v); err != nil || bufferSize <= 0 {
+			logger.Fatal(fmt.Sprintf("invalid TELEMETRY_SUBSCRIBER_BUFFER_SIZE %v", v))
+		}
+	}
+
+	policy := hub.Drop
+	if v := os.Getenv("TELEMETRY_SLOW_SUBSCRIBER_POLICY"); v != "" {
+		if policy, err = hub.SlowSubscriberPolicyForString(v); err != nil {
+			logger.Fatal(fmt.Sprintf("failed to initialize telemetry hub with error: %v", err))
+		}
+	}
+
+	telemetryHub = hub.New(bufferSize, policy)
+
+	if retentionPolicy, err = models.RetentionPolicyFromEnv(); err != nil {
+		logger.Fatal(fmt.Sprintf("failed to load telemetry retention policy with error: %v", err))
+	}
+
+	alarmMode := alarm.Verify
+	if v := os.Getenv("TELEMETRY_ALARM_MODE"); v != "" {
+		if alarmMode, err = alarm.ModeForString(v); err != nil {
+			logger.Fatal(fmt.Sprintf("failed to initialize alarm evaluation with error: %v", err))
+		}
+	}
+
+	// The alarm threshold registry starts out with the thresholds the simulator has always used.
+	seeded, err := models.SeedAlarmThresholds(alarm.DefaultAlarmThresholds())
+	if err != nil {
+		logger.Fatal(fmt.Sprintf("failed to seed alarm threshold registry with error: %v", err))
+	}
+	if seeded > 0 {
+		logger.Info(fmt.Sprintf("seeded empty alarm threshold registry with %v default alarm thresholds", seeded))
+	}
+
+	thresholds, err := models.RetrieveAlarmThresholds(nil)
+	if err != nil {
+		logger.Fatal(fmt.Sprintf("failed to load alarm threshold registry with error: %v", err))
+	}
+
+	alarmEvaluator = alarm.NewEvaluator(alarmMode, thresholds)
+
+	registered, err := models.RetrieveChannels()
+	if err != nil {
+		logger.Fatal(fmt.Sprintf("failed to load channel registry with error: %v", err))
+	}
+
+	channels = channel.NewRegistry(registered)
+
+	ingestConfig := ingest.Config{Capacity: defaultIngestQueueCapacity, Workers: defaultIngestWorkers,
+		MaxBatchSize: defaultIngestMaxBatchSize}
+	for _, v := range []struct {
+		key   string
+		value *int
+	}{
+		{"TELEMETRY_INGEST_QUEUE_CAPACITY", &ingestConfig.Capacity},
+		{"TELEMETRY_INGEST_WORKERS", &ingestConfig.Workers},
+		{"TELEMETRY_INGEST_MAX_BATCH_SIZE", &ingestConfig.MaxBatchSize},
+	} {
+		if env := os.Getenv(v.key); env != "" {
+			if *v.value, err = strconv.Atoi(env); err != nil || *v.value <= 0 {
+				logger.Fatal(fmt.Sprintf("invalid %v %v", v.key, env))
+			}
+		}
+	}
+
+	waitMs := defaultIngestStreamWaitMs
+	if v := os.Getenv("TELEMETRY_INGEST_STREAM_WAIT_MS"); v != "" {
+		if waitMs, err = strconv.Atoi(v); err != nil || waitMs < 0 {
+			logger.Fatal(fmt.Sprintf("invalid TELEMETRY_INGEST_STREAM_WAIT_MS %v", v))
+		}
+	}
+	ingestStreamWait = time.Duration(waitMs) * time.Millisecond
+
+	ingestQueue = ingest.New(ingestConfig, flushTelemetryData)
+
 }
 
 func (s *server) AlivenessCheck(ctx context.Context, req *api.AlivenessCheckRequest) (*api.AlivenessCheckResponse, error) {
@@ -76,57 +207,306 @@ func (s *server) AlivenessCheck(ctx context.Context, req *api.AlivenessCheckRequ
 func (s *server) TransmitTelemetry(ctx context.Context, req *api.TransmitTelemetryRequest) (*api.TransmitTelemetryResponse, error) {
 
 	var resp api.TransmitTelemetryResponse
-	var datum models.TelemetryDatum
-	var datumMap = req.TelemetryData.TelemetryDatumMap
-	var status api.ResponseDetails
-	var statusMap = make(map[string]*api.ResponseDetails)
 
-	for i, v := range datumMap {
-		err := validate(v)
+	resp.Details = persistTelemetryData(req.TelemetryData, req.AckMode, 0)
+	return &resp, nil
+}
+
+func (s *server) TransmitTelemetryStream(stream api.TelemetryService_TransmitTelemetryStreamServer) error {
+
+	var batchCount int64
+	var datumCount int32
+	var acceptedCount int32
+	var ack *api.TelemetryBatchAck
+
+	resp := new(api.TransmitTelemetryStreamResponse)
+
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			if ack != nil {
+				resp.BatchAcks = append(resp.BatchAcks, ack)
+			}
+			resp.Details = &api.ResponseDetails{Code: api.ResponseCode_OK,
+				Message: fmt.Sprintf("%v telemetry batches (%v telemetry datum) successfully processed", batchCount, datumCount)}
+			if acceptedCount > 0 {
+				resp.Details.Message = fmt.Sprintf("%v, %v telemetry datum acknowledged when accepted", resp.Details.Message, acceptedCount)
+			}
+			return stream.SendAndClose(resp)
+		}
 		if err != nil {
-			status.Code = api.ResponseCode_ERROR
-			status.Message = fmt.Sprintf("telemetry datum validation failed with error: %v", err)
-		} else {
-			datum.ID = v.Uuid
-			if v.Simulated {
-				datum.Simulated = v.Simulated
-				datum.SimulationID = v.SimulationUuid
-				datum.SimulationTransmitSequenceNumber = v.SimulationTransmitSequenceNumber
+			logger.Error(fmt.Sprintf("failed to receive telemetry stream batch with error: %v", err))
+			return err
+		}
+
+		batchCount++
+		statusMap := persistTelemetryData(req.TelemetryData, req.AckMode, ingestStreamWait)
+
+		// A DUPLICATE datum was persisted by an earlier (retried) transmission, a WARN datum was
+		// persisted despite its alarm flags disagreeing with the server's evaluation and an ACCEPTED
+		// datum was queued for persisting, so all of them count as processed. A batch refused because
+		// the ingest queue is full is reported as BACKPRESSURE rather than ERROR.
+		failedMap := make(map[string]*api.ResponseDetails)
+		failedCode := api.ResponseCode_BACKPRESSURE
+		ackCode := api.ResponseCode_OK
+		for k, v := range statusMap {
+			switch v.Code {
+			case api.ResponseCode_OK, api.ResponseCode_DUPLICATE, api.ResponseCode_WARN:
+			case api.ResponseCode_ACCEPTED:
+				ackCode = api.ResponseCode_ACCEPTED
+			default:
+				failedMap[k] = v
+				if v.Code != api.ResponseCode_BACKPRESSURE {
+					failedCode = api.ResponseCode_ERROR
+				}
 			}
-			datum.Description = v.Description.String()
-
-			datum.GranPrix = v.GranPrix.String()
-			datum.Track = v.Track.String()
-			datum.Constructor = v.Constructor.String()
-			datum.CarNumber = v.CarNumber
-
-			datum.Unit = v.Unit.String()
-			datum.Timestamp = v.Timestamp
-			datum.Latitude = v.Latitude
-			datum.Longitude = v.Longitude
-			datum.Elevation = v.Elevation
-			datum.Value = v.Value
-			datum.HiAlarm = v.HighAlarm
-			datum.LoAlarm = v.LowAlarm
-			err = datum.Create()
-			if err != nil {
-				status.Code = api.ResponseCode_ERROR
-				status.Message = fmt.Sprintf("server side error: %v", err)
-			} else {
-				status.Code = api.ResponseCode_OK
-				status.Message = fmt.Sprintf("telemetry datum successfully processed.")
+		}
+
+		if len(failedMap) > 0 {
+			// Stop processing the stream on the first failed batch. The failed batch gets its own
+			// acknowledgement (with the per datum details) so that the client can tell exactly which
+			// batch, and which datum within that batch, was not persisted.
+			if ack != nil {
+				resp.BatchAcks = append(resp.BatchAcks, ack)
 			}
+			resp.BatchAcks = append(resp.BatchAcks, &api.TelemetryBatchAck{FirstBatchSequenceNumber: req.BatchSequenceNumber,
+				LastBatchSequenceNumber: req.BatchSequenceNumber, DatumCount: int32(len(statusMap)),
+				Details: &api.ResponseDetails{Code: failedCode,
+					Message: fmt.Sprintf("%v of %v telemetry datum failed processing", len(failedMap), len(statusMap))},
+				DatumDetails: failedMap})
+			resp.Details = &api.ResponseDetails{Code: failedCode,
+				Message: fmt.Sprintf("telemetry stream aborted on batch %v", req.BatchSequenceNumber)}
+			logger.Error(fmt.Sprintf("telemetry stream aborted on batch %v, %v of %v telemetry datum failed processing",
+				req.BatchSequenceNumber, len(failedMap), len(statusMap)))
+			return stream.SendAndClose(resp)
+		}
+
+		datumCount += int32(len(statusMap))
+		ackMessage := "%v telemetry datum successfully processed"
+		if ackCode == api.ResponseCode_ACCEPTED {
+			acceptedCount += int32(len(statusMap))
+			ackMessage = "%v telemetry datum accepted"
+		}
+
+		// Consecutive successful batches with the same acknowledgement code are coalesced into a single
+		// acknowledgement, otherwise a long running, high sample rate stream would produce a response
+		// larger than the grpc message size limit.
+		if ack != nil && ack.LastBatchSequenceNumber+1 == req.BatchSequenceNumber && ack.Details.Code == ackCode {
+			ack.LastBatchSequenceNumber = req.BatchSequenceNumber
+			ack.DatumCount += int32(len(statusMap))
+			ack.Details.Message = fmt.Sprintf(ackMessage, ack.DatumCount)
+			continue
 		}
-		statusMap[i] = &status
+		if ack != nil {
+			resp.BatchAcks = append(resp.BatchAcks, ack)
+		}
+		ack = &api.TelemetryBatchAck{FirstBatchSequenceNumber: req.BatchSequenceNumber,
+			LastBatchSequenceNumber: req.BatchSequenceNumber, DatumCount: int32(len(statusMap)),
+			Details: &api.ResponseDetails{Code: ackCode,
+				Message: fmt.Sprintf(ackMessage, len(statusMap))}}
 	}
+}
 
-	resp.Details = statusMap
-	return &resp, nil
+// ingestRequest is a telemetry data request queued on the ingest queue. The flush worker that writes
+// it fills in statusMap, which is only read once the job is done.
+type ingestRequest struct {
+	data             *api.TelemetryData
+	datums           []models.TelemetryDatum
+	alarmMismatchMap map[string]string
+	statusMap        map[string]*api.ResponseDetails
 }
 
-func (s *server) GetTelemetryData(ctx context.Context, req *api.GetTelemetryDataRequest) (*api.GetTelemetryDataResponse, error) {
+// persistTelemetryData validates every datum in data, queues the valid data on the ingest queue and
+// returns the per datum processing status keyed the same way as data.TelemetryDatumMap. The datum
+// are persisted with all-or-nothing semantics: if any datum fails validation, conflicts with a
+// previously persisted datum, or the bulk insert fails, none of the datum in data are persisted. A
+// datum that was already persisted with identical content is reported as DUPLICATE, which makes
+// retries safe. Alarm flags are verified (or computed) according to the alarm evaluator's mode, a
+// newly persisted datum whose sender supplied alarm flags disagree with the server's evaluation is
+// reported as WARN.
+//
+// When the ingest queue has no room for data within wait every datum is reported as BACKPRESSURE.
+// With ACK_DURABLE the statuses are those of the write, with ACK_ACCEPTED every datum is reported
+// as ACCEPTED once it is queued and a failed write is only logged.
+func persistTelemetryData(data *api.TelemetryData, ackMode api.AckMode, wait time.Duration) map[string]*api.ResponseDetails {
+
+	var statusMap = make(map[string]*api.ResponseDetails)
+	var invalidCount int
+
+	if data == nil || len(data.TelemetryDatumMap) == 0 {
+		return statusMap
+	}
+
+	req := &ingestRequest{data: data, datums: make([]models.TelemetryDatum, 0, len(data.TelemetryDatumMap)),
+		alarmMismatchMap: make(map[string]string), statusMap: statusMap}
+
+	for i, v := range data.TelemetryDatumMap {
+		if err := telemetry.Validate(v, channels); err != nil {
+			statusMap[i] = &api.ResponseDetails{Code: api.ResponseCode_ERROR,
+				Message: fmt.Sprintf("telemetry datum validation failed with error: %v", err)}
+			invalidCount++
+			continue
+		}
+		if mismatch := alarmEvaluator.Apply(v); mismatch != "" {
+			req.alarmMismatchMap[i] = mismatch
+		}
+		req.datums = append(req.datums, models.NewFromTelemetryDatum(v))
+	}
+
+	if invalidCount > 0 {
+		for i := range data.TelemetryDatumMap {
+			if _, ok := statusMap[i]; !ok {
+				statusMap[i] = &api.ResponseDetails{Code: api.ResponseCode_ERROR,
+					Message: fmt.Sprintf("telemetry datum not persisted, %v telemetry datum in the request failed validation", invalidCount)}
+			}
+		}
+		return statusMap
+	}
+
+	job, err := ingestQueue.Submit(req, len(req.datums), wait)
+	switch {
+	case err == ingest.ErrFull:
+		logger.Warn(fmt.Sprintf("telemetry data not accepted, the ingest queue has no room for %v telemetry datum", len(req.datums)))
+		for i := range data.TelemetryDatumMap {
+			statusMap[i] = &api.ResponseDetails{Code: api.ResponseCode_BACKPRESSURE,
+				Message: "telemetry datum not accepted, the telemetry ingest queue is full, retry later"}
+		}
+		return statusMap
+	case err != nil:
+		logger.Error(fmt.Sprintf("failed to queue telemetry data with error: %v", err))
+		for i := range data.TelemetryDatumMap {
+			statusMap[i] = &api.ResponseDetails{Code: api.ResponseCode_ERROR,
+				Message: fmt.Sprintf("server side error: %v", err)}
+		}
+		return statusMap
+	}
+
+	if ackMode == api.AckMode_ACK_ACCEPTED {
+		// The flush worker writes req.statusMap, so the accepted statuses go in a map of their own.
+		acceptedMap := make(map[string]*api.ResponseDetails, len(data.TelemetryDatumMap))
+		for i := range data.TelemetryDatumMap {
+			if mismatch, ok := req.alarmMismatchMap[i]; ok {
+				acceptedMap[i] = &api.ResponseDetails{Code: api.ResponseCode_ACCEPTED,
+					Message: fmt.Sprintf("telemetry datum accepted, %v", mismatch)}
+				continue
+			}
+			acceptedMap[i] = &api.ResponseDetails{Code: api.ResponseCode_ACCEPTED,
+				Message: "telemetry datum accepted."}
+		}
+		return acceptedMap
+	}
+
+	<-job.Done()
+
+	return statusMap
+}
+
+// flushTelemetryData is the ingest queue flush function. The queued requests are written with a
+// single insert, if that fails (e.g. one of the requests is in conflict) each request is written on
+// its own so that one request cannot fail the others.
+func flushTelemetryData(jobs []*ingest.Job) int {
+
+	var failed int
+
+	reqs := make([]*ingestRequest, len(jobs))
+	for i, v := range jobs {
+		reqs[i] = v.Value.(*ingestRequest)
+	}
 
-	// TODO: validate the request.
+	// Only newly persisted datum go to the live subscribers, a duplicate was published when it was first
+	// persisted.
+	published := make(map[string]bool)
+
+	if len(reqs) > 1 {
+		var datums []models.TelemetryDatum
+		for _, v := range reqs {
+			datums = append(datums, v.datums...)
+		}
+		if ingestMap, err := models.CreateTelemetryData(datums); err == nil {
+			for _, v := range reqs {
+				failed += completeIngestRequest(v, ingestMap, nil, published)
+			}
+			return failed
+		}
+	}
+
+	for _, v := range reqs {
+		ingestMap, err := models.CreateTelemetryData(v.datums)
+		failed += completeIngestRequest(v, ingestMap, err, published)
+	}
+
+	return failed
+}
+
+// completeIngestRequest fills in the statuses of a written request, publishes its newly persisted
+// datum and returns the number of datum that were not persisted. A datum already published by an
+// earlier request in the same flush is a DUPLICATE.
+func completeIngestRequest(req *ingestRequest, ingestMap map[string]models.IngestStatus, err error, published map[string]bool) int {
+
+	data := req.data
+	statusMap := req.statusMap
+
+	switch {
+	case err == models.ErrTelemetryDatumConflict:
+		var conflictCount int
+		for _, v := range ingestMap {
+			if v == models.Conflict {
+				conflictCount++
+			}
+		}
+		logger.Warn(fmt.Sprintf("telemetry data not persisted, %v telemetry datum conflict with previously persisted telemetry datum", conflictCount))
+		for i, v := range data.TelemetryDatumMap {
+			if ingestMap[v.Uuid] == models.Conflict {
+				statusMap[i] = &api.ResponseDetails{Code: api.ResponseCode_CONFLICT,
+					Message: "telemetry datum conflicts with a previously persisted telemetry datum with the same uuid"}
+				continue
+			}
+			statusMap[i] = &api.ResponseDetails{Code: api.ResponseCode_ERROR,
+				Message: fmt.Sprintf("telemetry datum not persisted, %v telemetry datum in the request are in conflict", conflictCount)}
+		}
+		return len(req.datums)
+	case err != nil:
+		logger.Error(fmt.Sprintf("failed to persist telemetry data with error: %v", err))
+		for i := range data.TelemetryDatumMap {
+			statusMap[i] = &api.ResponseDetails{Code: api.ResponseCode_ERROR,
+				Message: fmt.Sprintf("server side error: %v", err)}
+		}
+		return len(req.datums)
+	}
+
+	publish := make([]*api.TelemetryDatum, 0, len(data.TelemetryDatumMap))
+
+	for i, v := range data.TelemetryDatumMap {
+		if ingestMap[v.Uuid] == models.Duplicate || published[v.Uuid] {
+			statusMap[i] = &api.ResponseDetails{Code: api.ResponseCode_DUPLICATE,
+				Message: "telemetry datum previously processed."}
+			continue
+		}
+		if mismatch, ok := req.alarmMismatchMap[i]; ok {
+			statusMap[i] = &api.ResponseDetails{Code: api.ResponseCode_WARN,
+				Message: fmt.Sprintf("telemetry datum successfully processed, %v", mismatch)}
+		} else {
+			statusMap[i] = &api.ResponseDetails{Code: api.ResponseCode_OK,
+				Message: "telemetry datum successfully processed."}
+		}
+		publish = append(publish, v)
+	}
+
+	for _, v := range publish {
+		published[v.Uuid] = true
+	}
+
+	if len(req.alarmMismatchMap) > 0 {
+		logger.Warn(fmt.Sprintf("%v of %v telemetry datum have sender alarm flags that disagree with the telemetry service evaluation (alarm mode: %v)",
+			len(req.alarmMismatchMap), len(data.TelemetryDatumMap), alarmEvaluator.Mode()))
+	}
+
+	telemetryHub.Publish(publish)
+
+	return 0
+}
+
+func (s *server) GetTelemetryData(ctx context.Context, req *api.GetTelemetryDataRequest) (*api.GetTelemetryDataResponse, error) {
 
 	var data *api.TelemetryData
 	var err error
@@ -134,7 +514,23 @@ func (s *server) GetTelemetryData(ctx context.Context, req *api.GetTelemetryData
 	resp := new(api.GetTelemetryDataResponse)
 	resp.Details = new(api.ResponseDetails)
 
-	if data, err = models.RetrieveTelemetryData(*req); err != nil {
+	if err = models.ValidateTelemetryDataRequest(*req); err != nil {
+		resp.Details.Code = api.ResponseCode_ERROR
+		resp.Details.Message = fmt.Sprintf("invalid telemetry data request: %v", err)
+		logger.Error(fmt.Sprintf("invalid telemetry data request: %v", err))
+		return resp, nil
+	}
+
+	if req.PageSize > 0 || req.PageToken != "" {
+		var nextPageToken string
+		if data, nextPageToken, err = models.RetrieveTelemetryDataPage(*req); err != nil {
+			resp.Details.Code = api.ResponseCode_ERROR
+			resp.Details.Message = fmt.Sprintf("failed to retrieve telemetry data with error: %v", err)
+			logger.Error(fmt.Sprintf("failed to retrieve telemetry data with error: %v", err))
+			return resp, nil
+		}
+		resp.NextPageToken = nextPageToken
+	} else if data, err = models.RetrieveTelemetryData(*req); err != nil {
 		resp.Details.Code = api.ResponseCode_ERROR
 		resp.Details.Message = fmt.Sprintf("failed to retrieve simulated telemetry data with error: %v", err)
 		logger.Error(fmt.Sprintf("failed to retrieve simulated telemetry data with error: %v", err))
@@ -154,90 +550,514 @@ func (s *server) GetTelemetryData(ctx context.Context, req *api.GetTelemetryData
 
 }
 
-func validate(datum *api.TelemetryDatum) error {
+func (s *server) StreamTelemetryData(req *api.GetTelemetryDataRequest, stream api.TelemetryService_StreamTelemetryDataServer) error {
+
+	var data *api.TelemetryData
+	var nextPageToken string
+	var err error
+
+	// Reject an invalid request with a single error response before any telemetry data is sent.
+	if err = models.ValidateTelemetryDataRequest(*req); err != nil {
+		logger.Error(fmt.Sprintf("invalid telemetry data request: %v", err))
+		return stream.Send(&api.GetTelemetryDataResponse{Details: &api.ResponseDetails{Code: api.ResponseCode_ERROR,
+			Message: fmt.Sprintf("invalid telemetry data request: %v", err)}})
+	}
+
+	pageReq := *req
+
+	for {
+		resp := new(api.GetTelemetryDataResponse)
+
+		if data, nextPageToken, err = models.RetrieveTelemetryDataPage(pageReq); err != nil {
+			resp.Details = &api.ResponseDetails{Code: api.ResponseCode_ERROR,
+				Message: fmt.Sprintf("failed to retrieve telemetry data with error: %v", err)}
+			logger.Error(fmt.Sprintf("failed to retrieve telemetry data with error: %v", err))
+			// Same as the unary calls, report the FOTAAS error via response code & message and
+			// end the stream normally.
+			return stream.Send(resp)
+		}
+
+		resp.Details = &api.ResponseDetails{Code: api.ResponseCode_OK,
+			Message: fmt.Sprintf("found %v telemetry datum", len(data.TelemetryDatumMap))}
+		resp.TelemetryData = data
+		resp.NextPageToken = nextPageToken
+
+		if err = stream.Send(resp); err != nil {
+			logger.Error(fmt.Sprintf("failed to send telemetry data with error: %v", err))
+			return err
+		}
+
+		if nextPageToken == "" {
+			return nil
+		}
+		pageReq.PageToken = nextPageToken
+	}
+}
+
+// exportChunkSize is the size of the file chunks sent by ExportTelemetry, well below the gRPC
+// maximum message size.
+const exportChunkSize = 64 * 1024
+
+// exportStreamWriter buffers the exported file and sends it to the client in chunks.
+type exportStreamWriter struct {
+	stream api.TelemetryService_ExportTelemetryServer
+	buf    []byte
+}
+
+func (w *exportStreamWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for len(w.buf) >= exportChunkSize {
+		if err := w.send(w.buf[:exportChunkSize]); err != nil {
+			return 0, err
+		}
+		w.buf = w.buf[exportChunkSize:]
+	}
+	return len(p), nil
+}
+
+// Flush sends the remaining buffered bytes.
+func (w *exportStreamWriter) Flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	err := w.send(w.buf)
+	w.buf = nil
+	return err
+}
+
+func (w *exportStreamWriter) send(chunk []byte) error {
+	return w.stream.Send(&api.ExportTelemetryResponse{Details: &api.ResponseDetails{Code: api.ResponseCode_OK},
+		Chunk: append([]byte(nil), chunk...)})
+}
+
+// ExportTelemetry streams the telemetry data matching the request filter as a file in the requested
+// format and layout. The file is sent in chunks, the last response carries no chunk and reports the
+// number of telemetry datum exported. A response with an error code means that the file is
+// incomplete.
+func (s *server) ExportTelemetry(req *api.ExportTelemetryRequest, stream api.TelemetryService_ExportTelemetryServer) error {
+
+	sendError := func(msg string) error {
+		logger.Error(msg)
+		// Same as the unary calls, report the FOTAAS error via response code & message and end the
+		// stream normally.
+		return stream.Send(&api.ExportTelemetryResponse{Details: &api.ResponseDetails{Code: api.ResponseCode_ERROR,
+			Message: msg}})
+	}
+
+	if req.Filter == nil {
+		return sendError("failed to export telemetry data with error: missing telemetry data filter")
+	}
+
+	// Reject an invalid filter before the first chunk is sent.
+	if err := models.ValidateTelemetryDataRequest(*req.Filter); err != nil {
+		return sendError(fmt.Sprintf("invalid telemetry data filter: %v", err))
+	}
+
+	w := &exportStreamWriter{stream: stream}
+
+	// The wide layout has a value column per channel registered when the export starts.
+	var registered []string
+	for _, v := range channels.List(nil) {
+		if !v.BuiltIn {
+			registered = append(registered, v.Name)
+		}
+	}
+
+	enc, err := export.NewEncoder(w, req.Format, req.Layout, registered)
+	if err != nil {
+		return sendError(fmt.Sprintf("failed to export telemetry data with error: %v", err))
+	}
+
+	var data *api.TelemetryData
+	var nextPageToken string
+
+	pageReq := *req.Filter
+	pageReq.PageToken = ""
+
+	for {
+		if data, nextPageToken, err = models.RetrieveTelemetryDataPage(pageReq); err != nil {
+			return sendError(fmt.Sprintf("failed to retrieve telemetry data with error: %v", err))
+		}
+
+		page := make([]*api.TelemetryDatum, 0, len(data.TelemetryDatumMap))
+		for _, v := range data.TelemetryDatumMap {
+			page = append(page, v)
+		}
+		if err = enc.Encode(page); err != nil {
+			return sendError(fmt.Sprintf("failed to export telemetry data with error: %v", err))
+		}
+
+		if nextPageToken == "" {
+			break
+		}
+		pageReq.PageToken = nextPageToken
+	}
+
+	if err = enc.Close(); err != nil {
+		return sendError(fmt.Sprintf("failed to export telemetry data with error: %v", err))
+	}
+	if err = w.Flush(); err != nil {
+		logger.Error(fmt.Sprintf("failed to send exported telemetry data with error: %v", err))
+		return err
+	}
+
+	msg := fmt.Sprintf("exported %v telemetry datum as %v %v rows", enc.Datums(), enc.Rows(), strings.ToLower(req.Layout.String()))
+	if enc.Skipped() > 0 {
+		msg += fmt.Sprintf(", skipped %v telemetry datum of channels registered during the export", enc.Skipped())
+	}
+	logger.Info(msg)
+
+	return stream.Send(&api.ExportTelemetryResponse{Details: &api.ResponseDetails{Code: api.ResponseCode_OK,
+		Message: msg}})
+}
+
+func (s *server) GetTelemetryAggregates(ctx context.Context, req *api.GetTelemetryAggregatesRequest) (*api.GetTelemetryAggregatesResponse, error) {
+
+	resp := new(api.GetTelemetryAggregatesResponse)
+
+	if req.Filter != nil {
+		if err := models.ValidateTelemetryDataRequest(*req.Filter); err != nil {
+			resp.Details = &api.ResponseDetails{Code: api.ResponseCode_ERROR,
+				Message: fmt.Sprintf("invalid telemetry data filter: %v", err)}
+			logger.Error(fmt.Sprintf("invalid telemetry data filter: %v", err))
+			return resp, nil
+		}
+	}
+
+	series, err := models.RetrieveTelemetryAggregates(*req)
+	if err != nil {
+		resp.Details = &api.ResponseDetails{Code: api.ResponseCode_ERROR,
+			Message: fmt.Sprintf("failed to retrieve telemetry aggregates with error: %v", err)}
+		logger.Error(fmt.Sprintf("failed to retrieve telemetry aggregates with error: %v", err))
+		// protoc generated code requires error in the return params, return nil here so that clients
+		// of this service can process this FOTAAS error differently than other system errors (e.g.
+		// if this service is not available). Intercept this error and handle it via response code &
+		// message.
+		return resp, nil
+	}
+
+	resp.Details = &api.ResponseDetails{Code: api.ResponseCode_OK,
+		Message: fmt.Sprintf("found %v telemetry aggregate series", len(series))}
+	resp.Series = series
+
+	return resp, nil
+}
+
+func (s *server) SubscribeTelemetry(req *api.SubscribeTelemetryRequest, stream api.TelemetryService_SubscribeTelemetryServer) error {
+
+	sub := telemetryHub.Subscribe(hub.Filter{SimulationID: req.SimulationUuid, Constructors: req.Constructors,
+		CarNumbers: req.CarNumbers, Descriptions: req.DatumDescriptions, Channels: req.Channels})
+	defer telemetryHub.Unsubscribe(sub)
 
-	// Check the uuid for valid format
-	if _, err := uuid.Parse(datum.Uuid); err != nil {
+	// Let the client know that the subscription is in place before any telemetry data arrives.
+	if err := stream.Send(&api.SubscribeTelemetryResponse{Details: &api.ResponseDetails{Code: api.ResponseCode_OK,
+		Message: "telemetry subscription started"}}); err != nil {
 		return err
 	}
 
-	// Check that the telemetry datum unit is valid for the description
-	switch datum.Description {
-	case api.TelemetryDatumDescription_BRAKE_TEMP_FL, api.TelemetryDatumDescription_BRAKE_TEMP_FR, api.TelemetryDatumDescription_BRAKE_TEMP_RL,
-		api.TelemetryDatumDescription_BRAKE_TEMP_RR, api.TelemetryDatumDescription_ENGINE_COOLANT_TEMP, api.TelemetryDatumDescription_ENGINE_OIL_TEMP,
-		api.TelemetryDatumDescription_ENERGY_STORAGE_TEMP, api.TelemetryDatumDescription_TIRE_TEMP_FL, api.TelemetryDatumDescription_TIRE_TEMP_FR,
-		api.TelemetryDatumDescription_TIRE_TEMP_RL, api.TelemetryDatumDescription_TIRE_TEMP_RR:
-		if datum.Unit != api.TelemetryDatumUnit_DEGREE_CELCIUS {
-			//return errors.New("invalid telemetry datum unit")
-			return fmt.Errorf("invalid telemetry datum unit for %v, expected TelemetryDatumUnit_DEGREE_CELCIUS got %v",
-				datum.Description.String(), datum.Unit.String())
-		}
-	case api.TelemetryDatumDescription_TIRE_PRESSURE_FL, api.TelemetryDatumDescription_TIRE_PRESSURE_FR, api.TelemetryDatumDescription_TIRE_PRESSURE_RL,
-		api.TelemetryDatumDescription_TIRE_PRESSURE_RR:
-		if datum.Unit != api.TelemetryDatumUnit_BAR {
-			//return errors.New("invalid telemetry datum unit")
-			return fmt.Errorf("invalid telemetry datum unit for %v, expected TelemetryDatumUnit_BAR got %v",
-				datum.Description.String(), datum.Unit.String())
-		}
-	case api.TelemetryDatumDescription_MGUK_OUTPUT, api.TelemetryDatumDescription_MGUH_OUTPUT:
-		if datum.Unit != api.TelemetryDatumUnit_JPS {
-			//return errors.New("invalid telemetry datum unit")
-			return fmt.Errorf("invalid telemetry datum unit for %v, expected TelemetryDatumUnit_JPS got %v",
-				datum.Description.String(), datum.Unit.String())
-		}
-	case api.TelemetryDatumDescription_SPEED:
-		if datum.Unit != api.TelemetryDatumUnit_KPH {
-			//return errors.New("invalid telemetry datum unit")
-			return fmt.Errorf("invalid telemetry datum unit for %v, expected TelemetryDatumUnit_KPH got %v",
-				datum.Description.String(), datum.Unit.String())
-		}
-	case api.TelemetryDatumDescription_ENGINE_OIL_PRESSURE:
-		if datum.Unit != api.TelemetryDatumUnit_KPA {
-			//return errors.New("invalid telemetry datum unit")
-			return fmt.Errorf("invalid telemetry datum unit for %v, expected TelemetryDatumUnit_KPA got %v",
-				datum.Description.String(), datum.Unit.String())
-		}
-	case api.TelemetryDatumDescription_G_FORCE:
-		if datum.Unit != api.TelemetryDatumUnit_G {
-			//return errors.New("invalid telemetry datum unit")
-			return fmt.Errorf("invalid telemetry datum unit for %v, expected TelemetryDatumUnit_G got %v",
-				datum.Description.String(), datum.Unit.String())
-		}
-	case api.TelemetryDatumDescription_FUEL_CONSUMED:
-		if datum.Unit != api.TelemetryDatumUnit_KG {
-			//return errors.New("invalid telemetry datum unit")
-			return fmt.Errorf("invalid telemetry datum unit for %v, expected TelemetryDatumUnit_KG got %v",
-				datum.Description.String(), datum.Unit.String())
-		}
-	case api.TelemetryDatumDescription_FUEL_FLOW:
-		if datum.Unit != api.TelemetryDatumUnit_KG_PER_HOUR {
-			//return errors.New("invalid telemetry datum unit")
-			return fmt.Errorf("invalid telemetry datum unit for %v, expected TelemetryDatumUnit_KG_PER_HOUR got %v",
-				datum.Description.String(), datum.Unit.String())
-		}
-	case api.TelemetryDatumDescription_ENGINE_RPM:
-		if datum.Unit != api.TelemetryDatumUnit_RPM {
-			//return errors.New("invalid telemetry datum unit")
-			return fmt.Errorf("invalid telemetry datum unit for %v, expected TelemetryDatumUnit_RPM got %v",
-				datum.Description.String(), datum.Unit.String())
-		}
-	case api.TelemetryDatumDescription_ENERGY_STORAGE_LEVEL:
-		if datum.Unit != api.TelemetryDatumUnit_MJ {
-			//return errors.New("invalid telemetry datum unit")
-			return fmt.Errorf("invalid telemetry datum unit for %v, expected TelemetryDatumUnit_MJ got %v",
-				datum.Description.String(), datum.Unit.String())
-		}
-	case api.TelemetryDatumDescription_G_FORCE_DIRECTION:
-		if datum.Unit != api.TelemetryDatumUnit_RADIAN {
-			//return errors.New("invalid telemetry datum unit")
-			return fmt.Errorf("invalid telemetry datum unit for %v, expected TelemetryDatumUnit_RADIAN got %v",
-				datum.Description.String(), datum.Unit.String())
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-sub.Done():
+			resp := &api.SubscribeTelemetryResponse{Details: &api.ResponseDetails{Code: api.ResponseCode_ERROR,
+				Message: fmt.Sprintf("telemetry subscription closed: %v", sub.Err())}, DroppedDatumCount: sub.TakeDropped()}
+			logger.Warn(fmt.Sprintf("telemetry subscription closed with error: %v", sub.Err()))
+			return stream.Send(resp)
+		case datum := <-sub.C:
+			data := &api.TelemetryData{TelemetryDatumMap: map[string]*api.TelemetryDatum{datum.Uuid: datum}}
+			// Batch whatever else is already buffered into the same response.
+		batch:
+			for len(data.TelemetryDatumMap) < maxSubscribeBatchSize {
+				select {
+				case datum = <-sub.C:
+					data.TelemetryDatumMap[datum.Uuid] = datum
+				default:
+					break batch
+				}
+			}
+			resp := &api.SubscribeTelemetryResponse{Details: &api.ResponseDetails{Code: api.ResponseCode_OK,
+				Message: fmt.Sprintf("%v telemetry datum", len(data.TelemetryDatumMap))},
+				TelemetryData: data, DroppedDatumCount: sub.TakeDropped()}
+			if err := stream.Send(resp); err != nil {
+				logger.Error(fmt.Sprintf("failed to send telemetry subscription data with error: %v", err))
+				return err
+			}
 		}
+	}
+}
+
+func (s *server) PurgeTelemetry(ctx context.Context, req *api.PurgeTelemetryRequest) (*api.PurgeTelemetryResponse, error) {
+
+	resp := new(api.PurgeTelemetryResponse)
+	resp.DryRun = req.DryRun
+
+	result, err := models.PurgeTelemetryData(retentionPolicy, time.Now(), req.DryRun)
+	resp.SimulatedDatumCount = result.SimulatedCount
+	resp.RealDatumCount = result.RealCount
+	if err != nil {
+		resp.Details = &api.ResponseDetails{Code: api.ResponseCode_ERROR,
+			Message: fmt.Sprintf("failed to purge telemetry data with error: %v", err)}
+		logger.Error(fmt.Sprintf("failed to purge telemetry data with error: %v", err))
+		// protoc generated code requires error in the return params, return nil here so that clients
+		// of this service can process this FOTAAS error differently than other system errors (e.g.
+		// if this service is not available). Intercept this error and handle it via response code &
+		// message.
+		return resp, nil
+	}
+
+	if req.DryRun {
+		resp.Details = &api.ResponseDetails{Code: api.ResponseCode_OK,
+			Message: fmt.Sprintf("%v simulated and %v real telemetry datum would be purged", result.SimulatedCount, result.RealCount)}
+	} else {
+		resp.Details = &api.ResponseDetails{Code: api.ResponseCode_OK,
+			Message: fmt.Sprintf("%v simulated and %v real telemetry datum purged", result.SimulatedCount, result.RealCount)}
+		logger.Info(resp.Details.Message)
+	}
+
+	return resp, nil
+}
+
+func (s *server) GetIngestStats(ctx context.Context, req *api.GetIngestStatsRequest) (*api.GetIngestStatsResponse, error) {
+
+	stats := ingestQueue.Stats()
+
+	resp := new(api.GetIngestStatsResponse)
+	resp.IngestStats = &api.IngestStats{QueueDepth: int32(stats.Depth), QueueCapacity: int32(stats.Capacity),
+		FlushWorkers: int32(stats.Workers), FlushCount: stats.Flushes, FlushedDatumCount: stats.FlushedDatum,
+		FailedDatumCount: stats.FailedDatum, RejectedDatumCount: stats.RejectedDatum,
+		LastFlushLatencyMs: durationMs(stats.LastFlushLatency), MeanFlushLatencyMs: durationMs(stats.MeanFlushLatency),
+		MaxFlushLatencyMs: durationMs(stats.MaxFlushLatency)}
+	resp.Details = &api.ResponseDetails{Code: api.ResponseCode_OK,
+		Message: fmt.Sprintf("%v of %v telemetry datum queued", stats.Depth, stats.Capacity)}
+
+	return resp, nil
+}
+
+func durationMs(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+func (s *server) CreateAlarmThreshold(ctx context.Context, req *api.CreateAlarmThresholdRequest) (*api.CreateAlarmThresholdResponse, error) {
+
+	resp := new(api.CreateAlarmThresholdResponse)
+
+	threshold, err := models.CreateAlarmThreshold(req.AlarmThreshold)
+	if err != nil {
+		resp.Details = alarmThresholdErrorDetails("create", err)
+		// protoc generated code requires error in the return params, return nil here so that clients
+		// of this service can process this FOTAAS error differently than other system errors (e.g.
+		// if this service is not available). Intercept this error and handle it via response code &
+		// message.
+		return resp, nil
+	}
+
+	reloadAlarmThresholds()
+
+	resp.AlarmThreshold = threshold
+	resp.Details = &api.ResponseDetails{Code: api.ResponseCode_OK,
+		Message: fmt.Sprintf("alarm threshold %v successfully created", threshold.Uuid)}
+
+	return resp, nil
+}
+
+func (s *server) GetAlarmThreshold(ctx context.Context, req *api.GetAlarmThresholdRequest) (*api.GetAlarmThresholdResponse, error) {
+
+	resp := new(api.GetAlarmThresholdResponse)
+
+	threshold, err := models.RetrieveAlarmThreshold(req.Uuid)
+	if err != nil {
+		resp.Details = alarmThresholdErrorDetails("retrieve", err)
+		// protoc generated code requires error in the return params, return nil here so that clients
+		// of this service can process this FOTAAS error differently than other system errors (e.g.
+		// if this service is not available). Intercept this error and handle it via response code &
+		// message.
+		return resp, nil
+	}
+
+	resp.AlarmThreshold = threshold
+	resp.Details = &api.ResponseDetails{Code: api.ResponseCode_OK,
+		Message: fmt.Sprintf("alarm threshold %v successfully retrieved", threshold.Uuid)}
+
+	return resp, nil
+}
+
+func (s *server) ListAlarmThresholds(ctx context.Context, req *api.ListAlarmThresholdsRequest) (*api.ListAlarmThresholdsResponse, error) {
+
+	resp := new(api.ListAlarmThresholdsResponse)
+
+	thresholds, err := models.RetrieveAlarmThresholds(req.DatumDescriptions)
+	if err != nil {
+		resp.Details = alarmThresholdErrorDetails("list", err)
+		// protoc generated code requires error in the return params, return nil here so that clients
+		// of this service can process this FOTAAS error differently than other system errors (e.g.
+		// if this service is not available). Intercept this error and handle it via response code &
+		// message.
+		return resp, nil
+	}
+
+	resp.AlarmThresholds = thresholds
+	resp.Details = &api.ResponseDetails{Code: api.ResponseCode_OK,
+		Message: fmt.Sprintf("%v alarm thresholds successfully retrieved", len(thresholds))}
+
+	return resp, nil
+}
+
+func (s *server) UpdateAlarmThreshold(ctx context.Context, req *api.UpdateAlarmThresholdRequest) (*api.UpdateAlarmThresholdResponse, error) {
+
+	resp := new(api.UpdateAlarmThresholdResponse)
+
+	threshold, err := models.UpdateAlarmThreshold(req.AlarmThreshold)
+	if err != nil {
+		resp.Details = alarmThresholdErrorDetails("update", err)
+		// protoc generated code requires error in the return params, return nil here so that clients
+		// of this service can process this FOTAAS error differently than other system errors (e.g.
+		// if this service is not available). Intercept this error and handle it via response code &
+		// message.
+		return resp, nil
+	}
+
+	reloadAlarmThresholds()
+
+	resp.AlarmThreshold = threshold
+	resp.Details = &api.ResponseDetails{Code: api.ResponseCode_OK,
+		Message: fmt.Sprintf("alarm threshold %v successfully updated", threshold.Uuid)}
+
+	return resp, nil
+}
+
+func (s *server) DeleteAlarmThreshold(ctx context.Context, req *api.DeleteAlarmThresholdRequest) (*api.DeleteAlarmThresholdResponse, error) {
+
+	resp := new(api.DeleteAlarmThresholdResponse)
+
+	if err := models.DeleteAlarmThreshold(req.Uuid); err != nil {
+		resp.Details = alarmThresholdErrorDetails("delete", err)
+		// protoc generated code requires error in the return params, return nil here so that clients
+		// of this service can process this FOTAAS error differently than other system errors (e.g.
+		// if this service is not available). Intercept this error and handle it via response code &
+		// message.
+		return resp, nil
+	}
+
+	reloadAlarmThresholds()
+
+	resp.Details = &api.ResponseDetails{Code: api.ResponseCode_OK,
+		Message: fmt.Sprintf("alarm threshold %v successfully deleted", req.Uuid)}
+
+	return resp, nil
+}
+
+// alarmThresholdErrorDetails returns the response details for an alarm threshold registry error,
+// op is the failed operation.
+func alarmThresholdErrorDetails(op string, err error) *api.ResponseDetails {
+	switch err {
+	case models.ErrAlarmThresholdNotFound:
+		return &api.ResponseDetails{Code: api.ResponseCode_NOT_FOUND, Message: err.Error()}
+	case models.ErrAlarmThresholdConflict:
+		return &api.ResponseDetails{Code: api.ResponseCode_CONFLICT, Message: err.Error()}
 	default:
-		return fmt.Errorf("invalid telemetry datum description %v", datum.Description)
+		logger.Error(fmt.Sprintf("failed to %v alarm threshold with error: %v", op, err))
+		return &api.ResponseDetails{Code: api.ResponseCode_ERROR,
+			Message: fmt.Sprintf("failed to %v alarm threshold with error: %v", op, err)}
 	}
+}
 
-	return nil
+// reloadAlarmThresholds refreshes the alarm evaluator after a change to the alarm threshold registry.
+func reloadAlarmThresholds() {
+	thresholds, err := models.RetrieveAlarmThresholds(nil)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to reload alarm threshold registry with error: %v", err))
+		return
+	}
+	alarmEvaluator.SetThresholds(thresholds)
+}
+
+func (s *server) RegisterChannel(ctx context.Context, req *api.RegisterChannelRequest) (*api.RegisterChannelResponse, error) {
+
+	resp := new(api.RegisterChannelResponse)
+
+	c, err := models.RegisterChannel(req.Channel)
+	if err != nil {
+		if err == models.ErrChannelConflict {
+			resp.Details = &api.ResponseDetails{Code: api.ResponseCode_CONFLICT, Message: err.Error()}
+		} else {
+			resp.Details = &api.ResponseDetails{Code: api.ResponseCode_ERROR,
+				Message: fmt.Sprintf("failed to register channel with error: %v", err)}
+			logger.Error(fmt.Sprintf("failed to register channel with error: %v", err))
+		}
+		// protoc generated code requires error in the return params, return nil here so that clients
+		// of this service can process this FOTAAS error differently than other system errors (e.g.
+		// if this service is not available). Intercept this error and handle it via response code &
+		// message.
+		return resp, nil
+	}
+
+	channels.Add(c)
+
+	resp.Channel = c
+	resp.Details = &api.ResponseDetails{Code: api.ResponseCode_OK,
+		Message: fmt.Sprintf("channel %v successfully registered", c.Name)}
+	logger.Info(resp.Details.Message)
+
+	return resp, nil
+}
+
+func (s *server) ListChannels(ctx context.Context, req *api.ListChannelsRequest) (*api.ListChannelsResponse, error) {
+
+	resp := new(api.ListChannelsResponse)
+
+	resp.Channels = channels.List(req.Categories)
+	resp.Details = &api.ResponseDetails{Code: api.ResponseCode_OK,
+		Message: fmt.Sprintf("%v channels successfully retrieved", len(resp.Channels))}
+
+	return resp, nil
+}
+
+func (s *server) GetTransmitProgress(ctx context.Context, req *api.GetTransmitProgressRequest) (*api.GetTransmitProgressResponse, error) {
+
+	resp := new(api.GetTransmitProgressResponse)
+
+	if _, err := uuid.Parse(req.SimulationUuid); err != nil {
+		resp.Details = &api.ResponseDetails{Code: api.ResponseCode_ERROR,
+			Message: fmt.Sprintf("invalid simulation uuid: %v", err)}
+		return resp, nil
+	}
+
+	progress, err := models.RetrieveTransmitProgress(req.SimulationUuid)
+	if err != nil {
+		resp.Details = &api.ResponseDetails{Code: api.ResponseCode_ERROR,
+			Message: fmt.Sprintf("failed to retrieve transmit progress with error: %v", err)}
+		logger.Error(fmt.Sprintf("failed to retrieve transmit progress with error: %v", err))
+		// protoc generated code requires error in the return params, return nil here so that clients
+		// of this service can process this FOTAAS error differently than other system errors (e.g.
+		// if this service is not available). Intercept this error and handle it via response code &
+		// message.
+		return resp, nil
+	}
+
+	resp.Cars = progress
+	resp.Details = &api.ResponseDetails{Code: api.ResponseCode_OK,
+		Message: fmt.Sprintf("transmit progress of %v cars successfully retrieved", len(progress))}
+
+	return resp, nil
+}
+
+// purgeWorker purges the telemetry data that is older than the retention policy allows every interval.
+func purgeWorker(interval time.Duration) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		result, err := models.PurgeTelemetryData(retentionPolicy, time.Now(), false)
+		if err != nil {
+			logger.Error(fmt.Sprintf("telemetry purge failed with error: %v", err))
+			continue
+		}
+		if result.SimulatedCount > 0 || result.RealCount > 0 {
+			logger.Info(fmt.Sprintf("%v simulated and %v real telemetry datum purged", result.SimulatedCount, result.RealCount))
+		}
+	}
 }
 
 func main() {
@@ -276,8 +1096,37 @@ func main() {
 
 	api.RegisterTelemetryServiceServer(svr, &server{})
 
+	// TELEMETRY_PURGE_INTERVAL_MINUTES of 0 (or unset) disables the background purge, a purge can
+	// still be run on demand via PurgeTelemetry.
+	if v := os.Getenv("TELEMETRY_PURGE_INTERVAL_MINUTES"); v != "" {
+		minutes, err := strconv.Atoi(v)
+		if err != nil || minutes < 0 {
+			logger.Fatal(fmt.Sprintf("invalid TELEMETRY_PURGE_INTERVAL_MINUTES %v", v))
+		}
+		if minutes > 0 {
+			go purgeWorker(time.Duration(minutes) * time.Minute)
+		}
+	}
+
+	// On SIGINT or SIGTERM the server stops accepting RPCs and waits for the pending ones, then the
+	// ingest queue flushes the telemetry data that it accepted. Telemetry subscriptions only end when
+	// their client leaves so they are closed first.
+	stopped := make(chan struct{})
+	go func() {
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+		logger.Info(fmt.Sprintf("telemetry service stopping on signal %v", <-sig))
+		telemetryHub.Close()
+		svr.GracefulStop()
+		close(stopped)
+	}()
+
 	if err := svr.Serve(listener); err != nil {
 		logger.Fatal(fmt.Sprintf("failed to serve on telemetry service port %v with error: %v", telemetrySvcPort, err))
 	}
 
+	<-stopped
+	ingestQueue.Close()
+	logger.Info("telemetry service stopped")
+
 }
@@ -3,6 +3,7 @@ package analysis
 import (
 	"context"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"strings"
@@ -60,85 +61,42 @@ func ExtractAlarmAnalysisData(req *api.GetAlarmAnalysisRequest) (*api.AlarmAnaly
 	dataReq.SearchBy.HighAlarm = true
 	dataReq.SearchBy.LowAlarm = true
 
-	var sb strings.Builder
-	sb.WriteString(os.Getenv("TELEMETRY_SERVICE_HOST"))
-	sb.WriteString(":")
-	sb.WriteString(os.Getenv("TELEMETRY_SERVICE_PORT"))
-	telemetrySvcEndpoint := sb.String()
+	ccac := make(map[constructorCar]alarmCounts)
 
-	conn, err := grpc.Dial(telemetrySvcEndpoint, grpc.WithInsecure())
+	datumCount, err := streamTelemetryData(dataReq, func(v *api.TelemetryDatum) {
+		cc := constructorCar{constructor: v.Constructor, carNumber: v.CarNumber}
+		ac := ccac[cc]
+		if v.HighAlarm {
+			ac.highAlarmCount++
+		}
+		if v.LowAlarm {
+			ac.lowAlarmCount++
+		}
+		ccac[cc] = ac
+	})
 	if err != nil {
 		return nil, err
 	}
-	defer conn.Close()
 
-	// TODO: determine what the appropriate deadline should be for this service call.
-	clientDeadline := time.Now().Add(time.Duration(300) * time.Second)
-	ctx, cancel := context.WithDeadline(context.Background(), clientDeadline)
-
-	defer cancel()
-
-	var client = api.NewTelemetryServiceClient(conn)
-
-	resp, err := client.GetTelemetryData(ctx, dataReq)
-	if err != nil {
-		return nil, err
+	if datumCount == 0 {
+		// no errors & no telemetry data, caller needs to check for nil
+		return nil, nil
 	}
 
-	switch resp.Details.Code {
-	case api.ResponseCode_OK:
-
-		telemetryData := resp.TelemetryData
-		if telemetryData == nil || len(telemetryData.TelemetryDatumMap) == 0 {
-			// no errors & no telemetry data, caller needs to check for nil
-			return nil, nil
-		}
-
-		ccac := make(map[constructorCar]alarmCounts)
-
-		for _, v := range telemetryData.TelemetryDatumMap {
-			ac := alarmCounts{}
-			cc := constructorCar{constructor: v.Constructor, carNumber: v.CarNumber}
-			if _, ok := ccac[cc]; !ok {
-				if v.HighAlarm {
-					ac.highAlarmCount++
-				}
-				if v.LowAlarm {
-					ac.lowAlarmCount++
-				}
-				ccac[cc] = ac
-			} else {
-				ac = ccac[cc]
-				if v.HighAlarm {
-					ac.highAlarmCount++
-				}
-				if v.LowAlarm {
-					ac.lowAlarmCount++
-				}
-				ccac[cc] = ac
-			}
-		}
-
-		data.Simulated = req.Simulated
-		data.DateRangeBegin = req.DateRangeBegin
-		data.DateRangeEnd = req.DateRangeEnd
-
-		for k, v := range ccac {
-			ac := api.AlarmAnalysisData_AlarmCountsByConstructorAndCar{}
-			ac.Constructor = k.constructor
-			ac.CarNumber = k.carNumber
-			ac.HighAlarmCount = v.highAlarmCount
-			ac.LowAlarmCount = v.lowAlarmCount
-			data.AlarmCounts = append(data.AlarmCounts, &ac)
-		}
-
-		return data, nil
-
-	case api.ResponseCode_ERROR:
-		return nil, fmt.Errorf("failed to retrieve telemetry data, response message from telemetry service was: %v", resp.Details.Message)
-	default:
-		return nil, fmt.Errorf("failed to retrieve telemetry data, invalid reponse code: %v", resp.Details.Code.String())
+	data.Simulated = req.Simulated
+	data.DateRangeBegin = req.DateRangeBegin
+	data.DateRangeEnd = req.DateRangeEnd
+
+	for k, v := range ccac {
+		ac := api.AlarmAnalysisData_AlarmCountsByConstructorAndCar{}
+		ac.Constructor = k.constructor
+		ac.CarNumber = k.carNumber
+		ac.HighAlarmCount = v.highAlarmCount
+		ac.LowAlarmCount = v.lowAlarmCount
+		data.AlarmCounts = append(data.AlarmCounts, &ac)
 	}
+
+	return data, nil
 }
 
 func ExtractConstructorAlarmAnalysisData(req *api.GetConstructorAlarmAnalysisRequest) (*api.ConstructorAlarmAnalysisData, error) {
@@ -159,6 +117,51 @@ func ExtractConstructorAlarmAnalysisData(req *api.GetConstructorAlarmAnalysisReq
 	dataReq.SearchBy.HighAlarm = true
 	dataReq.SearchBy.LowAlarm = true
 
+	dac := make(map[api.TelemetryDatumDescription]alarmCounts)
+
+	datumCount, err := streamTelemetryData(dataReq, func(v *api.TelemetryDatum) {
+		ac := dac[v.Description]
+		if v.HighAlarm {
+			ac.highAlarmCount++
+		}
+		if v.LowAlarm {
+			ac.lowAlarmCount++
+		}
+		dac[v.Description] = ac
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if datumCount == 0 {
+		// no errors & no telemetry data, caller needs to check for nil
+		return nil, nil
+	}
+
+	data.Simulated = req.Simulated
+	data.DateRangeBegin = req.DateRangeBegin
+	data.DateRangeEnd = req.DateRangeEnd
+	data.Constructor = req.Constructor
+	data.CarNumber = req.CarNumber
+
+	for k, v := range dac {
+		ac := api.ConstructorAlarmAnalysisData_AlarmCountsByDatumDescription{}
+		ac.DatumDescription = k
+		ac.LowAlarmCount = v.lowAlarmCount
+		ac.HighAlarmCount = v.highAlarmCount
+		data.AlarmCounts = append(data.AlarmCounts, &ac)
+	}
+
+	return data, nil
+}
+
+// streamTelemetryData retrieves the telemetry data matching dataReq from the telemetry service
+// page by page and calls fn for each datum, so that the full result set never has to be held in
+// memory (or fit in a single grpc message). It returns the number of datum streamed.
+func streamTelemetryData(dataReq *api.GetTelemetryDataRequest, fn func(*api.TelemetryDatum)) (int, error) {
+
+	var datumCount int
+
 	var sb strings.Builder
 	sb.WriteString(os.Getenv("TELEMETRY_SERVICE_HOST"))
 	sb.WriteString(":")
@@ -167,7 +170,7 @@ func ExtractConstructorAlarmAnalysisData(req *api.GetConstructorAlarmAnalysisReq
 
 	conn, err := grpc.Dial(telemetrySvcEndpoint, grpc.WithInsecure())
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 	defer conn.Close()
 
@@ -179,64 +182,33 @@ func ExtractConstructorAlarmAnalysisData(req *api.GetConstructorAlarmAnalysisReq
 
 	var client = api.NewTelemetryServiceClient(conn)
 
-	resp, err := client.GetTelemetryData(ctx, dataReq)
+	stream, err := client.StreamTelemetryData(ctx, dataReq)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	switch resp.Details.Code {
-	case api.ResponseCode_OK:
-
-		telemetryData := resp.TelemetryData
-		if telemetryData == nil || len(telemetryData.TelemetryDatumMap) == 0 {
-			// no errors & no telemetry data, caller needs to check for nil
-			return nil, nil
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return datumCount, nil
 		}
-
-		dac := make(map[api.TelemetryDatumDescription]alarmCounts)
-
-		for _, v := range telemetryData.TelemetryDatumMap {
-
-			ac := alarmCounts{}
-			if _, ok := dac[v.Description]; !ok {
-				if v.HighAlarm {
-					ac.highAlarmCount++
-				}
-				if v.LowAlarm {
-					ac.lowAlarmCount++
-				}
-				dac[v.Description] = ac
-			} else {
-				ac = dac[v.Description]
-				if v.HighAlarm {
-					ac.highAlarmCount++
-				}
-				if v.LowAlarm {
-					ac.lowAlarmCount++
-				}
-				dac[v.Description] = ac
-			}
+		if err != nil {
+			return 0, err
 		}
 
-		data.Simulated = req.Simulated
-		data.DateRangeBegin = req.DateRangeBegin
-		data.DateRangeEnd = req.DateRangeEnd
-		data.Constructor = req.Constructor
-		data.CarNumber = req.CarNumber
-
-		for k, v := range dac {
-			ac := api.ConstructorAlarmAnalysisData_AlarmCountsByDatumDescription{}
-			ac.DatumDescription = k
-			ac.LowAlarmCount = v.lowAlarmCount
-			ac.HighAlarmCount = v.highAlarmCount
-			data.AlarmCounts = append(data.AlarmCounts, &ac)
+		switch resp.Details.Code {
+		case api.ResponseCode_OK:
+			if resp.TelemetryData == nil {
+				continue
+			}
+			for _, v := range resp.TelemetryData.TelemetryDatumMap {
+				fn(v)
+				datumCount++
+			}
+		case api.ResponseCode_ERROR:
+			return 0, fmt.Errorf("failed to retrieve telemetry data, response message from telemetry service was: %v", resp.Details.Message)
+		default:
+			return 0, fmt.Errorf("failed to retrieve telemetry data, invalid reponse code: %v", resp.Details.Code.String())
 		}
-
-		return data, nil
-
-	case api.ResponseCode_ERROR:
-		return nil, fmt.Errorf("failed to retrieve telemetry data, response message from telemetry service was: %v", resp.Details.Message)
-	default:
-		return nil, fmt.Errorf("failed to retrieve telemetry data, invalid reponse code: %v", resp.Details.Code.String())
 	}
 }
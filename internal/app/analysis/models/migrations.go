@@ -0,0 +1,9 @@
+package models
+
+import (
+	"github.com/bburch01/FOTAAS/internal/pkg/migrate"
+)
+
+// migrations is the schema history of the analysis service database, which has no tables of its own
+// yet. Never change a migration that has been released, add a new one.
+var migrations []migrate.Migration
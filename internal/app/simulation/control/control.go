@@ -0,0 +1,167 @@
+// Package control is the registry of the simulations running in a simulation service instance. A
+// running simulation is cancelled, paused and resumed through its Control.
+package control
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrNotRunning is returned for a simulation that is not running in this service instance.
+	ErrNotRunning = errors.New("simulation is not running")
+	// ErrRunning is returned when registering a simulation that is already running.
+	ErrRunning = errors.New("simulation is already running")
+	// ErrPaused is returned when pausing a simulation that is already paused.
+	ErrPaused = errors.New("simulation is already paused")
+	// ErrNotPaused is returned when resuming a simulation that is not paused.
+	ErrNotPaused = errors.New("simulation is not paused")
+)
+
+var running = struct {
+	sync.Mutex
+	controls map[string]*Control
+}{controls: make(map[string]*Control)}
+
+// Control is the cancellation and pause state of a running simulation.
+type Control struct {
+	id     string
+	ctx    context.Context
+	cancel context.CancelFunc
+	mu     sync.Mutex
+	// resume is set while the simulation is paused, it is closed when the simulation is resumed.
+	resume chan struct{}
+}
+
+// Register adds the simulation with id simulationID to the registry of running simulations. A
+// simulation is registered before it is started, so that it can be cancelled or paused as soon as
+// the request to run it has returned.
+func Register(simulationID string) (*Control, error) {
+
+	running.Lock()
+	defer running.Unlock()
+
+	if _, ok := running.controls[simulationID]; ok {
+		return nil, ErrRunning
+	}
+
+	c := &Control{id: simulationID}
+	c.ctx, c.cancel = context.WithCancel(context.Background())
+	running.controls[simulationID] = c
+
+	return c, nil
+}
+
+// Unregister removes the simulation from the registry of running simulations once it has ended.
+func (c *Control) Unregister() {
+	running.Lock()
+	delete(running.controls, c.id)
+	running.Unlock()
+	c.cancel()
+}
+
+func lookup(simulationID string) (*Control, error) {
+	running.Lock()
+	defer running.Unlock()
+	c, ok := running.controls[simulationID]
+	if !ok {
+		return nil, ErrNotRunning
+	}
+	return c, nil
+}
+
+// Cancel cancels the running simulation with id simulationID, a paused simulation is cancelled
+// without being resumed.
+func Cancel(simulationID string) error {
+	c, err := lookup(simulationID)
+	if err != nil {
+		return err
+	}
+	c.cancel()
+	return nil
+}
+
+// Pause pauses the running simulation with id simulationID.
+func Pause(simulationID string) error {
+
+	c, err := lookup(simulationID)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.resume != nil {
+		return ErrPaused
+	}
+	c.resume = make(chan struct{})
+
+	return nil
+}
+
+// Resume resumes the paused simulation with id simulationID.
+func Resume(simulationID string) error {
+
+	c, err := lookup(simulationID)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.resume == nil {
+		return ErrNotPaused
+	}
+	close(c.resume)
+	c.resume = nil
+
+	return nil
+}
+
+// Cancelled reports whether the simulation has been cancelled.
+func (c *Control) Cancelled() bool {
+	return c.ctx.Err() != nil
+}
+
+// Paused returns a channel that is closed when the simulation is resumed, or nil if the simulation
+// is not paused.
+func (c *Control) Paused() <-chan struct{} {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.resume == nil {
+		return nil
+	}
+	return c.resume
+}
+
+// Sleep waits for d and reports whether the simulation is still running, it returns early with
+// false when the simulation is cancelled.
+func (c *Control) Sleep(d time.Duration) bool {
+
+	if c.Cancelled() {
+		return false
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-c.ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
+
+// WaitForResume waits on resume, a channel returned by Paused, and reports whether the simulation
+// was resumed rather than cancelled.
+func (c *Control) WaitForResume(resume <-chan struct{}) bool {
+	select {
+	case <-c.ctx.Done():
+		return false
+	case <-resume:
+		return true
+	}
+}
@@ -0,0 +1,83 @@
+package control
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestCancel(t *testing.T) {
+
+	id := uuid.New().String()
+
+	c, err := Register(id)
+	if err != nil {
+		t.Fatal("failed to register simulation with error: ", err)
+	}
+	if _, err = Register(id); err != ErrRunning {
+		t.Errorf("expected ErrRunning, got %v", err)
+	}
+
+	if !c.Sleep(time.Millisecond) {
+		t.Error("expected sleep to complete for a running simulation")
+	}
+	if err = Cancel(id); err != nil {
+		t.Error("failed to cancel simulation with error: ", err)
+	}
+	if !c.Cancelled() || c.Sleep(time.Hour) {
+		t.Error("expected sleep to return early for a cancelled simulation")
+	}
+
+	c.Unregister()
+	if err = Cancel(id); err != ErrNotRunning {
+		t.Errorf("expected ErrNotRunning, got %v", err)
+	}
+}
+
+func TestPauseResume(t *testing.T) {
+
+	id := uuid.New().String()
+
+	c, err := Register(id)
+	if err != nil {
+		t.Fatal("failed to register simulation with error: ", err)
+	}
+	defer c.Unregister()
+
+	if err = Resume(id); err != ErrNotPaused {
+		t.Errorf("expected ErrNotPaused, got %v", err)
+	}
+	if err = Pause(id); err != nil {
+		t.Error("failed to pause simulation with error: ", err)
+	}
+	if err = Pause(id); err != ErrPaused {
+		t.Errorf("expected ErrPaused, got %v", err)
+	}
+
+	resume := c.Paused()
+	if resume == nil {
+		t.Fatal("expected a paused simulation")
+	}
+
+	resumed := make(chan bool)
+	go func() { resumed <- c.WaitForResume(resume) }()
+	if err = Resume(id); err != nil {
+		t.Error("failed to resume simulation with error: ", err)
+	}
+	if !<-resumed || c.Paused() != nil {
+		t.Error("expected a resumed simulation")
+	}
+
+	// A paused simulation is cancelled without being resumed.
+	if err = Pause(id); err != nil {
+		t.Error("failed to pause simulation with error: ", err)
+	}
+	go func() { resumed <- c.WaitForResume(c.Paused()) }()
+	if err = Cancel(id); err != nil {
+		t.Error("failed to cancel simulation with error: ", err)
+	}
+	if <-resumed {
+		t.Error("expected a cancelled simulation not to be resumed")
+	}
+}
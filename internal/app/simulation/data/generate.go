@@ -1,7 +1,9 @@
 package data
 
 import (
+	"errors"
 	"fmt"
+	"hash/fnv"
 	"log"
 	"math"
 	"math/rand"
@@ -16,7 +18,9 @@ import (
 
 	"github.com/bburch01/FOTAAS/api"
 	"github.com/bburch01/FOTAAS/internal/app/simulation/models"
+	"github.com/bburch01/FOTAAS/internal/app/simulation/track"
 	"github.com/bburch01/FOTAAS/internal/app/telemetry"
+	"github.com/bburch01/FOTAAS/internal/app/telemetry/alarm"
 	"github.com/bburch01/FOTAAS/internal/pkg/logging"
 	"github.com/google/uuid"
 	"github.com/jmcvetta/randutil"
@@ -48,8 +52,6 @@ func init() {
 		log.Panicf("failed to initialize logging subsystem with error: %v", err)
 	}
 
-	rand.Seed(time.Now().UnixNano())
-
 }
 
 type SimMemberData struct {
@@ -110,109 +112,86 @@ var alarmTypeChoices = []randutil.Choice{
 
 var telemetryDatumParametersMap = map[api.TelemetryDatumDescription]telemetry.TelemetryDatumParameters{
 	api.TelemetryDatumDescription_BRAKE_TEMP_FL: telemetry.TelemetryDatumParameters{
-		Unit: api.TelemetryDatumUnit_DEGREE_CELCIUS, RangeLowValue: 750.0, RangeHighValue: 1050.0,
-		HighAlarmValue: 1300.0, LowAlarmValue: 0,
+		Unit: api.TelemetryDatumUnit_DEGREE_CELCIUS, RangeLowValue: 30.0, RangeHighValue: 1100.0,
 	},
 	api.TelemetryDatumDescription_BRAKE_TEMP_FR: telemetry.TelemetryDatumParameters{
-		Unit: api.TelemetryDatumUnit_DEGREE_CELCIUS, RangeLowValue: 750.0, RangeHighValue: 1050.0,
-		HighAlarmValue: 1300.0, LowAlarmValue: 0,
+		Unit: api.TelemetryDatumUnit_DEGREE_CELCIUS, RangeLowValue: 30.0, RangeHighValue: 1100.0,
 	},
 	api.TelemetryDatumDescription_BRAKE_TEMP_RL: telemetry.TelemetryDatumParameters{
-		Unit: api.TelemetryDatumUnit_DEGREE_CELCIUS, RangeLowValue: 750.0, RangeHighValue: 1050.0,
-		HighAlarmValue: 1300.0, LowAlarmValue: 0,
+		Unit: api.TelemetryDatumUnit_DEGREE_CELCIUS, RangeLowValue: 30.0, RangeHighValue: 1100.0,
 	},
 	api.TelemetryDatumDescription_BRAKE_TEMP_RR: telemetry.TelemetryDatumParameters{
-		Unit: api.TelemetryDatumUnit_DEGREE_CELCIUS, RangeLowValue: 750.0, RangeHighValue: 1050.0,
-		HighAlarmValue: 1300.0, LowAlarmValue: 0,
+		Unit: api.TelemetryDatumUnit_DEGREE_CELCIUS, RangeLowValue: 30.0, RangeHighValue: 1100.0,
 	},
 	api.TelemetryDatumDescription_ENERGY_STORAGE_LEVEL: telemetry.TelemetryDatumParameters{
 		Unit: api.TelemetryDatumUnit_MJ, RangeLowValue: 1.3, RangeHighValue: 3.8,
-		HighAlarmValue: 4.0, LowAlarmValue: 0,
 	},
 	api.TelemetryDatumDescription_ENERGY_STORAGE_TEMP: telemetry.TelemetryDatumParameters{
 		Unit: api.TelemetryDatumUnit_DEGREE_CELCIUS, RangeLowValue: 50.0, RangeHighValue: 55.0,
-		HighAlarmValue: 60.0, LowAlarmValue: 0,
 	},
 	api.TelemetryDatumDescription_ENGINE_COOLANT_TEMP: telemetry.TelemetryDatumParameters{
 		Unit: api.TelemetryDatumUnit_DEGREE_CELCIUS, RangeLowValue: 110.0, RangeHighValue: 120.0,
-		HighAlarmValue: 140.0, LowAlarmValue: 0,
 	},
 	api.TelemetryDatumDescription_ENGINE_OIL_PRESSURE: telemetry.TelemetryDatumParameters{
 		Unit: api.TelemetryDatumUnit_KPA, RangeLowValue: 300.0, RangeHighValue: 400.0,
-		HighAlarmValue: 550.0, LowAlarmValue: 40.0,
 	},
 	api.TelemetryDatumDescription_ENGINE_OIL_TEMP: telemetry.TelemetryDatumParameters{
 		Unit: api.TelemetryDatumUnit_DEGREE_CELCIUS, RangeLowValue: 110.0, RangeHighValue: 120.0,
-		HighAlarmValue: 140.0, LowAlarmValue: 0,
 	},
 	api.TelemetryDatumDescription_ENGINE_RPM: telemetry.TelemetryDatumParameters{
 		Unit: api.TelemetryDatumUnit_RPM, RangeLowValue: 2500.0, RangeHighValue: 13500.00,
-		HighAlarmValue: 15000.0, LowAlarmValue: 0,
 	},
 	api.TelemetryDatumDescription_FUEL_CONSUMED: telemetry.TelemetryDatumParameters{
 		Unit: api.TelemetryDatumUnit_KG, RangeLowValue: 0, RangeHighValue: 120.0,
-		HighAlarmValue: 125.0, LowAlarmValue: 0,
 	},
 	api.TelemetryDatumDescription_FUEL_FLOW: telemetry.TelemetryDatumParameters{
 		Unit: api.TelemetryDatumUnit_KG_PER_HOUR, RangeLowValue: 10.0, RangeHighValue: 80.0,
-		HighAlarmValue: 100.0, LowAlarmValue: 0,
 	},
 	api.TelemetryDatumDescription_G_FORCE: telemetry.TelemetryDatumParameters{
-		Unit: api.TelemetryDatumUnit_G, RangeLowValue: 2.0, RangeHighValue: 6.0,
-		HighAlarmValue: 8.0, LowAlarmValue: 0,
+		Unit: api.TelemetryDatumUnit_G, RangeLowValue: 0, RangeHighValue: 6.0,
 	},
 	api.TelemetryDatumDescription_G_FORCE_DIRECTION: telemetry.TelemetryDatumParameters{
 		Unit: api.TelemetryDatumUnit_RADIAN, RangeLowValue: 0, RangeHighValue: 6.280,
-		HighAlarmValue: 0, LowAlarmValue: 0,
 	},
 	api.TelemetryDatumDescription_MGUH_OUTPUT: telemetry.TelemetryDatumParameters{
 		Unit: api.TelemetryDatumUnit_JPS, RangeLowValue: 16.0, RangeHighValue: 19.0,
-		HighAlarmValue: 25.0, LowAlarmValue: 0,
 	},
 	api.TelemetryDatumDescription_MGUK_OUTPUT: telemetry.TelemetryDatumParameters{
 		Unit: api.TelemetryDatumUnit_JPS, RangeLowValue: 16.0, RangeHighValue: 19.0,
-		HighAlarmValue: 25.0, LowAlarmValue: 0,
 	},
 	api.TelemetryDatumDescription_SPEED: telemetry.TelemetryDatumParameters{
-		Unit: api.TelemetryDatumUnit_KPH, RangeLowValue: 100.0, RangeHighValue: 350.0,
-		HighAlarmValue: 400.0, LowAlarmValue: 0,
+		Unit: api.TelemetryDatumUnit_KPH, RangeLowValue: 60.0, RangeHighValue: 350.0,
 	},
 	api.TelemetryDatumDescription_TIRE_PRESSURE_FL: telemetry.TelemetryDatumParameters{
 		Unit: api.TelemetryDatumUnit_BAR, RangeLowValue: 1.1, RangeHighValue: 1.3,
-		HighAlarmValue: 1.6, LowAlarmValue: 0.8,
 	},
 	api.TelemetryDatumDescription_TIRE_PRESSURE_FR: telemetry.TelemetryDatumParameters{
 		Unit: api.TelemetryDatumUnit_BAR, RangeLowValue: 1.1, RangeHighValue: 1.3,
-		HighAlarmValue: 1.6, LowAlarmValue: 0.8,
 	},
 	api.TelemetryDatumDescription_TIRE_PRESSURE_RL: telemetry.TelemetryDatumParameters{
 		Unit: api.TelemetryDatumUnit_BAR, RangeLowValue: 1.1, RangeHighValue: 1.3,
-		HighAlarmValue: 1.6, LowAlarmValue: 0.8,
 	},
 	api.TelemetryDatumDescription_TIRE_PRESSURE_RR: telemetry.TelemetryDatumParameters{
 		Unit: api.TelemetryDatumUnit_BAR, RangeLowValue: 1.1, RangeHighValue: 1.3,
-		HighAlarmValue: 1.6, LowAlarmValue: 0.8,
 	},
 	api.TelemetryDatumDescription_TIRE_TEMP_FL: telemetry.TelemetryDatumParameters{
 		Unit: api.TelemetryDatumUnit_DEGREE_CELCIUS, RangeLowValue: 80.0, RangeHighValue: 120.0,
-		HighAlarmValue: 130.0, LowAlarmValue: 70.0,
 	},
 	api.TelemetryDatumDescription_TIRE_TEMP_FR: telemetry.TelemetryDatumParameters{
 		Unit: api.TelemetryDatumUnit_DEGREE_CELCIUS, RangeLowValue: 80.0, RangeHighValue: 120.0,
-		HighAlarmValue: 130.0, LowAlarmValue: 70.0,
 	},
 	api.TelemetryDatumDescription_TIRE_TEMP_RL: telemetry.TelemetryDatumParameters{
 		Unit: api.TelemetryDatumUnit_DEGREE_CELCIUS, RangeLowValue: 80.0, RangeHighValue: 120.0,
-		HighAlarmValue: 130.0, LowAlarmValue: 70.0,
 	},
 	api.TelemetryDatumDescription_TIRE_TEMP_RR: telemetry.TelemetryDatumParameters{
 		Unit: api.TelemetryDatumUnit_DEGREE_CELCIUS, RangeLowValue: 80.0, RangeHighValue: 120.0,
-		HighAlarmValue: 130.0, LowAlarmValue: 70.0,
 	},
 }
 
-func GenerateSimulatedTelemetryData(sim models.Simulation, simMember models.SimulationMember, wg *sync.WaitGroup,
-	resultsChan chan SimMemberData, errChan chan error) {
+// GenerateSimulatedTelemetryData generates the telemetry data of simMember for the whole of sim. The
+// alarm levels are resolved from thresholds, the telemetry service's alarm threshold registry.
+func GenerateSimulatedTelemetryData(sim models.Simulation, simMember models.SimulationMember, thresholds *alarm.Table,
+	wg *sync.WaitGroup, resultsChan chan SimMemberData, errChan chan error) {
 
 	var datumCount int32
 	var sampleRateInMillis int32
@@ -224,10 +203,22 @@ func GenerateSimulatedTelemetryData(sim models.Simulation, simMember models.Simu
 	var sb strings.Builder
 
 	var genAlarm bool
-	var simulatedTelemetryDataMap = make(map[api.TelemetryDatumDescription]telemetry.SimulatedTelemetryData)
+
+	// The member's choices are drawn from its own source so that they do not depend on the order the
+	// members are generated in.
+	r := newRand(sim.Seed, simMember.Constructor, simMember.CarNumber)
 
 	defer wg.Done()
 
+	// The telemetry is timestamped from the simulation's start timestamp, which a resumed simulation
+	// keeps from its first run, so that the resumed telemetry continues the timeline of the first run.
+	if sim.StartTimestamp != nil {
+		if simStartTime, err = ipbts.Timestamp(sim.StartTimestamp); err != nil {
+			errChan <- err
+			return
+		}
+	}
+
 	simDurationInMillis = sim.DurationInMinutes * 60000
 
 	switch sim.SampleRate {
@@ -253,7 +244,7 @@ func GenerateSimulatedTelemetryData(sim models.Simulation, simMember models.Simu
 	// ForceAlarm true, NoAlarms false: force the generation of an alarm
 	// ForceAlarm false, NoAlarms true: do not generate an alarm
 	if !simMember.ForceAlarm {
-		if genAlarmChoice, err = randutil.WeightedChoice(alarmEventChoices); err != nil {
+		if genAlarmChoice, err = weightedChoice(r, alarmEventChoices); err != nil {
 			errChan <- err
 		}
 		genAlarm = genAlarmChoice.Item.(bool)
@@ -262,7 +253,7 @@ func GenerateSimulatedTelemetryData(sim models.Simulation, simMember models.Simu
 	}
 
 	if !simMember.ForceAlarm && !simMember.NoAlarms {
-		if genAlarmChoice, err = randutil.WeightedChoice(alarmEventChoices); err != nil {
+		if genAlarmChoice, err = weightedChoice(r, alarmEventChoices); err != nil {
 			errChan <- err
 		}
 		genAlarm = genAlarmChoice.Item.(bool)
@@ -280,10 +271,62 @@ func GenerateSimulatedTelemetryData(sim models.Simulation, simMember models.Simu
 	}
 
 	// Alarm or not, get an alarmTypeChoice to keep the compiler happy.
-	if alarmTypeChoice, err = randutil.WeightedChoice(alarmTypeChoices); err != nil {
+	if alarmTypeChoice, err = weightedChoice(r, alarmTypeChoices); err != nil {
 		errChan <- err
 	}
 
+	// The alarm level of the chosen alarm type is whatever the registry resolves for this car at this
+	// track. If the registry has that alarm disabled for this car no alarm is generated.
+	ap := alarmTypeChoice.Item.(telemetry.AlarmParams)
+	threshold, _ := thresholds.Resolve(ap.Desc, simMember.Constructor, simMember.CarNumber, sim.Track)
+	switch ap.Mode {
+	case telemetry.High:
+		ap.Level = threshold.High
+		if genAlarm && !threshold.HasHigh {
+			logger.Warn(fmt.Sprintf("no high alarm threshold for %v, no alarm generated for simulation member: %v",
+				ap.Desc, simMember.ID))
+			genAlarm = false
+		}
+	case telemetry.Low:
+		ap.Level = threshold.Low
+		if genAlarm && !threshold.HasLow {
+			logger.Warn(fmt.Sprintf("no low alarm threshold for %v, no alarm generated for simulation member: %v",
+				ap.Desc, simMember.ID))
+			genAlarm = false
+		}
+	}
+
+	simulatedTelemetryDataMap, err := generateSimMemberData(sim, simMember, ap, genAlarm, sampleRateInMillis, datumCount,
+		simStartTime)
+	if err != nil {
+		errChan <- err
+		return
+	}
+
+	smd := SimMemberData{SimMemberID: simMember.ID, SimData: simulatedTelemetryDataMap}
+	resultsChan <- smd
+
+	return
+}
+
+// generateSimMemberData generates datumCount datum of every channel of simMember, with the alarm of ap
+// when genAlarm is set. A car is out of the simulation once it alarms so the telemetry data of every
+// channel of the car ends at the alarm datum.
+func generateSimMemberData(sim models.Simulation, simMember models.SimulationMember, ap telemetry.AlarmParams,
+	genAlarm bool, sampleRateInMillis int32, datumCount int32,
+	simStartTime time.Time) (map[api.TelemetryDatumDescription]telemetry.SimulatedTelemetryData, error) {
+
+	var simulatedTelemetryDataMap = make(map[api.TelemetryDatumDescription]telemetry.SimulatedTelemetryData)
+
+	geometry, ok := track.Lookup(sim.Track)
+	if !ok {
+		return nil, fmt.Errorf("no track geometry for track %v", sim.Track)
+	}
+
+	// The channels of the vehicle model are derived together from the car's run around the track.
+	vd := simulateVehicle(newRand(sim.Seed, simMember.Constructor, simMember.CarNumber, "vehicle"), geometry,
+		sampleRateInMillis, datumCount)
+
 	workerErrChan := make(chan error, len(telemetryDatumParametersMap))
 	workerResultsChan := make(chan telemetry.SimulatedTelemetryData, len(telemetryDatumParametersMap))
 	sem := make(chan int, runtime.NumCPU())
@@ -293,8 +336,8 @@ func GenerateSimulatedTelemetryData(sim models.Simulation, simMember models.Simu
 	logger.Debug(fmt.Sprintf("starting data generation workers for simulation member: %v", simMember.ID))
 
 	for datumDesc, datumParams := range telemetryDatumParametersMap {
-		go telemetryDataGenerationWorker(sim, simMember, datumDesc, datumParams, sampleRateInMillis,
-			alarmTypeChoice.Item.(telemetry.AlarmParams), datumCount,
+		go telemetryDataGenerationWorker(sim, simMember, datumDesc, datumParams, vd.values[datumDesc], sampleRateInMillis,
+			ap, datumCount,
 			simStartTime, genAlarm, sem, &workerWg, workerResultsChan, workerErrChan)
 	}
 
@@ -303,20 +346,28 @@ func GenerateSimulatedTelemetryData(sim models.Simulation, simMember models.Simu
 	close(workerResultsChan)
 
 	if err := <-workerErrChan; err != nil {
-		errChan <- err
+		return nil, err
 	}
 
+	end := int(datumCount)
 	for std := range workerResultsChan {
 		simulatedTelemetryDataMap[std.DatumDesc] = std
+		if std.AlarmExists {
+			end = std.AlarmIndex + 1
+		}
 	}
 
-	smd := SimMemberData{SimMemberID: simMember.ID, SimData: simulatedTelemetryDataMap}
-	resultsChan <- smd
+	for k, v := range simulatedTelemetryDataMap {
+		v.Data = v.Data[:end]
+		simulatedTelemetryDataMap[k] = v
+	}
 
-	return
+	positionSimMemberData(geometry, vd.distance, simulatedTelemetryDataMap)
+
+	return simulatedTelemetryDataMap, nil
 }
 
-func telemetryDataGenerationWorker(sim models.Simulation, simMember models.SimulationMember, tdd api.TelemetryDatumDescription, tdp telemetry.TelemetryDatumParameters, sampleRateInMillis int32, ap telemetry.AlarmParams, datumCount int32,
+func telemetryDataGenerationWorker(sim models.Simulation, simMember models.SimulationMember, tdd api.TelemetryDatumDescription, tdp telemetry.TelemetryDatumParameters, modelled []float64, sampleRateInMillis int32, ap telemetry.AlarmParams, datumCount int32,
 	simStartTime time.Time, genAlarm bool, sem chan int, wg *sync.WaitGroup,
 	resultsChan chan telemetry.SimulatedTelemetryData, errChan chan error) {
 
@@ -327,7 +378,18 @@ func telemetryDataGenerationWorker(sim models.Simulation, simMember models.Simul
 	defer wg.Done()
 	sem <- 1
 
-	values := randFloatsInRange(tdp.RangeLowValue, tdp.RangeHighValue, datumCount)
+	// The values, and the datum uuids, of every channel are drawn from their own sources. The datum
+	// uuids also depend on the simulation so that a simulation run again with the same seed does not
+	// collide with the telemetry data of the first run, while a resumed simulation regenerates the
+	// uuids of the telemetry data it already transmitted.
+	r := newRand(sim.Seed, simMember.Constructor, simMember.CarNumber, tdd)
+	uuidRand := newRand(sim.Seed, sim.ID, simMember.Constructor, simMember.CarNumber, tdd)
+
+	// A channel that is not derived from the vehicle model is drawn independently.
+	values := modelled
+	if values == nil {
+		values = randFloatsInRange(r, tdp.RangeLowValue, tdp.RangeHighValue, datumCount)
+	}
 	data := make([]api.TelemetryDatum, datumCount)
 	for i, v := range values {
 		data[i].Value = v
@@ -342,9 +404,9 @@ func telemetryDataGenerationWorker(sim models.Simulation, simMember models.Simul
 			case telemetry.High:
 				logger.Debug(fmt.Sprintf("alarm.Desc: %v alarm.Mode: %v range low: %v range high: %v"+
 					"ramp dir: up high alarm level: %v", ap.Desc, ap.Mode.String(), tdp.RangeLowValue,
-					tdp.RangeHighValue, tdp.HighAlarmValue))
-				if err := rampToAlarm(&simData, tdp.RangeLowValue, tdp.RangeHighValue, up,
-					tdp.HighAlarmValue); err != nil {
+					tdp.RangeHighValue, ap.Level))
+				if err := rampToAlarm(r, &simData, tdp.RangeLowValue, tdp.RangeHighValue, up,
+					ap.Level); err != nil {
 					errChan <- err
 					<-sem
 					return
@@ -352,9 +414,9 @@ func telemetryDataGenerationWorker(sim models.Simulation, simMember models.Simul
 			case telemetry.Low:
 				logger.Debug(fmt.Sprintf("alarm.Desc: %v alarm.Mode: %v range low: %v range high: %v"+
 					"ramp dir: down low alarm level: %v", ap.Desc, ap.Mode.String(), tdp.RangeLowValue,
-					tdp.RangeHighValue, tdp.LowAlarmValue))
-				if err := rampToAlarm(&simData, tdp.RangeLowValue, tdp.RangeHighValue, down,
-					tdp.LowAlarmValue); err != nil {
+					tdp.RangeHighValue, ap.Level))
+				if err := rampToAlarm(r, &simData, tdp.RangeLowValue, tdp.RangeHighValue, down,
+					ap.Level); err != nil {
 					errChan <- err
 					<-sem
 					return
@@ -373,7 +435,7 @@ func telemetryDataGenerationWorker(sim models.Simulation, simMember models.Simul
 			<-sem
 			return
 		}
-		simData.Data[i].Uuid = uuid.New().String()
+		simData.Data[i].Uuid = randomUUID(uuidRand).String()
 		simData.Data[i].Simulated = true
 		simData.Data[i].SimulationUuid = sim.ID
 		simData.Data[i].GranPrix = sim.GranPrix
@@ -383,10 +445,8 @@ func telemetryDataGenerationWorker(sim models.Simulation, simMember models.Simul
 		simData.Data[i].Description = tdd
 		simData.Data[i].Unit = tdp.Unit
 		simData.Data[i].Timestamp = datumTimestamp
-		//TODO: Currently, lat, long, & elevation are not modeled in the simulation.
-		simData.Data[i].Latitude = 0.0
-		simData.Data[i].Longitude = 0.0
-		simData.Data[i].Elevation = 0.0
+		// The lap position, latitude, longitude and elevation are set by positionSimMemberData from
+		// the distance covered in the vehicle model.
 		// The datum Value and (if an alarm occurred) HighAlarm (or LowAlarm) were set in
 		// the rampToAlarm() function.
 	}
@@ -398,20 +458,60 @@ func telemetryDataGenerationWorker(sim models.Simulation, simMember models.Simul
 
 }
 
-func randFloatsInRange(min, max float64, n int32) []float64 {
+// newRand returns a source of randomness seeded from seed and parts, the same seed and parts always
+// give the same source.
+func newRand(seed int64, parts ...interface{}) *rand.Rand {
+	h := fnv.New64a()
+	fmt.Fprint(h, seed)
+	for _, v := range parts {
+		fmt.Fprint(h, "/", v)
+	}
+	return rand.New(rand.NewSource(int64(h.Sum64())))
+}
+
+// weightedChoice is randutil.WeightedChoice drawing from r, randutil draws from crypto/rand which
+// cannot be seeded.
+func weightedChoice(r *rand.Rand, choices []randutil.Choice) (randutil.Choice, error) {
+	var sum int
+	for _, c := range choices {
+		sum += c.Weight
+	}
+	if sum <= 0 {
+		return randutil.Choice{}, errors.New("weighted choices must have a positive total weight")
+	}
+	n := r.Intn(sum)
+	for _, c := range choices {
+		if n < c.Weight {
+			return c, nil
+		}
+		n -= c.Weight
+	}
+	// Not reachable, n is less than the total weight.
+	return randutil.Choice{}, errors.New("internal error in weightedChoice")
+}
+
+// randomUUID returns a version 4 uuid drawn from r.
+func randomUUID(r *rand.Rand) uuid.UUID {
+	var u uuid.UUID
+	r.Read(u[:])
+	u[6] = (u[6] & 0x0f) | 0x40 // Version 4
+	u[8] = (u[8] & 0x3f) | 0x80 // Variant is 10
+	return u
+}
+
+func randFloatsInRange(r *rand.Rand, min, max float64, n int32) []float64 {
 	res := make([]float64, n)
 	for i := range res {
 		// Round floats down to 2 decimal places.
-		res[i] = math.Floor((min+rand.Float64()*(max-min))*100) / 100
+		res[i] = math.Floor((min+r.Float64()*(max-min))*100) / 100
 	}
 	return res
 }
 
-func rampToAlarm(simData *telemetry.SimulatedTelemetryData, minVal float64, maxVal float64, rd rampDirection, alarmLevel float64) error {
+func rampToAlarm(r *rand.Rand, simData *telemetry.SimulatedTelemetryData, minVal float64, maxVal float64, rd rampDirection, alarmLevel float64) error {
 	var segmentSize = len(simData.Data) / 4
 	var alarmReached = false
 	var rampFactor float64
-	var alarmIndexSet = false
 	if rd == down {
 		rampFactor = (((minVal + maxVal) / 2) - alarmLevel) / float64(10)
 	} else {
@@ -422,7 +522,7 @@ func rampToAlarm(simData *telemetry.SimulatedTelemetryData, minVal float64, maxV
 		{Weight: 1, Item: 1},
 		{Weight: 1, Item: 2},
 	}
-	segmentStartChoice, err := randutil.WeightedChoice(segmentStartChoices)
+	segmentStartChoice, err := weightedChoice(r, segmentStartChoices)
 	if err != nil {
 		return err
 	}
@@ -439,26 +539,22 @@ func rampToAlarm(simData *telemetry.SimulatedTelemetryData, minVal float64, maxV
 			}
 		}
 		if alarmReached {
-			// Pad the remaining datum with value = 0.0 since the simulation is effectively over
-			// due to an alarm.
-			(simData.Data)[i].Value = 0.0
-			if !alarmIndexSet {
-				if rd == down {
-					(simData.Data)[i-1].LowAlarm = true
-				} else {
-					(simData.Data)[i-1].HighAlarm = true
-				}
-				simData.AlarmExists = true
-				simData.AlarmIndex = i - 1
-				alarmIndexSet = true
-				logger.Debug(fmt.Sprintf("alarm level %v reached...", alarmLevel))
-			}
-		} else {
+			// The simulation is over for the car, the datum following the alarm datum are never
+			// transmitted.
 			if rd == down {
-				(simData.Data)[i].Value = math.Floor(((simData.Data)[i-1].Value-rampFactor)*100) / 100
+				(simData.Data)[i-1].LowAlarm = true
 			} else {
-				(simData.Data)[i].Value = math.Floor(((simData.Data)[i-1].Value+rampFactor)*100) / 100
+				(simData.Data)[i-1].HighAlarm = true
 			}
+			simData.AlarmExists = true
+			simData.AlarmIndex = i - 1
+			logger.Debug(fmt.Sprintf("alarm level %v reached...", alarmLevel))
+			break
+		}
+		if rd == down {
+			(simData.Data)[i].Value = math.Floor(((simData.Data)[i-1].Value-rampFactor)*100) / 100
+		} else {
+			(simData.Data)[i].Value = math.Floor(((simData.Data)[i-1].Value+rampFactor)*100) / 100
 		}
 	}
 	if !alarmReached {
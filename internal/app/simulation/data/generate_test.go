@@ -7,10 +7,16 @@ import (
 
 	"sync"
 	"testing"
+	"time"
 
 	//"github.com/bburch01/FOTAAS/internal/app/simulation/data"
 	"github.com/bburch01/FOTAAS/internal/app/simulation/models"
+	"github.com/bburch01/FOTAAS/internal/app/simulation/track"
 	"github.com/bburch01/FOTAAS/internal/app/telemetry"
+	"github.com/bburch01/FOTAAS/internal/app/telemetry/alarm"
+
+	ipbts "github.com/bburch01/FOTAAS/internal/pkg/protobuf/timestamp"
+	"github.com/golang/protobuf/proto"
 
 	"github.com/bburch01/FOTAAS/api"
 	"github.com/google/uuid"
@@ -49,12 +55,19 @@ func TestGenerateSimulatedTelemetryDataNoAlarm(t *testing.T) {
 		SimulationRateMultiplier: api.SimulationRateMultiplier_X1, GranPrix: api.GranPrix_UNITED_STATES,
 		Track: api.Track_AUSTIN, SimulationMembers: simMemberMap}
 
+	geometry, ok := track.Lookup(sim.Track)
+	if !ok {
+		t.Fatal("no track geometry for track ", sim.Track)
+	}
+
+	thresholds := alarm.NewTable(alarm.DefaultAlarmThresholds())
+
 	var wg sync.WaitGroup
 	errChan := make(chan error, len(sim.SimulationMembers))
 	resultsChan := make(chan SimMemberData, len(sim.SimulationMembers))
 	wg.Add(len(sim.SimulationMembers))
 	for _, v := range sim.SimulationMembers {
-		go GenerateSimulatedTelemetryData(sim, v, &wg, resultsChan, errChan)
+		go GenerateSimulatedTelemetryData(sim, v, thresholds, &wg, resultsChan, errChan)
 	}
 	wg.Wait()
 	close(resultsChan)
@@ -152,6 +165,18 @@ func TestGenerateSimulatedTelemetryDataNoAlarm(t *testing.T) {
 						" got value: ", v3.SimulationTransmitSequenceNumber)
 					t.FailNow()
 				}
+
+				if v3.Lap < 1 || v3.Sector < 1 || v3.Sector > 3 || v3.LapDistance < 0 ||
+					v3.LapDistance >= geometry.Length {
+					t.Error("invalid datum lap position, got lap: ", v3.Lap, " sector: ", v3.Sector,
+						" lap distance: ", v3.LapDistance)
+					t.FailNow()
+				}
+
+				if v3.Latitude == 0 || v3.Longitude == 0 {
+					t.Error("invalid datum position, got latitude: ", v3.Latitude, " longitude: ", v3.Longitude)
+					t.FailNow()
+				}
 			}
 		}
 	}
@@ -185,12 +210,14 @@ func TestGenerateSimulatedTelemetryDataForceAlarm(t *testing.T) {
 		SimulationRateMultiplier: api.SimulationRateMultiplier_X1, GranPrix: api.GranPrix_UNITED_STATES,
 		Track: api.Track_AUSTIN, SimulationMembers: simMemberMap}
 
+	thresholds := alarm.NewTable(alarm.DefaultAlarmThresholds())
+
 	var wg sync.WaitGroup
 	errChan := make(chan error, len(sim.SimulationMembers))
 	resultsChan := make(chan SimMemberData, len(sim.SimulationMembers))
 	wg.Add(len(sim.SimulationMembers))
 	for _, v := range sim.SimulationMembers {
-		go GenerateSimulatedTelemetryData(sim, v, &wg, resultsChan, errChan)
+		go GenerateSimulatedTelemetryData(sim, v, thresholds, &wg, resultsChan, errChan)
 	}
 	wg.Wait()
 	close(resultsChan)
@@ -228,11 +255,19 @@ func TestGenerateSimulatedTelemetryDataForceAlarm(t *testing.T) {
 			t.FailNow()
 		}
 
+		// The telemetry data of every channel of a car ends at the car's alarm datum.
+		datumCount := int(expectedDatumCount)
+		for _, v2 := range v {
+			if v2.AlarmExists {
+				datumCount = v2.AlarmIndex + 1
+			}
+		}
+
 		alarmCount := 0
 		for _, v2 := range v {
 
-			if int32(len(v2.Data)) != expectedDatumCount {
-				t.Error("invalid datum count, expected: ", expectedDatumCount, "got: ", len(v2.Data))
+			if len(v2.Data) != datumCount {
+				t.Error("invalid datum count, expected: ", datumCount, "got: ", len(v2.Data))
 				t.FailNow()
 			}
 
@@ -283,30 +318,26 @@ func TestGenerateSimulatedTelemetryDataForceAlarm(t *testing.T) {
 					t.FailNow()
 				}
 
-				// Confirm that all datum values preceeding the alarm value are within the valid range and that all
-				// datum values following the alarm datum have been set to 0.0 (as per design). Else f there is no alarm
-				// for the datum description, confirm that all datum are in valid range.
+				// Confirm that all datum values preceeding the alarm value are within the valid range. Else if there
+				// is no alarm for the datum description, confirm that all datum are in valid range.
 				dp := telemetryDatumParametersMap[v3.Description]
+				threshold, _ := thresholds.Resolve(v3.Description, v3.Constructor, v3.CarNumber, v3.Track)
 				if v2.AlarmExists {
 					if i < v2.AlarmIndex {
 						switch v2.AlarmMode {
 						case telemetry.Low:
-							if !((dp.LowAlarmValue <= v3.Value) && (v3.Value <= dp.RangeHighValue)) {
+							if !((threshold.Low <= v3.Value) && (v3.Value <= dp.RangeHighValue)) {
 								t.Error("datum index: ", i, " invalid pre-alarm datum value ", v3.Value,
-									" expected to be between ", dp.LowAlarmValue, " and ", dp.RangeHighValue)
+									" expected to be between ", threshold.Low, " and ", dp.RangeHighValue)
 							}
 						case telemetry.High:
-							if !((dp.RangeLowValue <= v3.Value) && (v3.Value <= dp.HighAlarmValue)) {
+							if !((dp.RangeLowValue <= v3.Value) && (v3.Value <= threshold.High)) {
 								t.Error("datum index: ", i, " invalid pre-alarm datum value ", v3.Value,
-									" expected to be between ", dp.RangeLowValue, " and ", dp.HighAlarmValue)
+									" expected to be between ", dp.RangeLowValue, " and ", threshold.High)
 							}
 						default:
 							t.Error("simulatedTelemetryData alarm mode invalid")
 						}
-					} else if i > v2.AlarmIndex {
-						if v3.Value != 0.0 {
-							t.Error("invalid post-alarm datum value, expected 0.0 got ", v3.Value)
-						}
 					}
 				} else {
 
@@ -335,6 +366,169 @@ func TestGenerateSimulatedTelemetryDataForceAlarm(t *testing.T) {
 	}
 }
 
+// TestGenerateSimulatedTelemetryDataAlarmEvaluation confirms that the telemetry service's alarm evaluation
+// reproduces the alarm flags of the generated telemetry data, for every alarm type and for registry
+// thresholds other than the defaults.
+func TestGenerateSimulatedTelemetryDataAlarmEvaluation(t *testing.T) {
+
+	// Car 44 has low alarm levels above the default levels.
+	overrides := map[api.TelemetryDatumDescription]float64{
+		api.TelemetryDatumDescription_TIRE_PRESSURE_FL:     1.0,
+		api.TelemetryDatumDescription_ENGINE_OIL_PRESSURE:  150.0,
+		api.TelemetryDatumDescription_MGUK_OUTPUT:          10.0,
+		api.TelemetryDatumDescription_MGUH_OUTPUT:          10.0,
+		api.TelemetryDatumDescription_ENERGY_STORAGE_LEVEL: 0.5,
+	}
+	registry := alarm.DefaultAlarmThresholds()
+	for desc, low := range overrides {
+		registry = append(registry, &api.AlarmThreshold{DatumDescription: desc,
+			OverrideBy: &api.AlarmThreshold_OverrideBy{CarNumber: true}, CarNumber: 44,
+			HighAlarmEnabled: true, HighAlarmValue: alarm.DefaultThresholds[desc].High,
+			LowAlarmEnabled: true, LowAlarmValue: low})
+	}
+	thresholds := alarm.NewTable(registry)
+	evaluator := alarm.NewEvaluator(alarm.Verify, registry)
+
+	simID := uuid.New().String()
+	sim := models.Simulation{ID: simID, DurationInMinutes: int32(1), SampleRate: api.SampleRate_SR_1000_MS,
+		SimulationRateMultiplier: api.SimulationRateMultiplier_X1, GranPrix: api.GranPrix_UNITED_STATES,
+		Track: api.Track_AUSTIN, Seed: 42}
+	simMembers := []models.SimulationMember{
+		{ID: uuid.New().String(), SimulationID: simID, Constructor: api.Constructor_HAAS, CarNumber: 8, ForceAlarm: true},
+		{ID: uuid.New().String(), SimulationID: simID, Constructor: api.Constructor_MERCEDES, CarNumber: 44, ForceAlarm: true},
+	}
+
+	for _, simMember := range simMembers {
+		for _, c := range alarmTypeChoices {
+
+			ap := c.Item.(telemetry.AlarmParams)
+			threshold, _ := thresholds.Resolve(ap.Desc, simMember.Constructor, simMember.CarNumber, sim.Track)
+			if ap.Mode == telemetry.High {
+				ap.Level = threshold.High
+			} else {
+				ap.Level = threshold.Low
+			}
+
+			simData, err := generateSimMemberData(sim, simMember, ap, true, 1000, 60, time.Now())
+			if err != nil {
+				t.Fatal("failed with error from generateSimMemberData: ", err)
+			}
+			if !simData[ap.Desc].AlarmExists {
+				t.Error("no ", ap.Mode, " alarm generated for ", ap.Desc, " car ", simMember.CarNumber)
+			}
+
+			for _, std := range simData {
+				for i := range std.Data {
+					high, low := evaluator.Evaluate(&std.Data[i])
+					if high != std.Data[i].HighAlarm || low != std.Data[i].LowAlarm {
+						t.Error(ap.Mode, " alarm of ", ap.Desc, " car ", simMember.CarNumber, ": ", std.DatumDesc,
+							" datum ", i, " value ", std.Data[i].Value, " generated with alarm flags (high: ",
+							std.Data[i].HighAlarm, " low: ", std.Data[i].LowAlarm, ") evaluated as (high: ", high,
+							" low: ", low, ")")
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestGenerateSimulatedTelemetryDataSeeded(t *testing.T) {
+
+	newSim := func(seed int64) models.Simulation {
+		simID := uuid.New().String()
+		simMemberMap := make(map[string]models.SimulationMember)
+		simMemberID := uuid.New().String()
+		simMemberMap[simMemberID] = models.SimulationMember{ID: simMemberID, SimulationID: simID,
+			Constructor: api.Constructor_HAAS, CarNumber: 8, ForceAlarm: true, NoAlarms: false}
+		simMemberID = uuid.New().String()
+		simMemberMap[simMemberID] = models.SimulationMember{ID: simMemberID, SimulationID: simID,
+			Constructor: api.Constructor_MERCEDES, CarNumber: 44, ForceAlarm: false, NoAlarms: false}
+		return models.Simulation{ID: simID, DurationInMinutes: int32(1), SampleRate: api.SampleRate_SR_1000_MS,
+			SimulationRateMultiplier: api.SimulationRateMultiplier_X1, GranPrix: api.GranPrix_UNITED_STATES,
+			Track: api.Track_AUSTIN, Seed: seed, SimulationMembers: simMemberMap}
+	}
+
+	// generate returns the generated telemetry data of sim by car number, the member uuids differ
+	// between simulations.
+	generate := func(sim models.Simulation) map[int32]map[api.TelemetryDatumDescription]telemetry.SimulatedTelemetryData {
+		thresholds := alarm.NewTable(alarm.DefaultAlarmThresholds())
+		var wg sync.WaitGroup
+		errChan := make(chan error, len(sim.SimulationMembers))
+		resultsChan := make(chan SimMemberData, len(sim.SimulationMembers))
+		wg.Add(len(sim.SimulationMembers))
+		for _, v := range sim.SimulationMembers {
+			go GenerateSimulatedTelemetryData(sim, v, thresholds, &wg, resultsChan, errChan)
+		}
+		wg.Wait()
+		close(resultsChan)
+		close(errChan)
+		if err := <-errChan; err != nil {
+			t.Fatal("failed with error from GenerateSimulatedTelemetryData: ", err)
+		}
+		byCar := make(map[int32]map[api.TelemetryDatumDescription]telemetry.SimulatedTelemetryData)
+		for v := range resultsChan {
+			byCar[sim.SimulationMembers[v.SimMemberID].CarNumber] = v.SimData
+		}
+		return byCar
+	}
+
+	first := generate(newSim(42))
+	again := generate(newSim(42))
+	other := generate(newSim(43))
+
+	differs := false
+	for car, channels := range first {
+		for desc, std := range channels {
+			againStd := again[car][desc]
+			if std.AlarmExists != againStd.AlarmExists || std.AlarmIndex != againStd.AlarmIndex {
+				t.Fatal("car ", car, " ", desc, " alarm differs between runs with the same seed")
+			}
+			for i := range std.Data {
+				if std.Data[i].Value != againStd.Data[i].Value || std.Data[i].HighAlarm != againStd.Data[i].HighAlarm ||
+					std.Data[i].LowAlarm != againStd.Data[i].LowAlarm {
+					t.Fatal("car ", car, " ", desc, " datum ", i, " differs between runs with the same seed")
+				}
+				// The datum uuids also depend on the simulation.
+				if std.Data[i].Uuid == againStd.Data[i].Uuid {
+					t.Fatal("car ", car, " ", desc, " datum ", i, " uuid repeated by another simulation")
+				}
+				if i < len(other[car][desc].Data) && std.Data[i].Value != other[car][desc].Data[i].Value {
+					differs = true
+				}
+			}
+		}
+	}
+	if !differs {
+		t.Error("runs with different seeds generated the same telemetry data")
+	}
+
+	// A resumed simulation, same simulation, seed and start timestamp, regenerates the same datum uuids
+	// and timestamps.
+	sim := newSim(42)
+	start, err := ipbts.TimestampProto(time.Now().Add(-time.Hour).Truncate(time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sim.StartTimestamp = start
+	resumed := generate(sim)
+	for car, channels := range generate(sim) {
+		for desc, std := range channels {
+			if !proto.Equal(std.Data[0].Timestamp, start) {
+				t.Fatal("car ", car, " ", desc, " first datum timestamp ", std.Data[0].Timestamp,
+					" expected the simulation start timestamp ", start)
+			}
+			for i := range std.Data {
+				if std.Data[i].Uuid != resumed[car][desc].Data[i].Uuid {
+					t.Fatal("car ", car, " ", desc, " datum ", i, " uuid differs for the same simulation and seed")
+				}
+				if !proto.Equal(std.Data[i].Timestamp, resumed[car][desc].Data[i].Timestamp) {
+					t.Fatal("car ", car, " ", desc, " datum ", i, " timestamp differs for the same simulation start")
+				}
+			}
+		}
+	}
+}
+
 /*
 func BenchmarkGenerateSimulatedTelemetryDataNoAlarm(b *testing.B) {
 
@@ -0,0 +1,28 @@
+package data
+
+import (
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/bburch01/FOTAAS/internal/app/simulation/track"
+	"github.com/bburch01/FOTAAS/internal/app/telemetry"
+)
+
+// positionSimMemberData sets the lap, sector, lap distance, latitude, longitude and elevation of
+// the telemetry data of a car. The car starts on the start line of geometry and has covered
+// distance[i] meters at the datum at index i. Every channel of the car is sampled at the same
+// times, so the datum at index i of every channel share a position.
+func positionSimMemberData(geometry *track.Geometry, distance []float64,
+	simData map[api.TelemetryDatumDescription]telemetry.SimulatedTelemetryData) {
+
+	for i, d := range distance {
+		lap, sector, lapDistance := geometry.LapPosition(d)
+		p := geometry.Position(lapDistance)
+		for _, v := range simData {
+			if i >= len(v.Data) {
+				continue
+			}
+			datum := &v.Data[i]
+			datum.Lap, datum.Sector, datum.LapDistance = lap, sector, lapDistance
+			datum.Latitude, datum.Longitude, datum.Elevation = p.Latitude, p.Longitude, p.Elevation
+		}
+	}
+}
@@ -0,0 +1,337 @@
+package data
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/bburch01/FOTAAS/internal/app/simulation/track"
+)
+
+// The vehicle model drives a car around the track and derives its telemetry from the motion: a
+// speed trace is computed for the whole simulation from the corners of the track and the grip,
+// power and drag of the car, and the other channels follow from the speed trace.
+const (
+	gravity = 9.81
+	// vehicleMass is the mass in kg of the car with its driver.
+	vehicleMass = 800.0
+	// enginePower is the power in W at the wheels.
+	enginePower = 750000.0
+	// dragFactor is the drag force in N at 1 m/s, drag grows with the square of the speed.
+	dragFactor = 0.84
+	// tractionLimit is the greatest acceleration in m/s² that the tires can put down.
+	tractionLimit = 1.3 * gravity
+	// The grip of the tires in g is the mechanical grip plus the aerodynamic grip, which grows with
+	// the square of the speed relative to gripReferenceSpeed in m/s.
+	mechanicalGrip     = 2.0
+	aeroGrip           = 2.5
+	gripReferenceSpeed = 83.3
+	// cornerArc is the length in meters of the arc that a corner of the track turns through.
+	cornerArc = 60.0
+	// traceStep is the distance in meters between the points of the speed trace.
+	traceStep = 5.0
+	// vehicleStepInMillis is the longest time step the vehicle model is integrated with.
+	vehicleStepInMillis = 10
+
+	wheelRadius = 0.33
+	upshiftRPM  = 12000.0
+
+	// The fuel flow in kg/h is idleFuelFlow with the throttle closed and maxFuelFlow with it fully
+	// open. fuelLoad is the fuel in kg the car starts with.
+	idleFuelFlow = 10.0
+	maxFuelFlow  = 80.0
+	fuelLoad     = 110.0
+
+	// ambientTemp and trackTemp are the air and track temperatures in degrees celcius.
+	ambientTemp = 30.0
+	trackTemp   = 35.0
+
+	// brakeBalance is the share of the braking done by the front brakes.
+	brakeBalance = 0.58
+	// A brake disc heats up from the braking energy and is cooled by the air flowing over it. The
+	// heat capacity is in J/K and the cooling in W/K, at rest and per m/s.
+	frontBrakeHeatCapacity = 1500.0
+	rearBrakeHeatCapacity  = 1000.0
+	brakeCooling           = 10.0
+	brakeSpeedCooling      = 1.2
+	brakeInitialTemp       = 550.0
+
+	// A tire heats up from the work of its slip and is cooled towards the track temperature. tireSlip
+	// is the share of the work of the tire forces turned into heat. A tire sheds heat faster the
+	// hotter it runs, the cooling in W/K is for a tire tireReferenceExcess K above the track.
+	tireSlip            = 0.05
+	tireHeatCapacity    = 8000.0
+	tireCooling         = 20.0
+	tireSpeedCooling    = 1.2
+	tireReferenceExcess = 60.0
+	tireInitialTemp     = 95.0
+	// The share of the cornering work done by each outer tire, of the braking work done by each front
+	// tire and of the accelerating work done by each rear tire, the other tires do the rest.
+	outerTireLoad    = 0.7
+	frontTireBraking = 0.6
+	rearTireTraction = 0.65
+
+	// The grip and power of each car, and the grip of each lap, are spread around the nominal values
+	// by up to these fractions.
+	memberGripSpread  = 0.02
+	memberPowerSpread = 0.01
+	lapGripSpread     = 0.01
+)
+
+// gearRatios are the overall ratios, gearbox and final drive, of the eight gears.
+var gearRatios = []float64{13.0, 10.3, 8.6, 7.3, 6.3, 5.5, 4.8, 4.26}
+
+// vehicleChannels are the channels derived from the vehicle model, the other channels are drawn
+// independently.
+var vehicleChannels = []api.TelemetryDatumDescription{
+	api.TelemetryDatumDescription_SPEED,
+	api.TelemetryDatumDescription_ENGINE_RPM,
+	api.TelemetryDatumDescription_G_FORCE,
+	api.TelemetryDatumDescription_G_FORCE_DIRECTION,
+	api.TelemetryDatumDescription_FUEL_FLOW,
+	api.TelemetryDatumDescription_FUEL_CONSUMED,
+	api.TelemetryDatumDescription_BRAKE_TEMP_FL,
+	api.TelemetryDatumDescription_BRAKE_TEMP_FR,
+	api.TelemetryDatumDescription_BRAKE_TEMP_RL,
+	api.TelemetryDatumDescription_BRAKE_TEMP_RR,
+	api.TelemetryDatumDescription_TIRE_TEMP_FL,
+	api.TelemetryDatumDescription_TIRE_TEMP_FR,
+	api.TelemetryDatumDescription_TIRE_TEMP_RL,
+	api.TelemetryDatumDescription_TIRE_TEMP_RR,
+}
+
+// vehicleData is the telemetry of a car derived from the vehicle model, datumCount values per
+// channel. distance is the distance in meters the car has covered at each datum.
+type vehicleData struct {
+	values   map[api.TelemetryDatumDescription][]float64
+	distance []float64
+}
+
+// speedTrace is the speed of a car at every traceStep meters along its run, with the signed
+// curvature of the track at each point.
+type speedTrace struct {
+	speed     []float64
+	curvature []float64
+}
+
+// simulateVehicle runs the vehicle model of a car around geometry for datumCount samples taken
+// sampleRateInMillis apart. The grip and power of the car, and the grip of each lap, are drawn
+// from r.
+func simulateVehicle(r *rand.Rand, geometry *track.Geometry, sampleRateInMillis int32, datumCount int32) vehicleData {
+
+	grip := 1 + memberGripSpread*(2*r.Float64()-1)
+	power := enginePower * (1 + memberPowerSpread*(2*r.Float64()-1))
+	topSpeed := math.Cbrt(power / dragFactor)
+
+	interval := float64(sampleRateInMillis) / 1000
+	trace := newSpeedTrace(r, geometry, grip, power, topSpeed, float64(datumCount)*interval)
+
+	step := interval
+	steps := 1
+	if sampleRateInMillis > vehicleStepInMillis {
+		steps = int(sampleRateInMillis / vehicleStepInMillis)
+		step = interval / float64(steps)
+	}
+
+	vd := vehicleData{values: make(map[api.TelemetryDatumDescription][]float64), distance: make([]float64, datumCount)}
+	for _, v := range vehicleChannels {
+		vd.values[v] = make([]float64, datumCount)
+	}
+
+	var s, fuelConsumed float64
+	brakeTemps := [4]float64{brakeInitialTemp, brakeInitialTemp, brakeInitialTemp, brakeInitialTemp}
+	tireTemps := [4]float64{tireInitialTemp, tireInitialTemp, tireInitialTemp, tireInitialTemp}
+
+	for i := int32(0); i < datumCount; i++ {
+
+		speed, longitudinal, lateral := trace.at(s)
+		throttle, _ := throttleAndBraking(speed, longitudinal, power)
+
+		vd.distance[i] = s
+		vd.values[api.TelemetryDatumDescription_SPEED][i] = speed * 3.6
+		vd.values[api.TelemetryDatumDescription_ENGINE_RPM][i] = engineRPM(speed)
+		vd.values[api.TelemetryDatumDescription_G_FORCE][i] = math.Hypot(longitudinal, lateral) / gravity
+		direction := math.Atan2(lateral, longitudinal)
+		if direction < 0 {
+			direction += 2 * math.Pi
+		}
+		vd.values[api.TelemetryDatumDescription_G_FORCE_DIRECTION][i] = direction
+		vd.values[api.TelemetryDatumDescription_FUEL_FLOW][i] = idleFuelFlow + (maxFuelFlow-idleFuelFlow)*throttle
+		vd.values[api.TelemetryDatumDescription_FUEL_CONSUMED][i] = fuelConsumed
+		for j, v := range []api.TelemetryDatumDescription{api.TelemetryDatumDescription_BRAKE_TEMP_FL,
+			api.TelemetryDatumDescription_BRAKE_TEMP_FR, api.TelemetryDatumDescription_BRAKE_TEMP_RL,
+			api.TelemetryDatumDescription_BRAKE_TEMP_RR} {
+			vd.values[v][i] = brakeTemps[j]
+		}
+		for j, v := range []api.TelemetryDatumDescription{api.TelemetryDatumDescription_TIRE_TEMP_FL,
+			api.TelemetryDatumDescription_TIRE_TEMP_FR, api.TelemetryDatumDescription_TIRE_TEMP_RL,
+			api.TelemetryDatumDescription_TIRE_TEMP_RR} {
+			vd.values[v][i] = tireTemps[j]
+		}
+
+		// Advance to the next sample, integrating the fuel and temperatures on the way.
+		for k := 0; k < steps; k++ {
+			speed, longitudinal, lateral := trace.at(s)
+			throttle, braking := throttleAndBraking(speed, longitudinal, power)
+
+			fuelConsumed = math.Min(fuelConsumed+(idleFuelFlow+(maxFuelFlow-idleFuelFlow)*throttle)*step/3600, fuelLoad)
+
+			// Braking power in W shared between the front and rear discs, left and right alike.
+			brakePower := braking * speed
+			cooling := brakeCooling + brakeSpeedCooling*speed
+			for j := range brakeTemps {
+				share, capacity := brakeBalance/2, frontBrakeHeatCapacity
+				if j >= 2 {
+					share, capacity = (1-brakeBalance)/2, rearBrakeHeatCapacity
+				}
+				brakeTemps[j] += (brakePower*share - cooling*(brakeTemps[j]-ambientTemp)) / capacity * step
+			}
+
+			// The outer tires do most of the cornering, the fronts most of the braking and the
+			// rears most of the accelerating. The tires are in FL, FR, RL, RR order.
+			lateralWork := tireSlip * vehicleMass * math.Abs(lateral) * speed
+			longitudinalWork := tireSlip * vehicleMass * math.Abs(longitudinal) * speed
+			cooling = tireCooling + tireSpeedCooling*speed
+			for j := range tireTemps {
+				left := j%2 == 0
+				front := j < 2
+				side := 1 - outerTireLoad
+				// A positive lateral acceleration is a left hand corner, the right tires are outside.
+				if left == (lateral < 0) {
+					side = outerTireLoad
+				}
+				axle := 1 - frontTireBraking
+				switch {
+				case longitudinal < 0 && front:
+					axle = frontTireBraking
+				case longitudinal >= 0 && front:
+					axle = 1 - rearTireTraction
+				case longitudinal >= 0:
+					axle = rearTireTraction
+				}
+				work := lateralWork*side/2 + longitudinalWork*axle/2
+				excess := tireTemps[j] - trackTemp
+				tireTemps[j] += (work - cooling*excess*math.Abs(excess)/tireReferenceExcess) / tireHeatCapacity * step
+			}
+
+			s += speed * step
+		}
+	}
+
+	for _, v := range vd.values {
+		for i := range v {
+			// Round floats down to 2 decimal places.
+			v[i] = math.Floor(v[i]*100) / 100
+		}
+	}
+
+	return vd
+}
+
+// newSpeedTrace returns the speed trace of a car with grip and power over the distance it can
+// cover in duration seconds. Each corner limits the speed to what the grip can hold around it,
+// the car accelerates flat out between the corners and brakes as late as it can for them. The
+// grip available to accelerate or brake is what is left of it after cornering.
+func newSpeedTrace(r *rand.Rand, geometry *track.Geometry, grip float64, power float64, topSpeed float64,
+	duration float64) *speedTrace {
+
+	// The curvature of a lap, each corner turns through its angle over cornerArc meters.
+	lapPoints := int(math.Ceil(geometry.Length / traceStep))
+	lapCurvature := make([]float64, lapPoints)
+	for _, c := range geometry.Corners() {
+		from := int(math.Floor((c.LapDistance - cornerArc/2) / traceStep))
+		for j := from; j < from+int(cornerArc/traceStep); j++ {
+			lapCurvature[((j%lapPoints)+lapPoints)%lapPoints] += c.Angle / cornerArc
+		}
+	}
+
+	n := int(math.Ceil(duration*topSpeed/traceStep)) + 2
+	trace := &speedTrace{speed: make([]float64, n), curvature: make([]float64, n)}
+
+	// The grip varies a little from lap to lap.
+	var lapGrip []float64
+	limit := make([]float64, n)
+	for i := range limit {
+		d := float64(i) * traceStep
+		lap := int(d / geometry.Length)
+		for len(lapGrip) <= lap {
+			lapGrip = append(lapGrip, grip*(1+lapGripSpread*math.Max(-2, math.Min(2, r.NormFloat64()))))
+		}
+		trace.curvature[i] = lapCurvature[int((d-float64(lap)*geometry.Length)/traceStep)%lapPoints]
+		limit[i] = math.Min(cornerSpeed(trace.curvature[i], lapGrip[lap]), topSpeed)
+	}
+
+	// Accelerate out of every corner and then brake into every corner.
+	trace.speed[0] = limit[0]
+	for i := 1; i < n; i++ {
+		v := trace.speed[i-1]
+		a := math.Min(tractionLimit, power/(vehicleMass*math.Max(v, 1))) - dragFactor*v*v/vehicleMass
+		a *= gripLeft(v, trace.curvature[i-1], lapGrip[int(float64(i-1)*traceStep/geometry.Length)])
+		trace.speed[i] = math.Min(limit[i], math.Sqrt(math.Max(v*v+2*a*traceStep, 0)))
+	}
+	for i := n - 2; i >= 0; i-- {
+		v := trace.speed[i+1]
+		g := lapGrip[int(float64(i+1)*traceStep/geometry.Length)]
+		a := gravity * g * (mechanicalGrip + aeroGrip*(v/gripReferenceSpeed)*(v/gripReferenceSpeed))
+		a *= gripLeft(v, trace.curvature[i+1], g)
+		trace.speed[i] = math.Min(trace.speed[i], math.Sqrt(v*v+2*a*traceStep))
+	}
+
+	return trace
+}
+
+// at returns the speed in m/s and the longitudinal and lateral accelerations in m/s² of the car
+// s meters along its run. A positive lateral acceleration is to the left.
+func (t *speedTrace) at(s float64) (float64, float64, float64) {
+	i := int(s / traceStep)
+	if i > len(t.speed)-2 {
+		i = len(t.speed) - 2
+	}
+	f := s/traceStep - float64(i)
+	v0, v1 := t.speed[i], t.speed[i+1]
+	speed := v0 + (v1-v0)*f
+	return speed, (v1*v1 - v0*v0) / (2 * traceStep), speed * speed * t.curvature[i]
+}
+
+// cornerSpeed returns the greatest speed in m/s that grip can hold around a corner of curvature,
+// the aerodynamic grip at that speed included.
+func cornerSpeed(curvature float64, grip float64) float64 {
+	k := math.Abs(curvature) - gravity*grip*aeroGrip/(gripReferenceSpeed*gripReferenceSpeed)
+	if k <= 0 {
+		return math.Inf(1)
+	}
+	return math.Sqrt(gravity * grip * mechanicalGrip / k)
+}
+
+// gripLeft returns the share of the grip left to accelerate or brake with at speed around a
+// corner of curvature.
+func gripLeft(speed float64, curvature float64, grip float64) float64 {
+	lateral := speed * speed * math.Abs(curvature)
+	available := gravity * grip * (mechanicalGrip + aeroGrip*(speed/gripReferenceSpeed)*(speed/gripReferenceSpeed))
+	if lateral >= available {
+		return 0
+	}
+	return math.Sqrt(1 - (lateral/available)*(lateral/available))
+}
+
+// throttleAndBraking returns the throttle opening, from 0 to 1, and the braking force in N of the
+// car at speed accelerating at longitudinal m/s².
+func throttleAndBraking(speed float64, longitudinal float64, power float64) (float64, float64) {
+	force := vehicleMass*longitudinal + dragFactor*speed*speed
+	if force <= 0 {
+		return 0, -force
+	}
+	return math.Min(force*speed/power, 1), 0
+}
+
+// engineRPM returns the engine speed of the car at speed m/s, in the lowest gear that keeps the
+// engine below upshiftRPM.
+func engineRPM(speed float64) float64 {
+	wheelRPM := speed / (2 * math.Pi * wheelRadius) * 60
+	for _, ratio := range gearRatios {
+		if rpm := wheelRPM * ratio; rpm <= upshiftRPM {
+			return rpm
+		}
+	}
+	return wheelRPM * gearRatios[len(gearRatios)-1]
+}
@@ -0,0 +1,94 @@
+package data
+
+import (
+	"math"
+	"testing"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/bburch01/FOTAAS/internal/app/simulation/track"
+)
+
+func TestSimulateVehicle(t *testing.T) {
+
+	for k := range api.Track_name {
+
+		geometry, _ := track.Lookup(api.Track(k))
+
+		// 30 minutes at 1 sample per second.
+		vd := simulateVehicle(newRand(1, k), geometry, 1000, 1800)
+
+		for desc, values := range vd.values {
+			dp := telemetryDatumParametersMap[desc]
+			for i, v := range values {
+				if v < dp.RangeLowValue || v > dp.RangeHighValue {
+					t.Fatalf("track %v %v datum %v value %v out of range %v to %v", api.Track(k), desc, i, v,
+						dp.RangeLowValue, dp.RangeHighValue)
+				}
+			}
+		}
+
+		speed := vd.values[api.TelemetryDatumDescription_SPEED]
+		rpm := vd.values[api.TelemetryDatumDescription_ENGINE_RPM]
+		fuelConsumed := vd.values[api.TelemetryDatumDescription_FUEL_CONSUMED]
+		for i := range speed {
+
+			// The engine turns at the speed of the wheels times the ratio of one of the gears.
+			wheelRPM := speed[i] / 3.6 / (2 * math.Pi * wheelRadius) * 60
+			inGear := false
+			for _, ratio := range gearRatios {
+				if math.Abs(rpm[i]-wheelRPM*ratio) < 5 {
+					inGear = true
+				}
+			}
+			if !inGear {
+				t.Fatalf("track %v datum %v engine rpm %v is not in any gear at %v kph", api.Track(k), i, rpm[i], speed[i])
+			}
+
+			if i == 0 {
+				continue
+			}
+			if fuelConsumed[i] < fuelConsumed[i-1] {
+				t.Fatalf("track %v datum %v fuel consumed went down from %v to %v", api.Track(k), i, fuelConsumed[i-1],
+					fuelConsumed[i])
+			}
+			if vd.distance[i] <= vd.distance[i-1] {
+				t.Fatalf("track %v datum %v distance went from %v to %v", api.Track(k), i, vd.distance[i-1], vd.distance[i])
+			}
+		}
+	}
+}
+
+func TestSimulateVehicleBraking(t *testing.T) {
+
+	geometry, _ := track.Lookup(api.Track_MONZA)
+
+	// 5 minutes at 1 sample every 10 ms.
+	vd := simulateVehicle(newRand(1), geometry, 10, 30000)
+
+	speed := vd.values[api.TelemetryDatumDescription_SPEED]
+	flow := vd.values[api.TelemetryDatumDescription_FUEL_FLOW]
+	gForce := vd.values[api.TelemetryDatumDescription_G_FORCE]
+	brakeTemp := vd.values[api.TelemetryDatumDescription_BRAKE_TEMP_FL]
+
+	var braking int
+	for i := 1; i < len(speed); i++ {
+		// The brakes only heat up, and the throttle is closed, while the car slows down.
+		if brakeTemp[i] > brakeTemp[i-1] {
+			braking++
+			if speed[i] >= speed[i-1] {
+				t.Fatalf("datum %v brake temperature rose from %v to %v at %v kph and then %v kph", i, brakeTemp[i-1],
+					brakeTemp[i], speed[i-1], speed[i])
+			}
+			if flow[i-1] != idleFuelFlow {
+				t.Fatalf("datum %v fuel flow %v while braking", i, flow[i-1])
+			}
+			// Braking from 1 sample to the next takes a deceleration of at least the speed lost.
+			if decel := (speed[i-1] - speed[i]) / 3.6 / 0.01 / gravity; math.Max(gForce[i-1], gForce[i]) < decel*0.9-0.05 {
+				t.Fatalf("datum %v g force %v and %v while slowing down at %v g", i, gForce[i-1], gForce[i], decel)
+			}
+		}
+	}
+	if braking == 0 {
+		t.Error("the car never braked")
+	}
+}
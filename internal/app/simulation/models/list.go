@@ -0,0 +1,486 @@
+package models
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"time"
+
+	ipbts "github.com/bburch01/FOTAAS/internal/pkg/protobuf/timestamp"
+	itime "github.com/bburch01/FOTAAS/internal/pkg/time"
+	"github.com/golang/protobuf/proto"
+	pbts "github.com/golang/protobuf/ptypes/timestamp"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultPageSize is the simulation page size used when a request does not specify one.
+	DefaultPageSize = 100
+	// MaxPageSize caps the simulation page size, every simulation on a page comes with its members.
+	MaxPageSize = 1000
+)
+
+// simulationSelectColumns are the simulation columns read by scanSimulationInfo, in scan order.
+const simulationSelectColumns = `id, duration_in_minutes, sample_rate, gran_prix, track, state, start_timestamp,
+	end_timestamp, percent_complete, final_status_code, final_status_message, seed`
+
+// timestampLayout is the format of the simulation timestamp columns.
+const timestampLayout = "2006-01-02 15:04:05"
+
+// sortColumns is the simulation column of each SimulationSortField.
+var sortColumns = map[api.SimulationSortField]string{
+	api.SimulationSortField_START_TIMESTAMP:     "start_timestamp",
+	api.SimulationSortField_END_TIMESTAMP:       "end_timestamp",
+	api.SimulationSortField_DURATION_IN_MINUTES: "duration_in_minutes",
+	api.SimulationSortField_PERCENT_COMPLETE:    "percent_complete",
+}
+
+// simulationQuery accumulates the where clause conditions and their placeholder arguments for a
+// simulation select. Values are never written into the sql text, they are always passed to the
+// driver as arguments.
+type simulationQuery struct {
+	conditions []string
+	args       []interface{}
+}
+
+// where adds a condition containing zero or more ? placeholders along with the matching arguments.
+func (q *simulationQuery) where(condition string, args ...interface{}) {
+	q.conditions = append(q.conditions, condition)
+	q.args = append(q.args, args...)
+}
+
+// whereIn adds a column in (?, ...) condition, an empty value list adds nothing.
+func (q *simulationQuery) whereIn(column string, values []interface{}) {
+	if len(values) == 0 {
+		return
+	}
+	q.where(column+" in (?"+strings.Repeat(", ?", len(values)-1)+")", values...)
+}
+
+func (q *simulationQuery) whereClause() string {
+	var sb strings.Builder
+	for i, c := range q.conditions {
+		if i == 0 {
+			sb.WriteString(" where ")
+		} else {
+			sb.WriteString(" and ")
+		}
+		sb.WriteString(c)
+	}
+	return sb.String()
+}
+
+// newSimulationQuery builds the conditions of the select of the simulations matching the filters of
+// req that follow token (when not nil).
+func newSimulationQuery(req api.ListSimulationsRequest, token *pageToken) (*simulationQuery, error) {
+
+	q := new(simulationQuery)
+
+	var states, granPrix, tracks, constructors []interface{}
+	for _, v := range req.States {
+		states = append(states, v.String())
+	}
+	for _, v := range req.GranPrix {
+		granPrix = append(granPrix, v.String())
+	}
+	for _, v := range req.Tracks {
+		tracks = append(tracks, v.String())
+	}
+	for _, v := range req.Constructors {
+		constructors = append(constructors, v.String())
+	}
+	q.whereIn("state", states)
+	q.whereIn("gran_prix", granPrix)
+	q.whereIn("track", tracks)
+	if len(constructors) > 0 {
+		q.where("id in (select simulation_id from simulation_member where constructor in (?"+
+			strings.Repeat(", ?", len(constructors)-1)+"))", constructors...)
+	}
+
+	if req.StartTimeFrom != nil {
+		t, err := ipbts.Timestamp(req.StartTimeFrom)
+		if err != nil {
+			return nil, err
+		}
+		q.where("start_timestamp >= ?", t.UTC().Format(timestampLayout))
+	}
+	if req.StartTimeTo != nil {
+		t, err := ipbts.Timestamp(req.StartTimeTo)
+		if err != nil {
+			return nil, err
+		}
+		q.where("start_timestamp < ?", t.UTC().Format(timestampLayout))
+	}
+
+	if token != nil {
+		if err := q.after(req, token); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, ok := sortColumns[req.SortBy]; !ok {
+		return nil, fmt.Errorf("invalid simulation sort field: %v", req.SortBy)
+	}
+
+	return q, nil
+}
+
+// sql returns the select of the simulations matching the query's conditions, ordered by req.SortBy
+// and then id so that the order of a page is stable.
+func (q *simulationQuery) sql(req api.ListSimulationsRequest) string {
+
+	direction := "asc"
+	if req.Descending {
+		direction = "desc"
+	}
+
+	return "select " + simulationSelectColumns + " from simulation" + q.whereClause() +
+		" order by " + sortColumns[req.SortBy] + " " + direction + ", id " + direction
+}
+
+// pageToken is the keyset cursor for paged simulation listing. Pages are ordered by (sort column,
+// id) so the cursor is the sort column value and id of the last simulation of the previous page,
+// along with a hash of the filters and sort of the request that the token was issued for. A null
+// sort column value (a simulation that has not started or ended) sorts first ascending and last
+// descending.
+type pageToken struct {
+	null  bool
+	value string
+	id    string
+}
+
+// requestHash hashes the filters and sort of req, a page token is only valid for the request that
+// it was issued for.
+func requestHash(req api.ListSimulationsRequest) (string, error) {
+
+	req.PageSize = 0
+	req.PageToken = ""
+
+	b, err := proto.Marshal(&req)
+	if err != nil {
+		return "", err
+	}
+
+	h := fnv.New64a()
+	h.Write(b)
+
+	return strconv.FormatUint(h.Sum64(), 16), nil
+}
+
+// sortValue returns the value of the sort column of req for the simulation info, or null when the
+// column is null.
+func sortValue(req api.ListSimulationsRequest, info *api.SimulationInfo) (value string, null bool, err error) {
+
+	var ts *pbts.Timestamp
+
+	switch req.SortBy {
+	case api.SimulationSortField_DURATION_IN_MINUTES:
+		return strconv.Itoa(int(info.DurationInMinutes)), false, nil
+	case api.SimulationSortField_PERCENT_COMPLETE:
+		// The full precision of the float column, a rounded value would not compare equal to it.
+		return strconv.FormatFloat(info.PercentComplete, 'g', -1, 64), false, nil
+	case api.SimulationSortField_START_TIMESTAMP:
+		ts = info.StartTimestamp
+	case api.SimulationSortField_END_TIMESTAMP:
+		ts = info.EndTimestamp
+	default:
+		return "", false, fmt.Errorf("invalid simulation sort field: %v", req.SortBy)
+	}
+
+	// scanSimulationInfo converts a null timestamp column to the zero time.
+	t, err := ipbts.Timestamp(ts)
+	if err != nil {
+		return "", false, err
+	}
+	if t.IsZero() {
+		return "", true, nil
+	}
+
+	return t.UTC().Format(timestampLayout), false, nil
+}
+
+func encodePageToken(req api.ListSimulationsRequest, last *api.SimulationInfo) (string, error) {
+
+	hash, err := requestHash(req)
+	if err != nil {
+		return "", err
+	}
+
+	value, null, err := sortValue(req, last)
+	if err != nil {
+		return "", err
+	}
+
+	token := hash + "|" + strconv.FormatBool(null) + "|" + value + "|" + last.Uuid
+
+	return base64.RawURLEncoding.EncodeToString([]byte(token)), nil
+}
+
+// decodePageToken decodes the page token of req, it is rejected when it was issued for a request
+// with other filters or another sort.
+func decodePageToken(req api.ListSimulationsRequest) (*pageToken, error) {
+
+	b, err := base64.RawURLEncoding.DecodeString(req.PageToken)
+	if err != nil {
+		return nil, errors.New("invalid page token")
+	}
+
+	parts := strings.Split(string(b), "|")
+	if len(parts) != 4 {
+		return nil, errors.New("invalid page token")
+	}
+
+	null, err := strconv.ParseBool(parts[1])
+	if err != nil || (null && parts[2] != "") {
+		return nil, errors.New("invalid page token")
+	}
+
+	if _, err := uuid.Parse(parts[3]); err != nil {
+		return nil, errors.New("invalid page token")
+	}
+
+	hash, err := requestHash(req)
+	if err != nil {
+		return nil, err
+	}
+	if parts[0] != hash {
+		return nil, errors.New("page token does not match the filters and sort of the request")
+	}
+
+	return &pageToken{null: null, value: parts[2], id: parts[3]}, nil
+}
+
+// after adds the condition selecting the simulations that follow token in the order of req.
+func (q *simulationQuery) after(req api.ListSimulationsRequest, token *pageToken) error {
+
+	column, ok := sortColumns[req.SortBy]
+	if !ok {
+		return fmt.Errorf("invalid simulation sort field: %v", req.SortBy)
+	}
+
+	if token.null {
+		if req.Descending {
+			q.where("("+column+" is null and id < ?)", token.id)
+		} else {
+			q.where("(("+column+" is null and id > ?) or "+column+" is not null)", token.id)
+		}
+		return nil
+	}
+
+	var value interface{} = token.value
+	switch req.SortBy {
+	case api.SimulationSortField_DURATION_IN_MINUTES:
+		v, err := strconv.Atoi(token.value)
+		if err != nil {
+			return errors.New("invalid page token")
+		}
+		value = v
+	case api.SimulationSortField_PERCENT_COMPLETE:
+		v, err := strconv.ParseFloat(token.value, 64)
+		if err != nil {
+			return errors.New("invalid page token")
+		}
+		value = v
+	default:
+		if _, err := time.Parse(timestampLayout, token.value); err != nil {
+			return errors.New("invalid page token")
+		}
+	}
+
+	if req.Descending {
+		q.where("("+column+" < ? or ("+column+" = ? and id < ?) or "+column+" is null)", value, value, token.id)
+	} else {
+		q.where("("+column+" > ? or ("+column+" = ? and id > ?))", value, value, token.id)
+	}
+	return nil
+}
+
+func pageSize(req api.ListSimulationsRequest) int {
+	switch {
+	case req.PageSize <= 0:
+		return DefaultPageSize
+	case req.PageSize > MaxPageSize:
+		return MaxPageSize
+	default:
+		return int(req.PageSize)
+	}
+}
+
+// ListSimulations retrieves a page of the simulations matching the filters of req, each with its
+// members, along with the token of the next page (empty when there are no more pages).
+func ListSimulations(req api.ListSimulationsRequest) ([]*api.SimulationInfo, string, error) {
+
+	size := pageSize(req)
+
+	var token *pageToken
+	if req.PageToken != "" {
+		var err error
+		if token, err = decodePageToken(req); err != nil {
+			return nil, "", err
+		}
+	}
+
+	q, err := newSimulationQuery(req, token)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// One more simulation than the page size is selected to find out whether there is a next page.
+	query := q.sql(req) + " limit ?"
+	args := append(q.args, size+1)
+
+	logger.Debug(fmt.Sprintf("select sql: %v", query))
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to list simulations with error: %v", err))
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var simulations []*api.SimulationInfo
+	for rows.Next() {
+		info, err := scanSimulationInfo(rows)
+		if err != nil {
+			return nil, "", err
+		}
+		simulations = append(simulations, info)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	var nextPageToken string
+	if len(simulations) > size {
+		simulations = simulations[:size]
+		if nextPageToken, err = encodePageToken(req, simulations[size-1]); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if err = retrieveMembers(simulations); err != nil {
+		return nil, "", err
+	}
+
+	return simulations, nextPageToken, nil
+}
+
+// scanner is a *sql.Row or *sql.Rows.
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanSimulationInfo scans a row of simulationSelectColumns.
+func scanSimulationInfo(row scanner) (*api.SimulationInfo, error) {
+
+	var sampleRate, granPrix, track, state string
+	var startTs, endTs itime.NullTime
+
+	info := new(api.SimulationInfo)
+
+	if err := row.Scan(&info.Uuid, &info.DurationInMinutes, &sampleRate, &granPrix, &track, &state, &startTs, &endTs,
+		&info.PercentComplete, &info.FinalStatusCode, &info.FinalStatusMessage, &info.Seed); err != nil {
+		return nil, err
+	}
+
+	ordinal, ok := api.SampleRate_value[sampleRate]
+	if !ok {
+		return nil, fmt.Errorf("invalid simulation sample rate enum: %v", sampleRate)
+	}
+	info.SampleRate = api.SampleRate(ordinal)
+
+	ordinal, ok = api.GranPrix_value[granPrix]
+	if !ok {
+		return nil, fmt.Errorf("invalid simulation gran prix enum: %v", granPrix)
+	}
+	info.GranPrix = api.GranPrix(ordinal)
+
+	ordinal, ok = api.Track_value[track]
+	if !ok {
+		return nil, fmt.Errorf("invalid simulation track enum: %v", track)
+	}
+	info.Track = api.Track(ordinal)
+
+	ordinal, ok = api.SimulationState_value[state]
+	if !ok {
+		return nil, fmt.Errorf("invalid simulation state enum: %v", state)
+	}
+	info.State = api.SimulationState(ordinal)
+
+	tsProto, err := ipbts.TimestampProto(startTs.Time)
+	if err != nil {
+		return nil, errors.New("failed to convert start timestamp to protobuf format")
+	}
+	info.StartTimestamp = tsProto
+
+	tsProto, err = ipbts.TimestampProto(endTs.Time)
+	if err != nil {
+		return nil, errors.New("failed to convert end timestamp to protobuf format")
+	}
+	info.EndTimestamp = tsProto
+
+	return info, nil
+}
+
+// retrieveMembers sets the members of each of simulations.
+func retrieveMembers(simulations []*api.SimulationInfo) error {
+
+	if len(simulations) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*api.SimulationInfo, len(simulations))
+	ids := make([]interface{}, 0, len(simulations))
+	for _, v := range simulations {
+		byID[v.Uuid] = v
+		ids = append(ids, v.Uuid)
+	}
+
+	q := new(simulationQuery)
+	q.whereIn("simulation_id", ids)
+	query := `select id, simulation_id, constructor, car_number, force_alarm, no_alarms, alarm_occurred,
+		alarm_datum_description, alarm_datum_unit, alarm_datum_value from simulation_member` + q.whereClause() +
+		" order by car_number, id"
+
+	rows, err := db.Query(query, q.args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+
+		var member api.SimulationMemberInfo
+		var simID, constructor string
+		// The alarm columns are null until the member has an alarm.
+		var alarmOccurred sql.NullBool
+		var alarmDescription, alarmUnit sql.NullString
+		var alarmValue sql.NullFloat64
+
+		if err = rows.Scan(&member.Uuid, &simID, &constructor, &member.CarNumber, &member.ForceAlarm, &member.NoAlarms,
+			&alarmOccurred, &alarmDescription, &alarmUnit, &alarmValue); err != nil {
+			return err
+		}
+
+		ordinal, ok := api.Constructor_value[constructor]
+		if !ok {
+			return fmt.Errorf("invalid constructor enum: %v", constructor)
+		}
+		member.Constructor = api.Constructor(ordinal)
+		member.AlarmOccurred = alarmOccurred.Bool
+		member.AlarmDatumDescription = alarmDescription.String
+		member.AlarmDatumUnit = alarmUnit.String
+		member.AlarmDatumValue = alarmValue.Float64
+
+		if info, ok := byID[simID]; ok {
+			info.Members = append(info.Members, &member)
+		}
+	}
+
+	return rows.Err()
+}
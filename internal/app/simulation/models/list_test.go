@@ -0,0 +1,127 @@
+package models
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	ipbts "github.com/bburch01/FOTAAS/internal/pkg/protobuf/timestamp"
+
+	"github.com/bburch01/FOTAAS/api"
+)
+
+func TestSimulationQuery(t *testing.T) {
+
+	from, _ := ipbts.TimestampProto(time.Date(2019, 7, 14, 0, 0, 0, 0, time.UTC))
+
+	req := api.ListSimulationsRequest{States: []api.SimulationState{api.SimulationState_IN_PROGRESS, api.SimulationState_PAUSED},
+		Tracks: []api.Track{api.Track_SILVERSTONE}, Constructors: []api.Constructor{api.Constructor_WILLIAMS},
+		StartTimeFrom: from, SortBy: api.SimulationSortField_PERCENT_COMPLETE, Descending: true}
+
+	q, err := newSimulationQuery(req, nil)
+	if err != nil {
+		t.Fatal("failed to build simulation query with error: ", err)
+	}
+	query := q.sql(req)
+
+	for _, v := range []string{"state in (?, ?)", "track in (?)",
+		"id in (select simulation_id from simulation_member where constructor in (?))", "start_timestamp >= ?",
+		"order by percent_complete desc, id desc"} {
+		if !strings.Contains(query, v) {
+			t.Errorf("expected %v in query: %v", v, query)
+		}
+	}
+	if strings.Contains(query, "gran_prix in") || strings.Contains(query, "start_timestamp <") {
+		t.Errorf("expected no gran prix or start time upper bound in query: %v", query)
+	}
+
+	args := []interface{}{"IN_PROGRESS", "PAUSED", "SILVERSTONE", "WILLIAMS", "2019-07-14 00:00:00"}
+	if len(q.args) != len(args) {
+		t.Fatalf("expected query args %v, got %v", args, q.args)
+	}
+	for i := range args {
+		if q.args[i] != args[i] {
+			t.Errorf("expected query args %v, got %v", args, q.args)
+		}
+	}
+
+	if _, err = newSimulationQuery(api.ListSimulationsRequest{SortBy: 99}, nil); err == nil {
+		t.Error("expected an invalid sort field to be rejected")
+	}
+}
+
+func TestPageToken(t *testing.T) {
+
+	start, _ := ipbts.TimestampProto(time.Date(2019, 7, 14, 13, 10, 0, 0, time.UTC))
+	notEnded, _ := ipbts.TimestampProto(time.Time{})
+	last := &api.SimulationInfo{Uuid: "2f0c2f3e-5f8e-4f55-9c4e-3b9e8e0c6a11", DurationInMinutes: 60,
+		PercentComplete: 33.33, StartTimestamp: start, EndTimestamp: notEnded}
+
+	req := api.ListSimulationsRequest{Tracks: []api.Track{api.Track_SILVERSTONE},
+		SortBy: api.SimulationSortField_START_TIMESTAMP, PageSize: 10}
+
+	token, err := encodePageToken(req, last)
+	if err != nil {
+		t.Fatal("failed to encode page token with error: ", err)
+	}
+
+	// The page size may change from page to page.
+	req.PageToken = token
+	req.PageSize = 20
+	decoded, err := decodePageToken(req)
+	if err != nil || decoded.null || decoded.value != "2019-07-14 13:10:00" || decoded.id != last.Uuid {
+		t.Errorf("expected the start timestamp and id of the last simulation, got %v with error: %v", decoded, err)
+	}
+
+	q, err := newSimulationQuery(req, decoded)
+	if err != nil {
+		t.Fatal("failed to build simulation query with error: ", err)
+	}
+	if query := q.sql(req); !strings.Contains(query,
+		"(start_timestamp > ? or (start_timestamp = ? and id > ?))") {
+		t.Errorf("expected the simulations following the page token in query: %v", query)
+	}
+
+	// A token is rejected by a request with other filters or another sort.
+	for _, v := range []api.ListSimulationsRequest{
+		{Tracks: []api.Track{api.Track_MONZA}, SortBy: api.SimulationSortField_START_TIMESTAMP, PageToken: token},
+		{Tracks: []api.Track{api.Track_SILVERSTONE}, SortBy: api.SimulationSortField_START_TIMESTAMP, Descending: true,
+			PageToken: token},
+		{Tracks: []api.Track{api.Track_SILVERSTONE}, SortBy: api.SimulationSortField_PERCENT_COMPLETE, PageToken: token},
+		{Tracks: []api.Track{api.Track_SILVERSTONE}, PageToken: "not a token"},
+	} {
+		if _, err = decodePageToken(v); err == nil {
+			t.Errorf("expected page token to be rejected by request %v", v)
+		}
+	}
+
+	// A simulation that has not ended sorts last when descending by end timestamp.
+	req = api.ListSimulationsRequest{SortBy: api.SimulationSortField_END_TIMESTAMP, Descending: true}
+	if req.PageToken, err = encodePageToken(req, last); err != nil {
+		t.Fatal("failed to encode page token with error: ", err)
+	}
+	if decoded, err = decodePageToken(req); err != nil || !decoded.null {
+		t.Fatalf("expected a null end timestamp, got %v with error: %v", decoded, err)
+	}
+	if q, err = newSimulationQuery(req, decoded); err != nil {
+		t.Fatal("failed to build simulation query with error: ", err)
+	}
+	if query := q.sql(req); !strings.Contains(query, "(end_timestamp is null and id < ?)") {
+		t.Errorf("expected the simulations without an end timestamp following the page token in query: %v", query)
+	}
+
+	// The percent complete keeps the full precision of the float column.
+	req = api.ListSimulationsRequest{SortBy: api.SimulationSortField_PERCENT_COMPLETE}
+	if req.PageToken, err = encodePageToken(req, last); err != nil {
+		t.Fatal("failed to encode page token with error: ", err)
+	}
+	if decoded, err = decodePageToken(req); err != nil {
+		t.Fatal("failed to decode page token with error: ", err)
+	}
+	if q, err = newSimulationQuery(req, decoded); err != nil {
+		t.Fatal("failed to build simulation query with error: ", err)
+	}
+	if v, ok := q.args[0].(float64); !ok || v != last.PercentComplete {
+		t.Errorf("expected percent complete %v in query args, got %v", last.PercentComplete, q.args)
+	}
+}
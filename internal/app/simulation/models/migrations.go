@@ -0,0 +1,64 @@
+package models
+
+import (
+	"github.com/bburch01/FOTAAS/internal/pkg/migrate"
+)
+
+// migrations is the schema history of the simulation service database. Never change a migration
+// that has been released, add a new one.
+var migrations = []migrate.Migration{
+	{Version: 1, Description: "create simulation", Statements: []string{`CREATE TABLE IF NOT EXISTS simulation
+(
+  id VARCHAR(36) CHARACTER SET UTF8MB4 NOT NULL,
+  duration_in_minutes INTEGER NOT NULL,
+  sample_rate ENUM('SR_1_MS', 'SR_10_MS', 'SR_100_MS', 'SR_1000_MS') NOT NULL,
+  gran_prix ENUM('UNITED_STATES', 'AZERBAIJAN', 'SPANISH', 'GERMAN', 'HUNGARIAN',
+        'BRAZILIAN', 'SINGAPORE', 'AUSTRALIAN', 'MEXICAN', 'MONACO',
+        'CANADIAN', 'ITALIAN', 'FRENCH', 'BAHRAIN', 'CHINESE',
+        'BRITISH', 'RUSSIAN', 'BELGIAN', 'AUSTRIAN', 'JAPANESE', 'ABU_DHABI') NOT NULL,
+  track ENUM('AUSTIN', 'BAKU', 'CATALUNYA_BARCELONA', 'HOCKENHEIM', 'HUNGARORING',
+        'INTERLAGOS_SAU_PAULO', 'MARINA_BAY', 'MELBOURNE', 'MEXICO_CITY', 'MONTE_CARLO',
+        'MONTREAL', 'MONZA', 'PAUL_RICARD_LE_CASTELLET', 'SAKHIR', 'SHANGHAI',
+        'SILVERSTONE', 'SOCHI', 'SPA_FRANCORCHAMPS', 'SPIELBERG_RED_BULL_RING', 'SUZUKA', 'YAS_MARINA') NOT NULL,
+  state ENUM('INITIALIZING','IN_PROGRESS', 'COMPLETED', 'FAILED_TO_START', 'FAILED') NOT NULL,
+  start_timestamp TIMESTAMP NULL,
+  end_timestamp TIMESTAMP NULL,
+  percent_complete FLOAT NOT NULL,
+  final_status_code VARCHAR(36) CHARACTER SET UTF8MB4 NULL,
+  final_status_message VARCHAR(255) CHARACTER SET UTF8MB4 NULL,
+  PRIMARY KEY (id)
+) ENGINE=InnoDB DEFAULT CHARSET=UTF8MB4`}},
+	{Version: 2, Description: "create simulation_member", Statements: []string{`CREATE TABLE IF NOT EXISTS simulation_member
+(
+  id VARCHAR(36) CHARACTER SET UTF8MB4 NOT NULL,
+  simulation_id VARCHAR(36) CHARACTER SET UTF8MB4 NOT NULL,
+  constructor ENUM('ALPHA_ROMEO', 'FERRARI', 'HAAS', 'MCLAREN', 'MERCEDES',
+        'RACING_POINT', 'RED_BULL_RACING', 'SCUDERIA_TORO_ROSO', 'WILLIAMS') NOT NULL,
+  car_number INTEGER NOT NULL,
+  force_alarm BOOLEAN NOT NULL,
+  no_alarms BOOLEAN NOT NULL,
+  alarm_occurred BOOLEAN NULL,
+  alarm_datum_description ENUM('G_FORCE', 'G_FORCE_DIRECTION', 'FUEL_CONSUMED', 'FUEL_FLOW', 'ENGINE_COOLANT_TEMP',
+        'ENGINE_OIL_PRESSURE', 'ENGINE_OIL_TEMP', 'ENGINE_RPM', 'BRAKE_TEMP_FR', 'BRAKE_TEMP_FL',
+        'BRAKE_TEMP_RR', 'BRAKE_TEMP_RL', 'ENERGY_STORAGE_LEVEL', 'ENERGY_STORAGE_TEMP',
+        'MGUK_OUTPUT', 'MGUH_OUTPUT', 'SPEED', 'TIRE_PRESSURE_FR', 'TIRE_PRESSURE_FL',
+        'TIRE_PRESSURE_RR', 'TIRE_PRESSURE_RL', 'TIRE_TEMP_FR', 'TIRE_TEMP_FL',
+        'TIRE_TEMP_RR', 'TIRE_TEMP_RL') NULL,
+  alarm_datum_unit ENUM('G', 'KG_PER_HOUR', 'DEGREE_CELCIUS', 'MJ', 'JPS',
+        'RPM', 'BAR', 'KG', 'KPH', 'METER', 'RADIAN', 'KPA') NULL,
+  alarm_datum_value FLOAT NULL,
+  INDEX par_ind (simulation_id),
+  UNIQUE (id, simulation_id),
+  CONSTRAINT fk_simulation_id FOREIGN KEY (simulation_id)
+  REFERENCES simulation(id)
+  ON DELETE CASCADE
+  ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=UTF8MB4`}},
+	{Version: 3, Description: "add CANCELLED and PAUSED simulation states", Statements: []string{`ALTER TABLE simulation
+  MODIFY state ENUM('INITIALIZING','IN_PROGRESS', 'COMPLETED', 'FAILED_TO_START', 'FAILED',
+        'CANCELLED', 'PAUSED') NOT NULL`}},
+	{Version: 4, Description: "add simulation_rate_multiplier to simulation", Statements: []string{`ALTER TABLE simulation
+  ADD COLUMN simulation_rate_multiplier ENUM('X1', 'X2', 'X4', 'X8', 'X10', 'X20') NOT NULL DEFAULT 'X1'`}},
+	{Version: 5, Description: "add seed to simulation", Statements: []string{`ALTER TABLE simulation
+  ADD COLUMN seed BIGINT NOT NULL DEFAULT 0`}},
+}
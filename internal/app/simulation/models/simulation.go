@@ -26,6 +26,7 @@ type Simulation struct {
 	PercentComplete          float32
 	FinalStatusCode          string
 	FinalStatusMessage       string
+	Seed                     int64
 	SimulationMembers        map[string]SimulationMember
 }
 
@@ -34,8 +35,8 @@ func (sim *Simulation) Create() error {
 	sqlStatement := `
 			INSERT INTO simulation (id, duration_in_minutes, sample_rate, gran_prix, track,
 				 state, start_timestamp, end_timestamp, percent_complete, final_status_code,
-				  final_status_message)
-			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+				  final_status_message, simulation_rate_multiplier, seed)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 	pstmt, err := db.Prepare(sqlStatement)
 	if err != nil {
@@ -44,7 +45,8 @@ func (sim *Simulation) Create() error {
 	defer pstmt.Close()
 
 	_, err = pstmt.Exec(sim.ID, sim.DurationInMinutes, sim.SampleRate.String(), sim.GranPrix.String(), sim.Track.String(),
-		sim.State, nil, nil, sim.PercentComplete, sim.FinalStatusCode, sim.FinalStatusMessage)
+		sim.State, nil, nil, sim.PercentComplete, sim.FinalStatusCode, sim.FinalStatusMessage,
+		sim.SimulationRateMultiplier.String(), sim.Seed)
 	if err != nil {
 		return err
 	}
@@ -198,8 +200,13 @@ func (sim Simulation) FindAllMembers() ([]SimulationMember, error) {
 	var simMembers []SimulationMember
 	var member SimulationMember
 	var constructor string
+	// The alarm columns are null until the member has an alarm.
+	var alarmOccurred sql.NullBool
+	var alarmDescription, alarmUnit sql.NullString
+	var alarmValue sql.NullFloat64
 
-	rows, err := db.Query("select * from simulation_member where simulation_id = ?", sim.ID)
+	rows, err := db.Query(`select id, simulation_id, constructor, car_number, force_alarm, no_alarms, alarm_occurred,
+		alarm_datum_description, alarm_datum_unit, alarm_datum_value from simulation_member where simulation_id = ?`, sim.ID)
 
 	if err != nil {
 		return nil, err
@@ -209,8 +216,8 @@ func (sim Simulation) FindAllMembers() ([]SimulationMember, error) {
 	for rows.Next() {
 
 		err := rows.Scan(&member.ID, &member.SimulationID, &constructor,
-			&member.CarNumber, &member.ForceAlarm, &member.NoAlarms, &member.AlarmOccurred, &member.AlarmDatumDescription,
-			&member.AlarmDatumUnit, &member.AlarmDatumValue)
+			&member.CarNumber, &member.ForceAlarm, &member.NoAlarms, &alarmOccurred, &alarmDescription,
+			&alarmUnit, &alarmValue)
 
 		if err != nil {
 			return nil, err
@@ -221,6 +228,10 @@ func (sim Simulation) FindAllMembers() ([]SimulationMember, error) {
 			return nil, fmt.Errorf("invalid constructor enum: %v", constructor)
 		}
 		member.Constructor = api.Constructor(ordinal)
+		member.AlarmOccurred = alarmOccurred.Bool
+		member.AlarmDatumDescription = alarmDescription.String
+		member.AlarmDatumUnit = alarmUnit.String
+		member.AlarmDatumValue = alarmValue.Float64
 		simMembers = append(simMembers, member)
 	}
 	err = rows.Err()
@@ -233,14 +244,8 @@ func (sim Simulation) FindAllMembers() ([]SimulationMember, error) {
 
 func RetrieveSimulationInfo(req api.GetSimulationInfoRequest) (*api.SimulationInfo, error) {
 
-	var sampleRate, granPrix, track, state string
-	var startTs, endTs itime.NullTime
-
-	info := new(api.SimulationInfo)
-
-	err := db.QueryRow("select * from simulation where id = ?", req.SimulationUuid).Scan(&info.Uuid,
-		&info.DurationInMinutes, &sampleRate, &granPrix, &track, &state, &startTs, &endTs,
-		&info.PercentComplete, &info.FinalStatusCode, &info.FinalStatusMessage)
+	info, err := scanSimulationInfo(db.QueryRow("select "+simulationSelectColumns+" from simulation where id = ?",
+		req.SimulationUuid))
 
 	switch {
 	case err == sql.ErrNoRows:
@@ -249,45 +254,86 @@ func RetrieveSimulationInfo(req api.GetSimulationInfoRequest) (*api.SimulationIn
 	case err != nil:
 		logger.Error(fmt.Sprintf("failed to retrieve simulation info with error: %v", err))
 		return nil, err
-	default:
+	}
+
+	if err = retrieveMembers([]*api.SimulationInfo{info}); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// RetrieveOrphanedSimulations retrieves, with their members, the simulations that are INITIALIZING,
+// IN_PROGRESS or PAUSED. When the simulation service starts, before it runs any simulation, these
+// are the simulations that were running when it stopped.
+func RetrieveOrphanedSimulations() ([]*Simulation, error) {
+
+	rows, err := db.Query(`select id, duration_in_minutes, sample_rate, simulation_rate_multiplier, gran_prix, track, state,
+		start_timestamp, percent_complete, seed from simulation where state in ('INITIALIZING', 'IN_PROGRESS', 'PAUSED')`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var simulations []*Simulation
+	for rows.Next() {
+
+		var sampleRate, simRateMultiplier, granPrix, track string
+		var startTs itime.NullTime
+
+		sim := &Simulation{SimulationMembers: make(map[string]SimulationMember)}
+		if err = rows.Scan(&sim.ID, &sim.DurationInMinutes, &sampleRate, &simRateMultiplier, &granPrix, &track, &sim.State,
+			&startTs, &sim.PercentComplete, &sim.Seed); err != nil {
+			return nil, err
+		}
+
 		ordinal, ok := api.SampleRate_value[sampleRate]
 		if !ok {
 			return nil, fmt.Errorf("invalid simulation sample rate enum: %v", sampleRate)
 		}
-		info.SampleRate = api.SampleRate(ordinal)
+		sim.SampleRate = api.SampleRate(ordinal)
+
+		ordinal, ok = api.SimulationRateMultiplier_value[simRateMultiplier]
+		if !ok {
+			return nil, fmt.Errorf("invalid simulation rate multiplier enum: %v", simRateMultiplier)
+		}
+		sim.SimulationRateMultiplier = api.SimulationRateMultiplier(ordinal)
 
 		ordinal, ok = api.GranPrix_value[granPrix]
 		if !ok {
 			return nil, fmt.Errorf("invalid simulation gran prix enum: %v", granPrix)
 		}
-		info.GranPrix = api.GranPrix(ordinal)
+		sim.GranPrix = api.GranPrix(ordinal)
 
 		ordinal, ok = api.Track_value[track]
 		if !ok {
 			return nil, fmt.Errorf("invalid simulation track enum: %v", track)
 		}
-		info.Track = api.Track(ordinal)
+		sim.Track = api.Track(ordinal)
 
-		ordinal, ok = api.SimulationState_value[state]
-		if !ok {
-			return nil, fmt.Errorf("invalid simulation state enum: %v", track)
+		if startTs.Valid {
+			if sim.StartTimestamp, err = ipbts.TimestampProto(startTs.Time); err != nil {
+				return nil, errors.New("failed to convert start timestamp to protobuf format")
+			}
 		}
-		info.State = api.SimulationState(ordinal)
 
-		tsProto, err := ipbts.TimestampProto(startTs.Time)
-		if err != nil {
-			return nil, errors.New("failed to convert start timestamp to protobuf format")
-		}
-		info.StartTimestamp = tsProto
+		simulations = append(simulations, sim)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
 
-		tsProto, err = ipbts.TimestampProto(endTs.Time)
+	for _, sim := range simulations {
+		members, err := sim.FindAllMembers()
 		if err != nil {
-			return nil, errors.New("failed to convert end timestamp to protobuf format")
+			return nil, err
+		}
+		for _, v := range members {
+			sim.SimulationMembers[v.ID] = v
 		}
-		info.EndTimestamp = tsProto
 	}
 
-	return info, nil
+	return simulations, nil
 }
 
 func NewFromRunSimulationRequest(req api.RunSimulationRequest) *Simulation {
@@ -301,6 +347,13 @@ func NewFromRunSimulationRequest(req api.RunSimulationRequest) *Simulation {
 	sim.GranPrix = req.Simulation.GranPrix
 	sim.Track = req.Simulation.Track
 
+	// Without a seed from the client the simulation is seeded from the time, the seed is recorded so
+	// that the run can still be reproduced.
+	sim.Seed = req.Simulation.Seed
+	if sim.Seed == 0 {
+		sim.Seed = time.Now().UnixNano()
+	}
+
 	var simMember SimulationMember
 	for _, v := range req.Simulation.SimulationMemberMap {
 		simMember.ID = v.Uuid
@@ -0,0 +1,155 @@
+package simulation
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/bburch01/FOTAAS/internal/app/simulation/control"
+	"github.com/bburch01/FOTAAS/internal/app/simulation/models"
+	"google.golang.org/grpc"
+)
+
+// RecoveryPolicy is what the simulation service does, when it starts, with the simulations that were
+// running when it stopped. Such a simulation would otherwise stay INITIALIZING, IN_PROGRESS or
+// PAUSED forever.
+type RecoveryPolicy int
+
+const (
+	// RecoveryFail marks the orphaned simulations FAILED.
+	RecoveryFail RecoveryPolicy = iota
+	// RecoveryResume resumes the orphaned simulations after the last telemetry that the telemetry
+	// service stored for them. A simulation that cannot be resumed is marked FAILED.
+	RecoveryResume
+)
+
+func (p RecoveryPolicy) String() string {
+	if p == RecoveryResume {
+		return "resume"
+	}
+	return "fail"
+}
+
+// RecoveryPolicyForString returns the recovery policy named by s (case insensitive), fail or resume.
+func RecoveryPolicyForString(s string) (RecoveryPolicy, error) {
+	switch strings.ToLower(s) {
+	case "fail":
+		return RecoveryFail, nil
+	case "resume":
+		return RecoveryResume, nil
+	default:
+		return RecoveryFail, fmt.Errorf("invalid recovery policy %v, valid policies are: fail, resume", s)
+	}
+}
+
+// RecoverOrphanedSimulations applies policy to the simulations orphaned by a restart of the
+// simulation service and returns the number recovered. It has to be called before the service runs
+// any simulation, and assumes that the service is the only one using its database.
+func RecoverOrphanedSimulations(policy RecoveryPolicy) (int, error) {
+
+	orphans, err := models.RetrieveOrphanedSimulations()
+	if err != nil {
+		return 0, err
+	}
+
+	for _, sim := range orphans {
+
+		message := "simulation orphaned by a restart of the simulation service"
+
+		if policy == RecoveryResume {
+			err := resumeOrphan(sim)
+			if err == nil {
+				continue
+			}
+			logger.Error(fmt.Sprintf("failed to resume simulation %v with error: %v", sim.ID, err))
+			message = "simulation orphaned by a restart of the simulation service could not be resumed"
+		}
+
+		logger.Info(fmt.Sprintf("simulation %v orphaned in state %v marked FAILED", sim.ID, sim.State))
+		sim.State = "FAILED"
+		if err := sim.UpdateState(); err != nil {
+			return 0, err
+		}
+		sim.FinalStatusCode = "ERROR"
+		if err := sim.UpdateFinalStatusCode(); err != nil {
+			return 0, err
+		}
+		sim.FinalStatusMessage = message
+		if err := sim.UpdateFinalStatusMessage(); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(orphans), nil
+}
+
+// resumeOrphan starts the transmission of the orphaned simulation sim after the telemetry already
+// stored. A simulation that was paused is resumed paused.
+func resumeOrphan(sim *models.Simulation) error {
+
+	progress, err := retrieveTransmitProgress(sim.ID)
+	if err != nil {
+		return err
+	}
+
+	transmitted := make(map[string]int32)
+	for _, v := range sim.SimulationMembers {
+		for _, car := range progress {
+			if car.Constructor == v.Constructor && car.CarNumber == v.CarNumber {
+				transmitted[v.ID] = car.LastTransmitSequenceNumber
+			}
+		}
+	}
+
+	ctl, err := control.Register(sim.ID)
+	if err != nil {
+		return err
+	}
+	if sim.State == "PAUSED" {
+		if err = control.Pause(sim.ID); err != nil {
+			ctl.Unregister()
+			return err
+		}
+	}
+
+	logger.Info(fmt.Sprintf("simulation %v orphaned in state %v resumed with transmit progress: %v", sim.ID, sim.State,
+		transmitted))
+	go ResumeSimulation(sim, ctl, transmitted)
+
+	return nil
+}
+
+// retrieveTransmitProgress retrieves the last simulation transmit sequence number up to which the
+// telemetry service stored every sequence number for each car of the simulation with id simID.
+func retrieveTransmitProgress(simID string) ([]*api.CarTransmitProgress, error) {
+
+	var sb strings.Builder
+	sb.WriteString(os.Getenv("TELEMETRY_SERVICE_HOST"))
+	sb.WriteString(":")
+	sb.WriteString(os.Getenv("TELEMETRY_SERVICE_PORT"))
+	telemetrySvcEndpoint := sb.String()
+
+	conn, err := grpc.Dial(telemetrySvcEndpoint, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	// TODO: determine what the appropriate deadline should be for this service call.
+	clientDeadline := time.Now().Add(time.Duration(30) * time.Second)
+	ctx, cancel := context.WithDeadline(context.Background(), clientDeadline)
+	defer cancel()
+
+	resp, err := api.NewTelemetryServiceClient(conn).GetTransmitProgress(ctx, &api.GetTransmitProgressRequest{SimulationUuid: simID})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Details.Code != api.ResponseCode_OK {
+		return nil, fmt.Errorf("failed to retrieve transmit progress with telemetry service message: %v", resp.Details.Message)
+	}
+
+	return resp.Cars, nil
+}
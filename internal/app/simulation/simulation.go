@@ -3,6 +3,7 @@ package simulation
 import (
 	"context"
 	"fmt"
+	"io"
 	"log"
 	"math"
 	"os"
@@ -13,9 +14,12 @@ import (
 	ipbts "github.com/bburch01/FOTAAS/internal/pkg/protobuf/timestamp"
 
 	"github.com/bburch01/FOTAAS/api"
+	"github.com/bburch01/FOTAAS/internal/app/simulation/control"
 	"github.com/bburch01/FOTAAS/internal/app/simulation/data"
 	"github.com/bburch01/FOTAAS/internal/app/simulation/models"
 	"github.com/bburch01/FOTAAS/internal/app/telemetry"
+	"github.com/bburch01/FOTAAS/internal/app/telemetry/alarm"
+	"github.com/bburch01/FOTAAS/internal/app/telemetry/ingest"
 	"github.com/bburch01/FOTAAS/internal/pkg/logging"
 	"github.com/joho/godotenv"
 	"go.uber.org/zap"
@@ -24,6 +28,12 @@ import (
 
 var logger *zap.Logger
 
+// telemetryAckMode is the acknowledgement mode of the simulation telemetry streams, durable unless
+// SIMULATION_TELEMETRY_ACK_MODE opts in to accepted. With accepted a slow telemetry database does not
+// slow down the simulation, but a batch that the telemetry service fails to store after accepting it
+// is only reported once the stream is closed and the simulation does not retransmit it.
+var telemetryAckMode = api.AckMode_ACK_DURABLE
+
 func init() {
 
 	var lm logging.LogMode
@@ -41,9 +51,20 @@ func init() {
 		log.Panicf("failed to initialize logging subsystem with error: %v", err)
 	}
 
+	if v := os.Getenv("SIMULATION_TELEMETRY_ACK_MODE"); v != "" {
+		if telemetryAckMode, err = ingest.AckModeForString(v); err != nil {
+			log.Panicf("failed to initialize simulation telemetry stream with error: %v", err)
+		}
+	}
+
 }
 
-func StartSimulation(sim *models.Simulation) {
+// StartSimulation runs sim, which was registered as running with ctl, until it completes, fails or
+// is cancelled. While paused the simulation transmits no telemetry and its telemetry stream is
+// closed.
+func StartSimulation(sim *models.Simulation, ctl *control.Control) {
+
+	defer ctl.Unregister()
 
 	sim.State = "INITIALIZING"
 	if err := sim.Create(); err != nil {
@@ -53,13 +74,92 @@ func StartSimulation(sim *models.Simulation) {
 		return
 	}
 
+	runSimulation(sim, ctl, nil)
+}
+
+// ResumeSimulation runs sim, a simulation orphaned by a restart of the simulation service that was
+// registered as running with ctl, like StartSimulation. transmitted is the last simulation transmit
+// sequence number stored by the telemetry service for each simulation member (keyed by member id),
+// the transmission of a member resumes after it. A member without an entry is transmitted from the
+// start.
+func ResumeSimulation(sim *models.Simulation, ctl *control.Control, transmitted map[string]int32) {
+
+	defer ctl.Unregister()
+
+	runSimulation(sim, ctl, transmitted)
+}
+
+// runSimulation generates the telemetry of sim, which has been persisted, and transmits the part of
+// it that is not in transmitted (see ResumeSimulation).
+func runSimulation(sim *models.Simulation, ctl *control.Control, transmitted map[string]int32) {
+
+	// The alarm levels of the simulated alarms come from the telemetry service's alarm threshold
+	// registry, so that the telemetry service's alarm evaluation agrees with the simulator.
+	thresholds, err := retrieveAlarmThresholds()
+	if err != nil {
+		logger.Error(fmt.Sprintf("simulation %v failed to start with error: %v", sim.ID, err))
+		sim.State = "FAILED_TO_START"
+		if err := sim.UpdateState(); err != nil {
+			logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
+		}
+		sim.FinalStatusCode = "ERROR"
+		if err := sim.UpdateFinalStatusCode(); err != nil {
+			logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
+		}
+		sim.FinalStatusMessage = "simulation failed to start, unable to retrieve the alarm threshold registry"
+		if err := sim.UpdateFinalStatusMessage(); err != nil {
+			logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
+		}
+		return
+	}
+
+	// The telemetry data is timestamped from the start timestamp, so it is persisted before the
+	// telemetry is generated and a resumed simulation keeps the start timestamp of its first run. It
+	// is persisted to the second, the resumed simulation must continue the same timeline.
+	if sim.StartTimestamp == nil {
+		sim.StartTimestamp, err = ipbts.TimestampProto(time.Now().Truncate(time.Second))
+		if err != nil {
+			logger.Error(fmt.Sprintf("simulation %v failed to start with error: %v", sim.ID, err))
+			sim.State = "FAILED_TO_START"
+			if err := sim.UpdateState(); err != nil {
+				logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
+			}
+			sim.FinalStatusCode = "ERROR"
+			if err := sim.UpdateFinalStatusCode(); err != nil {
+				logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
+			}
+			sim.FinalStatusMessage = "simulation failed to start with a server-side error"
+			if err := sim.UpdateFinalStatusMessage(); err != nil {
+				logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
+			}
+			return
+		}
+
+		if err := sim.UpdateStartTimestamp(); err != nil {
+			logger.Error(fmt.Sprintf("simulation %v failed to start with error: %v", sim.ID, err))
+			sim.State = "FAILED_TO_START"
+			if err := sim.UpdateState(); err != nil {
+				logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
+			}
+			sim.FinalStatusCode = "ERROR"
+			if err := sim.UpdateFinalStatusCode(); err != nil {
+				logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
+			}
+			sim.FinalStatusMessage = "simulation failed to start with a server-side error"
+			if err := sim.UpdateFinalStatusMessage(); err != nil {
+				logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
+			}
+			return
+		}
+	}
+
 	// Generate simulated telemetry data for all simulation members in advance.
 	var wg sync.WaitGroup
 	errChan := make(chan error, len(sim.SimulationMembers))
 	resultsChan := make(chan data.SimMemberData, len(sim.SimulationMembers))
 	wg.Add(len(sim.SimulationMembers))
 	for _, v := range sim.SimulationMembers {
-		go data.GenerateSimulatedTelemetryData(*sim, v, &wg, resultsChan, errChan)
+		go data.GenerateSimulatedTelemetryData(*sim, v, thresholds, &wg, resultsChan, errChan)
 	}
 	wg.Wait()
 	close(resultsChan)
@@ -84,6 +184,11 @@ func StartSimulation(sim *models.Simulation) {
 		return
 	}
 
+	if ctl.Cancelled() {
+		recordCancelled(sim)
+		return
+	}
+
 	var simMemberDataMap = make(map[string]map[api.TelemetryDatumDescription]telemetry.SimulatedTelemetryData)
 
 	// Retrieve and aggregate the simulation data (for all of the sim members) from the results channel
@@ -163,6 +268,20 @@ func StartSimulation(sim *models.Simulation) {
 	percentCompleteIncrement := float32(float32(100.0) / float32(datumCount))
 	percentCompleteIncrement = float32(math.Floor(float64(percentCompleteIncrement*100)) / 100)
 
+	// A resumed simulation continues from the first sequence number that one of its members has not
+	// transmitted.
+	first := datumCount
+	for _, v := range sim.SimulationMembers {
+		next := int32(0)
+		if last, ok := transmitted[v.ID]; ok {
+			next = last + 1
+		}
+		if next < first {
+			first = next
+		}
+	}
+	percentComplete = float32(math.Floor(float64(float32(first)*percentCompleteIncrement*100)) / 100)
+
 	var sb strings.Builder
 
 	sb.WriteString(os.Getenv("TELEMETRY_SERVICE_HOST"))
@@ -189,32 +308,24 @@ func StartSimulation(sim *models.Simulation) {
 		return
 	}
 
-	// TODO: determine what the appropriate deadline should be for this service call.
-	clientDeadline := time.Now().Add(time.Duration(300) * time.Second)
-	ctx, cancel := context.WithDeadline(context.Background(), clientDeadline)
-	defer cancel()
-
 	client := api.NewTelemetryServiceClient(conn)
 
-	sim.StartTimestamp, err = ipbts.TimestampProto(time.Now())
-	if err != nil {
-		logger.Error(fmt.Sprintf("simulation %v failed to start with error: %v", sim.ID, err))
-		sim.State = "FAILED_TO_START"
-		if err := sim.UpdateState(); err != nil {
-			logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
-		}
+	sim.State = "IN_PROGRESS"
+	if err := sim.UpdateState(); err != nil {
+		logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
 		sim.FinalStatusCode = "ERROR"
 		if err := sim.UpdateFinalStatusCode(); err != nil {
 			logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
 		}
-		sim.FinalStatusMessage = "simulation failed to start with a server-side error"
+		sim.FinalStatusMessage = fmt.Sprintf("simulation failed to start with server-side error: %v", err)
 		if err := sim.UpdateFinalStatusMessage(); err != nil {
 			logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
 		}
 		return
 	}
 
-	if err := sim.UpdateStartTimestamp(); err != nil {
+	sim.PercentComplete = percentComplete
+	if err := sim.UpdatePercentComplete(); err != nil {
 		logger.Error(fmt.Sprintf("simulation %v failed to start with error: %v", sim.ID, err))
 		sim.State = "FAILED_TO_START"
 		if err := sim.UpdateState(); err != nil {
@@ -231,22 +342,8 @@ func StartSimulation(sim *models.Simulation) {
 		return
 	}
 
-	sim.State = "IN_PROGRESS"
-	if err := sim.UpdateState(); err != nil {
-		logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
-		sim.FinalStatusCode = "ERROR"
-		if err := sim.UpdateFinalStatusCode(); err != nil {
-			logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
-		}
-		sim.FinalStatusMessage = fmt.Sprintf("simulation failed to start with server-side error: %v", err)
-		if err := sim.UpdateFinalStatusMessage(); err != nil {
-			logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
-		}
-		return
-	}
-
-	sim.PercentComplete = percentComplete
-	if err := sim.UpdatePercentComplete(); err != nil {
+	stream, streamCancel, err := openTelemetryStream(client, time.Duration(sim.DurationInMinutes)*time.Minute)
+	if err != nil {
 		logger.Error(fmt.Sprintf("simulation %v failed to start with error: %v", sim.ID, err))
 		sim.State = "FAILED_TO_START"
 		if err := sim.UpdateState(); err != nil {
@@ -263,17 +360,65 @@ func StartSimulation(sim *models.Simulation) {
 		return
 	}
 
-	var resp *api.TransmitTelemetryResponse
-	var req api.TransmitTelemetryRequest
+	var req api.TransmitTelemetryStreamRequest
+	var batchSeqNum int64
 	var transmissionCount int
 
+	defer func() { streamCancel() }()
+
 	// Main simulation loop
-	for idx := int32(0); idx < datumCount; idx++ {
+	for idx := first; idx < datumCount; idx++ {
+
+		// The telemetry stream is closed while the simulation is paused, the stream deadline would
+		// otherwise have to cover a pause of any length.
+		if resume := ctl.Paused(); resume != nil {
+			if _, err = closeTelemetryStream(stream); err != nil {
+				logger.Error(fmt.Sprintf("simulation %v failed with error: %v", sim.ID, err))
+				recordFailed(sim)
+				return
+			}
+			streamCancel()
+
+			sim.State = "PAUSED"
+			if err := sim.UpdateState(); err != nil {
+				logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
+			}
+			logger.Info(fmt.Sprintf("simulation %v paused at %v percent complete", sim.ID, sim.PercentComplete))
+
+			if !ctl.WaitForResume(resume) {
+				recordCancelled(sim)
+				return
+			}
+
+			sim.State = "IN_PROGRESS"
+			if err := sim.UpdateState(); err != nil {
+				logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
+			}
+			logger.Info(fmt.Sprintf("simulation %v resumed", sim.ID))
+
+			remaining := time.Duration(datumCount-idx) * time.Duration(sampleRateInMillis) * time.Millisecond
+			if stream, streamCancel, err = openTelemetryStream(client, remaining); err != nil {
+				streamCancel = func() {}
+				logger.Error(fmt.Sprintf("simulation %v failed with error: %v", sim.ID, err))
+				recordFailed(sim)
+				return
+			}
+		}
+
 		for _, v := range sim.SimulationMembers {
 
-			tdata := api.TelemetryData{}
+			if last, ok := transmitted[v.ID]; ok && idx <= last {
+				continue
+			}
 
+			// The telemetry of a car ends at its alarm, the car is out of the simulation.
 			simMemberData := simMemberDataMap[v.ID]
+			if int(idx) >= len(simMemberData[api.TelemetryDatumDescription_SPEED].Data) {
+				continue
+			}
+
+			tdata := api.TelemetryData{}
+
 			datumMap := make(map[string]*api.TelemetryDatum, len(simMemberData))
 
 			for _, v2 := range simMemberData {
@@ -282,10 +427,19 @@ func StartSimulation(sim *models.Simulation) {
 			}
 
 			tdata.TelemetryDatumMap = datumMap
+			req.BatchSequenceNumber = batchSeqNum
 			req.TelemetryData = &tdata
-
-			resp, err = client.TransmitTelemetry(ctx, &req)
-			if err != nil {
+			req.AckMode = telemetryAckMode
+			batchSeqNum++
+
+			if err = stream.Send(&req); err != nil {
+				// The telemetry service closes the stream early when a batch fails processing, in
+				// which case Send returns io.EOF and the reason is in the stream response.
+				if err == io.EOF {
+					if _, err = closeTelemetryStream(stream); err == nil {
+						err = fmt.Errorf("telemetry stream closed unexpectedly by the telemetry service")
+					}
+				}
 				logger.Error(fmt.Sprintf("simulation %v failed with error: %v", sim.ID, err))
 				sim.State = "FAILED"
 				if err := sim.UpdateState(); err != nil {
@@ -295,36 +449,23 @@ func StartSimulation(sim *models.Simulation) {
 				if err := sim.UpdateFinalStatusCode(); err != nil {
 					logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
 				}
-				sim.FinalStatusMessage = "simulation failed to start with a server-side error"
+				sim.FinalStatusMessage = "simulation failed with a server-side error"
 				if err := sim.UpdateFinalStatusMessage(); err != nil {
 					logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
 				}
 				return
 			}
-
-			for _, v := range resp.Details {
-				if v.Code != api.ResponseCode_OK {
-					logger.Error(fmt.Sprintf("simulation %v failed with telemetry service code: %v", sim.ID, v.Code))
-					logger.Error(fmt.Sprintf("simulation %v failed with telemetry service message: %v", sim.ID, v.Message))
-					sim.State = "FAILED"
-					if err := sim.UpdateState(); err != nil {
-						logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
-					}
-					sim.FinalStatusCode = "ERROR"
-					if err := sim.UpdateFinalStatusCode(); err != nil {
-						logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
-					}
-					sim.FinalStatusMessage = "simulation failed to start with a server-side error"
-					if err := sim.UpdateFinalStatusMessage(); err != nil {
-						logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
-					}
-					return
-				}
-			}
 		}
 
 		transmissionCount++
-		time.Sleep(sleepDuration)
+		if !ctl.Sleep(sleepDuration) {
+			// The telemetry already sent is kept, the stream is closed normally.
+			if _, err = closeTelemetryStream(stream); err != nil {
+				logger.Error(fmt.Sprintf("simulation %v failed to close its telemetry stream with error: %v", sim.ID, err))
+			}
+			recordCancelled(sim)
+			return
+		}
 		percentComplete += percentCompleteIncrement
 		percentComplete = float32(math.Floor(float64(percentComplete*100)) / 100)
 
@@ -348,6 +489,23 @@ func StartSimulation(sim *models.Simulation) {
 
 	}
 
+	if _, err = closeTelemetryStream(stream); err != nil {
+		logger.Error(fmt.Sprintf("simulation %v failed with error: %v", sim.ID, err))
+		sim.State = "FAILED"
+		if err := sim.UpdateState(); err != nil {
+			logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
+		}
+		sim.FinalStatusCode = "ERROR"
+		if err := sim.UpdateFinalStatusCode(); err != nil {
+			logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
+		}
+		sim.FinalStatusMessage = "simulation failed with a server-side error"
+		if err := sim.UpdateFinalStatusMessage(); err != nil {
+			logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
+		}
+		return
+	}
+
 	sim.EndTimestamp, err = ipbts.TimestampProto(time.Now())
 	if err != nil {
 		logger.Error(fmt.Sprintf("simulation %v failed with error: %v", sim.ID, err))
@@ -422,3 +580,125 @@ func StartSimulation(sim *models.Simulation) {
 
 	return
 }
+
+// recordCancelled persists the final state of the cancelled simulation sim.
+func recordCancelled(sim *models.Simulation) {
+
+	logger.Info(fmt.Sprintf("simulation %v cancelled at %v percent complete", sim.ID, sim.PercentComplete))
+
+	sim.State = "CANCELLED"
+	if err := sim.UpdateState(); err != nil {
+		logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
+	}
+	if ts, err := ipbts.TimestampProto(time.Now()); err == nil {
+		sim.EndTimestamp = ts
+		if err := sim.UpdateEndTimestamp(); err != nil {
+			logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
+		}
+	}
+	sim.FinalStatusCode = "OK"
+	if err := sim.UpdateFinalStatusCode(); err != nil {
+		logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
+	}
+	sim.FinalStatusMessage = fmt.Sprintf("simulation cancelled at %v percent complete", sim.PercentComplete)
+	if err := sim.UpdateFinalStatusMessage(); err != nil {
+		logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
+	}
+}
+
+// recordFailed persists the final state of the simulation sim that failed while running.
+func recordFailed(sim *models.Simulation) {
+
+	sim.State = "FAILED"
+	if err := sim.UpdateState(); err != nil {
+		logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
+	}
+	sim.FinalStatusCode = "ERROR"
+	if err := sim.UpdateFinalStatusCode(); err != nil {
+		logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
+	}
+	sim.FinalStatusMessage = "simulation failed with a server-side error"
+	if err := sim.UpdateFinalStatusMessage(); err != nil {
+		logger.Error(fmt.Sprintf("failed to update simulation %v with error: %v", sim.ID, err))
+	}
+}
+
+// openTelemetryStream opens a telemetry stream for a simulation with d left to run (at X1). The
+// stream stays open for the rest of the simulation, so the deadline has to cover d plus some
+// headroom for the telemetry service to drain the stream.
+func openTelemetryStream(client api.TelemetryServiceClient, d time.Duration) (api.TelemetryService_TransmitTelemetryStreamClient,
+	context.CancelFunc, error) {
+
+	// TODO: determine what the appropriate headroom should be for this service call.
+	clientDeadline := time.Now().Add(d + time.Duration(300)*time.Second)
+	ctx, cancel := context.WithDeadline(context.Background(), clientDeadline)
+
+	stream, err := client.TransmitTelemetryStream(ctx)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	return stream, cancel, nil
+}
+
+// retrieveAlarmThresholds retrieves the telemetry service's alarm threshold registry.
+func retrieveAlarmThresholds() (*alarm.Table, error) {
+
+	var sb strings.Builder
+	sb.WriteString(os.Getenv("TELEMETRY_SERVICE_HOST"))
+	sb.WriteString(":")
+	sb.WriteString(os.Getenv("TELEMETRY_SERVICE_PORT"))
+	telemetrySvcEndpoint := sb.String()
+
+	conn, err := grpc.Dial(telemetrySvcEndpoint, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	// TODO: determine what the appropriate deadline should be for this service call.
+	clientDeadline := time.Now().Add(time.Duration(30) * time.Second)
+	ctx, cancel := context.WithDeadline(context.Background(), clientDeadline)
+	defer cancel()
+
+	resp, err := api.NewTelemetryServiceClient(conn).ListAlarmThresholds(ctx, &api.ListAlarmThresholdsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Details.Code != api.ResponseCode_OK {
+		return nil, fmt.Errorf("failed to list alarm thresholds with telemetry service message: %v", resp.Details.Message)
+	}
+
+	return alarm.NewTable(resp.AlarmThresholds), nil
+}
+
+// closeTelemetryStream closes the client side of a telemetry stream and checks the per batch
+// acknowledgements returned by the telemetry service.
+func closeTelemetryStream(stream api.TelemetryService_TransmitTelemetryStreamClient) (*api.TransmitTelemetryStreamResponse, error) {
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range resp.BatchAcks {
+		if v.Details.Code != api.ResponseCode_OK && v.Details.Code != api.ResponseCode_ACCEPTED {
+			logger.Error(fmt.Sprintf("telemetry batch %v failed with telemetry service code: %v", v.FirstBatchSequenceNumber, v.Details.Code))
+			logger.Error(fmt.Sprintf("telemetry batch %v failed with telemetry service message: %v", v.FirstBatchSequenceNumber, v.Details.Message))
+			for _, v2 := range v.DatumDetails {
+				// A DUPLICATE datum is one that was already persisted by a retried transmission.
+				if v2.Code == api.ResponseCode_DUPLICATE {
+					continue
+				}
+				logger.Error(fmt.Sprintf("telemetry batch %v datum failed with telemetry service message: %v", v.FirstBatchSequenceNumber, v2.Message))
+			}
+		}
+	}
+
+	if resp.Details.Code != api.ResponseCode_OK {
+		return resp, fmt.Errorf("telemetry stream failed with telemetry service message: %v", resp.Details.Message)
+	}
+
+	return resp, nil
+}
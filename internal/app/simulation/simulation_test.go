@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/bburch01/FOTAAS/api"
+	"github.com/bburch01/FOTAAS/internal/app/simulation/control"
 	"github.com/bburch01/FOTAAS/internal/app/simulation/models"
 	"github.com/google/uuid"
 	"github.com/joho/godotenv"
@@ -60,6 +61,22 @@ func TestStartSimulation(t *testing.T) {
 	// eventually need to add a polling loop that will query the simulation service db to check on the
 	// status of the test simulation. sqlmock will eventually need to be incorported so the this unit
 	// test can run in the CI/CD pipeline.
-	StartSimulation(&sim)
+	ctl, err := control.Register(simID)
+	if err != nil {
+		t.Fatal("failed to register simulation with error: ", err)
+	}
+	StartSimulation(&sim, ctl)
+
+}
 
+func TestRecoveryPolicyForString(t *testing.T) {
+
+	for s, want := range map[string]RecoveryPolicy{"fail": RecoveryFail, "Resume": RecoveryResume} {
+		if p, err := RecoveryPolicyForString(s); err != nil || p != want {
+			t.Errorf("expected recovery policy %v for %v, got %v with error: %v", want, s, p, err)
+		}
+	}
+	if _, err := RecoveryPolicyForString("restart"); err == nil {
+		t.Error("expected an invalid recovery policy to be rejected")
+	}
 }
@@ -0,0 +1,135 @@
+package track
+
+import (
+	"github.com/bburch01/FOTAAS/api"
+)
+
+// catalogue holds the geometry of every track. The centrelines are coarse outlines of the grand
+// prix layouts, traced in a dozen or so points, which is enough to give simulated telemetry data
+// plausible positions and elevation changes.
+var catalogue = map[api.Track]*Geometry{
+	api.Track_AUSTIN: {Length: 5513, Centreline: []Point{
+		{30.132800, -97.641100, 162}, {30.135944, -97.641515, 178}, {30.137471, -97.641204, 193},
+		{30.137022, -97.643697, 186}, {30.136214, -97.646501, 179}, {30.135495, -97.649409, 172},
+		{30.134417, -97.652006, 167}, {30.132351, -97.653045, 163}, {30.131273, -97.650448, 160},
+		{30.131003, -97.644216, 155}, {30.130464, -97.637984, 151}, {30.129027, -97.635699, 150},
+		{30.127410, -97.637153, 152}, {30.128129, -97.639854, 154}, {30.129836, -97.640685, 157},
+		{30.131453, -97.640892, 160},
+	}},
+	api.Track_BAKU: {Length: 6003, Centreline: []Point{
+		{40.372500, 49.853300, -20}, {40.371961, 49.849173, -21}, {40.369805, 49.848348, -19}, {40.368727, 49.850942, -16},
+		{40.366661, 49.851531, -12}, {40.365673, 49.847994, -6}, {40.366930, 49.845046, 2}, {40.369086, 49.843631, 8},
+		{40.370883, 49.844339, 4}, {40.372500, 49.846225, -5}, {40.374746, 49.849763, -18}, {40.375195, 49.856248, -24},
+		{40.374746, 49.863912, -26}, {40.374117, 49.869808, -26}, {40.373039, 49.868039, -24}, {40.372859, 49.860375, -22},
+	}},
+	api.Track_CATALUNYA_BARCELONA: {Length: 4655, Centreline: []Point{
+		{41.570000, 2.261100, 128}, {41.565059, 2.261100, 119}, {41.563712, 2.259659, 121}, {41.564969, 2.257498, 130},
+		{41.567305, 2.256057, 137}, {41.569281, 2.254376, 141}, {41.571078, 2.256297, 134}, {41.570719, 2.258939, 125},
+		{41.572336, 2.260380, 122}, {41.573593, 2.257978, 127}, {41.574671, 2.259659, 131}, {41.573773, 2.261580, 133},
+		{41.571797, 2.261820, 130},
+	}},
+	api.Track_HOCKENHEIM: {Length: 4574, Centreline: []Point{
+		{49.327800, 8.565600, 102}, {49.326453, 8.567254, 102}, {49.326183, 8.571389, 103}, {49.327441, 8.578005, 104},
+		{49.329147, 8.584208, 105}, {49.330495, 8.586275, 105}, {49.331214, 8.582829, 104}, {49.330136, 8.576627, 103},
+		{49.329866, 8.571803, 103}, {49.331393, 8.569735, 102}, {49.331842, 8.567254, 102}, {49.330495, 8.564773, 101},
+		{49.328878, 8.563532, 101},
+	}},
+	api.Track_HUNGARORING: {Length: 4381, Centreline: []Point{
+		{47.578900, 19.248600, 248}, {47.575127, 19.246602, 236}, {47.573869, 19.248067, 232}, {47.575127, 19.250997, 238},
+		{47.577283, 19.252062, 245}, {47.578361, 19.254193, 252}, {47.580158, 19.255525, 259}, {47.581595, 19.253660, 262},
+		{47.581236, 19.250731, 258}, {47.582673, 19.249799, 255}, {47.583122, 19.247535, 251}, {47.581595, 19.246469, 250},
+		{47.580247, 19.247401, 249},
+	}},
+	api.Track_INTERLAGOS_SAU_PAULO: {Length: 4309, Centreline: []Point{
+		{-23.703600, -46.699700, 785}, {-23.704858, -46.700485, 771}, {-23.705936, -46.699896, 766},
+		{-23.707373, -46.697247, 752}, {-23.707822, -46.694598, 749}, {-23.706295, -46.695972, 758},
+		{-23.705037, -46.698130, 764}, {-23.703780, -46.697247, 771}, {-23.702522, -46.698523, 776},
+		{-23.703061, -46.701270, 774}, {-23.701803, -46.702251, 780}, {-23.700546, -46.701466, 790},
+		{-23.701264, -46.699896, 792}, {-23.702432, -46.699308, 790},
+	}},
+	api.Track_MARINA_BAY: {Length: 5063, Centreline: []Point{
+		{1.291400, 103.864000, 7}, {1.290502, 103.862652, 6}, {1.288705, 103.863461, 6}, {1.287627, 103.861304, 5},
+		{1.288705, 103.859328, 6}, {1.290681, 103.857710, 8}, {1.293197, 103.858609, 10}, {1.293736, 103.860855, 11},
+		{1.295173, 103.862922, 9}, {1.294814, 103.865797, 7}, {1.293736, 103.868043, 6}, {1.292747, 103.869841, 5},
+		{1.291580, 103.868672, 5}, {1.291220, 103.866246, 6},
+	}},
+	api.Track_MELBOURNE: {Length: 5303, Centreline: []Point{
+		{-37.849700, 144.968000, 7}, {-37.851946, 144.966294, 6}, {-37.854012, 144.966862, 6}, {-37.855539, 144.969706, 5},
+		{-37.854731, 144.972778, 5}, {-37.852395, 144.974371, 6}, {-37.849251, 144.973916, 7}, {-37.845927, 144.972778, 8},
+		{-37.843412, 144.970844, 8}, {-37.842693, 144.968569, 8}, {-37.844310, 144.967090, 7}, {-37.846556, 144.966294, 7},
+		{-37.848353, 144.966862, 7},
+	}},
+	api.Track_MEXICO_CITY: {Length: 4304, Centreline: []Point{
+		{19.404200, -99.090700, 2238}, {19.403661, -99.097367, 2236}, {19.403302, -99.100224, 2235},
+		{19.404919, -99.100986, 2235}, {19.406176, -99.099272, 2236}, {19.406536, -99.096414, 2237},
+		{19.407614, -99.093557, 2239}, {19.407973, -99.089748, 2240}, {19.407614, -99.086890, 2240},
+		{19.406176, -99.085747, 2239}, {19.404919, -99.086890, 2239}, {19.404380, -99.088795, 2238},
+	}},
+	api.Track_MONTE_CARLO: {Length: 3337, Centreline: []Point{
+		{43.734700, 7.420600, 8}, {43.734161, 7.419108, 8}, {43.735419, 7.419854, 22}, {43.737036, 7.421346, 39},
+		{43.738114, 7.422092, 44}, {43.737754, 7.423584, 38}, {43.736497, 7.424330, 22}, {43.735239, 7.423087, 8},
+		{43.734341, 7.422092, 6}, {43.733263, 7.420103, 5}, {43.732724, 7.418362, 6}, {43.733083, 7.416870, 7},
+		{43.733981, 7.417368, 8}, {43.734431, 7.418984, 8},
+	}},
+	api.Track_MONTREAL: {Length: 4361, Centreline: []Point{
+		{45.500000, -73.522800, 13}, {45.500539, -73.525107, 13}, {45.501976, -73.526901, 12}, {45.503773, -73.525876, 12},
+		{45.506288, -73.528183, 12}, {45.508534, -73.529977, 11}, {45.509432, -73.528183, 11}, {45.507186, -73.526132, 12},
+		{45.504671, -73.524338, 12}, {45.502246, -73.522287, 13}, {45.500719, -73.521262, 13},
+	}},
+	api.Track_MONZA: {Length: 5793, Centreline: []Point{
+		{45.615600, 9.281100, 162}, {45.611108, 9.281100, 161}, {45.610030, 9.280329, 162}, {45.611827, 9.279559, 165},
+		{45.616947, 9.276220, 170}, {45.619642, 9.274422, 176}, {45.621888, 9.275706, 182}, {45.622607, 9.277889, 184},
+		{45.620631, 9.279174, 180}, {45.617846, 9.280329, 174}, {45.614702, 9.281614, 166}, {45.610569, 9.283412, 160},
+		{45.607515, 9.284439, 158}, {45.606797, 9.283026, 159}, {45.610210, 9.281871, 160},
+	}},
+	api.Track_PAUL_RICARD_LE_CASTELLET: {Length: 5842, Centreline: []Point{
+		{43.250600, 5.791700, 417}, {43.250061, 5.788617, 418}, {43.248803, 5.787013, 419}, {43.247636, 5.789233, 421},
+		{43.246288, 5.786767, 420}, {43.247186, 5.783067, 418}, {43.248354, 5.779367, 416}, {43.249253, 5.773200, 413},
+		{43.249881, 5.769500, 411}, {43.251319, 5.770733, 412}, {43.251678, 5.776900, 414}, {43.251498, 5.784300, 416},
+		{43.251408, 5.788617, 417},
+	}},
+	api.Track_SAKHIR: {Length: 5412, Centreline: []Point{
+		{26.032500, 50.510600, 11}, {26.027110, 50.510600, 8}, {26.026212, 50.511800, 8}, {26.027469, 50.513599, 10},
+		{26.029805, 50.512599, 12}, {26.031422, 50.514799, 15}, {26.029805, 50.516598, 18}, {26.031602, 50.518198, 20},
+		{26.033847, 50.516798, 17}, {26.035195, 50.514599, 14}, {26.036273, 50.512100, 12}, {26.034746, 50.511000, 11},
+	}},
+	api.Track_SHANGHAI: {Length: 5451, Centreline: []Point{
+		{31.338900, 121.219700, 5}, {31.338181, 121.221804, 5}, {31.336654, 121.222329, 5}, {31.336025, 121.220752, 5},
+		{31.337553, 121.220226, 5}, {31.337553, 121.217071, 5}, {31.335307, 121.214441, 5}, {31.333510, 121.212338, 5},
+		{31.337553, 121.212864, 5}, {31.340247, 121.215493, 5}, {31.343841, 121.210234, 5}, {31.344470, 121.212338, 5},
+		{31.341595, 121.217596, 5}, {31.340697, 121.219700, 5},
+	}},
+	api.Track_SILVERSTONE: {Length: 5891, Centreline: []Point{
+		{52.078600, -1.016900, 153}, {52.078061, -1.013246, 152}, {52.079678, -1.010761, 151}, {52.082193, -1.007838, 153},
+		{52.084439, -1.009299, 156}, {52.085427, -1.012807, 157}, {52.087583, -1.014707, 157}, {52.088481, -1.019093, 156},
+		{52.086685, -1.022747, 155}, {52.083990, -1.024208, 154}, {52.081295, -1.027132, 153}, {52.079049, -1.024939, 153},
+		{52.077702, -1.021285, 153}, {52.077881, -1.018362, 153},
+	}},
+	api.Track_SOCHI: {Length: 5848, Centreline: []Point{
+		{43.405700, 39.957800, 4}, {43.404353, 39.962128, 4}, {43.403005, 39.964601, 4}, {43.404622, 39.966455, 5},
+		{43.407047, 39.968928, 6}, {43.408844, 39.966455, 6}, {43.409742, 39.962746, 5}, {43.410192, 39.959036, 5},
+		{43.410641, 39.954709, 5}, {43.409293, 39.952854, 4}, {43.407497, 39.953472, 4}, {43.406239, 39.955945, 4},
+	}},
+	api.Track_SPA_FRANCORCHAMPS: {Length: 7004, Centreline: []Point{
+		{50.437200, 5.971400, 410}, {50.438817, 5.970272, 402}, {50.439895, 5.970836, 412}, {50.442590, 5.973516, 455},
+		{50.445285, 5.977042, 470}, {50.444386, 5.979862, 460}, {50.441692, 5.982683, 440}, {50.438098, 5.988325, 405},
+		{50.432708, 5.992556, 385}, {50.429115, 5.991145, 375}, {50.427319, 5.985504, 380}, {50.429115, 5.979862, 395},
+		{50.431810, 5.975631, 405}, {50.434505, 5.972810, 410},
+	}},
+	api.Track_SPIELBERG_RED_BULL_RING: {Length: 4318, Centreline: []Point{
+		{47.219700, 14.764700, 677}, {47.221047, 14.761393, 690}, {47.222395, 14.756764, 716}, {47.223742, 14.757426, 718},
+		{47.221497, 14.762716, 700}, {47.219251, 14.769329, 682}, {47.217903, 14.772636, 672}, {47.216556, 14.770652, 668},
+		{47.217005, 14.767345, 669}, {47.218353, 14.768007, 673}, {47.218981, 14.766023, 676},
+	}},
+	api.Track_SUZUKA: {Length: 5807, Centreline: []Point{
+		{34.843100, 136.541000, 44}, {34.843819, 136.543736, 43}, {34.845795, 136.544831, 50}, {34.847142, 136.542642, 55},
+		{34.846244, 136.539905, 58}, {34.844897, 136.537716, 52}, {34.844447, 136.534980, 46}, {34.843549, 136.532244, 40},
+		{34.842202, 136.530055, 37}, {34.840405, 136.531149, 45}, {34.841303, 136.536622, 60}, {34.842202, 136.541000, 68},
+		{34.843549, 136.545378, 70}, {34.842381, 136.547567, 62}, {34.841303, 136.544831, 50}, {34.842022, 136.542313, 46},
+	}},
+	api.Track_YAS_MARINA: {Length: 5554, Centreline: []Point{
+		{24.467200, 54.603100, 5}, {24.468997, 54.601620, 5}, {24.470793, 54.602607, 5}, {24.472590, 54.605074, 5},
+		{24.476183, 54.606061, 5}, {24.477531, 54.607541, 5}, {24.475285, 54.608528, 5}, {24.471692, 54.607048, 5},
+		{24.468997, 54.607541, 5}, {24.466302, 54.606061, 5}, {24.464954, 54.604087, 5}, {24.465853, 54.602607, 5},
+	}},
+}
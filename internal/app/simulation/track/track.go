@@ -0,0 +1,144 @@
+// Package track is the catalogue of track geometries used to position simulated cars: the lap
+// length of each track and a centreline polyline with elevation.
+package track
+
+import (
+	"math"
+	"sort"
+
+	"github.com/bburch01/FOTAAS/api"
+)
+
+// SectorCount is the number of timing sectors of a lap.
+const SectorCount = 3
+
+// earthRadius is the mean radius of the earth in meters.
+const earthRadius = 6371008.8
+
+// Point is a position on a track centreline.
+type Point struct {
+	Latitude  float64
+	Longitude float64
+	// Elevation is meters above sea level.
+	Elevation float64
+}
+
+// Geometry is the geometry of a track. The centreline is a closed polyline that starts at the start
+// line and runs in the racing direction, the last point joins back to the first.
+type Geometry struct {
+	// Length is the official lap length in meters.
+	Length     float64
+	Centreline []Point
+	// cumulative is the distance along the centreline to each point, and to the start line again at
+	// the end.
+	cumulative []float64
+}
+
+// Lookup returns the geometry of t.
+func Lookup(t api.Track) (*Geometry, bool) {
+	g, ok := catalogue[t]
+	return g, ok
+}
+
+func init() {
+	for _, g := range catalogue {
+		g.cumulative = make([]float64, len(g.Centreline)+1)
+		for i := range g.Centreline {
+			next := g.Centreline[(i+1)%len(g.Centreline)]
+			g.cumulative[i+1] = g.cumulative[i] + distance(g.Centreline[i], next)
+		}
+	}
+}
+
+// LapPosition returns the lap (from 1), the sector (from 1) and the distance in meters into the lap
+// of a car that has covered distance meters since crossing the start line for the first time. The
+// timing sectors are taken to be equal thirds of the lap.
+func (g *Geometry) LapPosition(distance float64) (int32, int32, float64) {
+
+	if distance < 0 {
+		return 0, 0, 0
+	}
+
+	laps := math.Floor(distance / g.Length)
+	lapDistance := distance - laps*g.Length
+	sector := int32(lapDistance/(g.Length/SectorCount)) + 1
+	if sector > SectorCount {
+		sector = SectorCount
+	}
+
+	return int32(laps) + 1, sector, lapDistance
+}
+
+// Position returns the position on the centreline lapDistance meters into the lap. The centreline
+// is coarser than the track, so a lap distance is mapped to the same fraction of the centreline.
+func (g *Geometry) Position(lapDistance float64) Point {
+
+	perimeter := g.cumulative[len(g.cumulative)-1]
+	d := math.Mod(lapDistance/g.Length, 1) * perimeter
+	if d < 0 {
+		d += perimeter
+	}
+
+	// The segment from point i-1 to point i contains d.
+	i := sort.SearchFloat64s(g.cumulative, d)
+	switch {
+	case i == 0:
+		return g.Centreline[0]
+	case i >= len(g.cumulative):
+		i = len(g.cumulative) - 1
+	}
+
+	from := g.Centreline[i-1]
+	to := g.Centreline[i%len(g.Centreline)]
+	f := (d - g.cumulative[i-1]) / (g.cumulative[i] - g.cumulative[i-1])
+
+	return Point{Latitude: from.Latitude + (to.Latitude-from.Latitude)*f,
+		Longitude: from.Longitude + (to.Longitude-from.Longitude)*f,
+		Elevation: from.Elevation + (to.Elevation-from.Elevation)*f}
+}
+
+// Corner is a change of direction of the centreline.
+type Corner struct {
+	// LapDistance is the distance in meters into the lap of the centreline point where the
+	// direction changes.
+	LapDistance float64
+	// Angle is the change of direction in radians, positive for a left hand corner.
+	Angle float64
+}
+
+// Corners returns a corner for each centreline point, in lap distance order. The centreline is
+// coarse, so a corner stands for all of the changes of direction around its point.
+func (g *Geometry) Corners() []Corner {
+
+	n := len(g.Centreline)
+	perimeter := g.cumulative[n]
+	corners := make([]Corner, n)
+	for i, p := range g.Centreline {
+		prev := g.Centreline[(i+n-1)%n]
+		next := g.Centreline[(i+1)%n]
+		angle := heading(p, next) - heading(prev, p)
+		if angle > math.Pi {
+			angle -= 2 * math.Pi
+		} else if angle <= -math.Pi {
+			angle += 2 * math.Pi
+		}
+		corners[i] = Corner{LapDistance: g.cumulative[i] / perimeter * g.Length, Angle: angle}
+	}
+
+	return corners
+}
+
+// heading returns the direction from a to b in radians, counterclockwise from east.
+func heading(a, b Point) float64 {
+	lat := (a.Latitude + b.Latitude) / 2 * math.Pi / 180
+	return math.Atan2(b.Latitude-a.Latitude, (b.Longitude-a.Longitude)*math.Cos(lat))
+}
+
+// distance returns the distance in meters between a and b, using an equirectangular projection
+// which is accurate over the extent of a track.
+func distance(a, b Point) float64 {
+	lat := (a.Latitude + b.Latitude) / 2 * math.Pi / 180
+	x := (b.Longitude - a.Longitude) * math.Pi / 180 * math.Cos(lat)
+	y := (b.Latitude - a.Latitude) * math.Pi / 180
+	return math.Hypot(x, y) * earthRadius
+}
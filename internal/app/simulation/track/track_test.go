@@ -0,0 +1,107 @@
+package track
+
+import (
+	"math"
+	"testing"
+
+	"github.com/bburch01/FOTAAS/api"
+)
+
+func TestCatalogue(t *testing.T) {
+
+	for k := range api.Track_name {
+		g, ok := Lookup(api.Track(k))
+		if !ok {
+			t.Errorf("no track geometry for track %v", api.Track(k))
+			continue
+		}
+		if len(g.Centreline) < 3 {
+			t.Errorf("track %v centreline has %v points, expected at least 3", api.Track(k), len(g.Centreline))
+		}
+		// The official lap length and the length of the coarse centreline should roughly agree.
+		perimeter := g.cumulative[len(g.cumulative)-1]
+		if perimeter < g.Length/2 || perimeter > g.Length*2 {
+			t.Errorf("track %v centreline is %.0f m long, expected roughly %.0f m", api.Track(k), perimeter, g.Length)
+		}
+	}
+}
+
+func TestLapPosition(t *testing.T) {
+
+	g, _ := Lookup(api.Track_MONZA)
+
+	// Monza is 5793 m, the sectors are 1931 m each.
+	for _, v := range []struct {
+		distance    float64
+		lap         int32
+		sector      int32
+		lapDistance float64
+	}{
+		{0, 1, 1, 0},
+		{1930, 1, 1, 1930},
+		{1931, 1, 2, 1931},
+		{5792, 1, 3, 5792},
+		{5793, 2, 1, 0},
+		{5793*11 + 4000, 12, 3, 4000},
+	} {
+		lap, sector, lapDistance := g.LapPosition(v.distance)
+		if lap != v.lap || sector != v.sector || math.Abs(lapDistance-v.lapDistance) > 1e-6 {
+			t.Errorf("distance %v: got lap %v sector %v lap distance %v, want %v, %v and %v", v.distance, lap, sector,
+				lapDistance, v.lap, v.sector, v.lapDistance)
+		}
+	}
+}
+
+func TestPosition(t *testing.T) {
+
+	g, _ := Lookup(api.Track_SILVERSTONE)
+
+	if p := g.Position(0); p != g.Centreline[0] {
+		t.Errorf("expected the start line %+v at lap distance 0, got %+v", g.Centreline[0], p)
+	}
+
+	// The end of the lap joins back to the start line.
+	if p := g.Position(g.Length - 1e-9); distance(p, g.Centreline[0]) > 1 {
+		t.Errorf("expected the start line %+v at the end of the lap, got %+v", g.Centreline[0], p)
+	}
+
+	// Consecutive positions 10 m apart along the lap stay close together and within the track's
+	// extent.
+	prev := g.Position(0)
+	for d := 10.0; d < g.Length; d += 10 {
+		p := g.Position(d)
+		if step := distance(prev, p); step > 50 {
+			t.Fatalf("position jumped %.0f m between lap distance %v and %v", step, d-10, d)
+		}
+		if distance(p, g.Centreline[0]) > g.Length/2 {
+			t.Fatalf("position %+v at lap distance %v is off the track", p, d)
+		}
+		prev = p
+	}
+}
+
+func TestCorners(t *testing.T) {
+
+	for k := range api.Track_name {
+		g, _ := Lookup(api.Track(k))
+		corners := g.Corners()
+		if len(corners) != len(g.Centreline) {
+			t.Fatalf("track %v has %v corners, expected %v", api.Track(k), len(corners), len(g.Centreline))
+		}
+		var turned, prev float64
+		for i, c := range corners {
+			if c.LapDistance < prev || c.LapDistance >= g.Length || (i > 0 && c.LapDistance == prev) {
+				t.Fatalf("track %v corner %v lap distance %v out of order", api.Track(k), i, c.LapDistance)
+			}
+			if c.Angle <= -math.Pi || c.Angle > math.Pi {
+				t.Fatalf("track %v corner %v angle %v out of range", api.Track(k), i, c.Angle)
+			}
+			turned += c.Angle
+			prev = c.LapDistance
+		}
+		// A lap of a closed circuit turns through a whole number of turns.
+		if turns := turned / (2 * math.Pi); math.Abs(turns-math.Round(turns)) > 1e-9 {
+			t.Errorf("track %v lap turns through %v turns", api.Track(k), turns)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"database/sql"
+	"fmt"
 	"log"
 	"os"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"go.uber.org/zap"
 
 	logging "github.com/bburch01/FOTAAS/internal/pkg/logging"
+	"github.com/bburch01/FOTAAS/internal/pkg/migrate"
 )
 
 var db *sql.DB
@@ -40,7 +42,22 @@ func init() {
 
 }
 
+// InitDB opens the status service database and applies its pending schema migrations.
 func InitDB() error {
+
+	if err := OpenDB(); err != nil {
+		return err
+	}
+
+	applied, err := MigrateDB(false)
+	for _, m := range applied {
+		logger.Info(fmt.Sprintf("applied status schema migration %v: %v", m.Version, m.Description))
+	}
+	return err
+}
+
+// OpenDB opens the status service database without migrating it.
+func OpenDB() error {
 	dbDriver := os.Getenv("DB_DRIVER")
 	dbHost := os.Getenv("DB_HOST")
 	dbUser := os.Getenv("DB_USER")
@@ -69,3 +86,12 @@ func PingDB() error {
 	}
 	return nil
 }
+
+// MigrateDB applies the pending schema migrations of the status service database and returns them.
+// With dryRun set nothing is applied and the pending migrations are returned.
+func MigrateDB(dryRun bool) ([]migrate.Migration, error) {
+	if dryRun {
+		return migrate.Pending(db, migrations)
+	}
+	return migrate.Apply(db, migrations)
+}
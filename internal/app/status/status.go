@@ -304,8 +304,13 @@ func PollForSimulationComplete(simID string, simDurationInMinutes int32) api.Tes
 
 		switch resp.Details.Code {
 		case api.ResponseCode_OK:
-			if resp.SimulationInfo.State == api.SimulationState_COMPLETED {
+			switch resp.SimulationInfo.State {
+			case api.SimulationState_COMPLETED:
 				return api.TestResult_PASS
+			case api.SimulationState_FAILED, api.SimulationState_FAILED_TO_START, api.SimulationState_CANCELLED:
+				logger.Error(fmt.Sprintf("poll for simulation complete test failed, simulation ended in state %v with message: %v",
+					resp.SimulationInfo.State, resp.SimulationInfo.FinalStatusMessage))
+				return api.TestResult_FAIL
 			}
 		case api.ResponseCode_ERROR:
 			logger.Error(fmt.Sprintf("poll for simulation complete test failed with simulation service message: %v", resp.Details.Message))
@@ -0,0 +1,250 @@
+// Package alarm evaluates telemetry datum values against the telemetry service's alarm thresholds.
+package alarm
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/bburch01/FOTAAS/api"
+)
+
+// Mode determines what the telemetry service does with the alarm flags of ingested telemetry data.
+type Mode int
+
+const (
+	// Off stores the sender's alarm flags as is.
+	Off Mode = iota
+	// Verify stores the sender's alarm flags but reports the datum whose flags disagree with the
+	// server's evaluation.
+	Verify
+	// Compute replaces the sender's alarm flags with the server's evaluation and reports the datum
+	// whose flags disagreed.
+	Compute
+)
+
+func (m Mode) String() string {
+	return [...]string{"off", "verify", "compute"}[m]
+}
+
+// ModeForString returns the Mode named by s (case insensitive).
+func ModeForString(s string) (Mode, error) {
+	switch strings.ToLower(s) {
+	case "off":
+		return Off, nil
+	case "verify":
+		return Verify, nil
+	case "compute":
+		return Compute, nil
+	default:
+		return Off, fmt.Errorf("invalid alarm mode %v, valid modes are: off, verify, compute", s)
+	}
+}
+
+// Threshold is the alarm threshold of a telemetry datum description. A value at or above High
+// raises a high alarm and a value at or below Low raises a low alarm. A threshold only applies
+// when its Has flag is set.
+type Threshold struct {
+	HasHigh bool
+	High    float64
+	HasLow  bool
+	Low     float64
+}
+
+// ThresholdFromProto returns the Threshold of an alarm threshold registry entry.
+func ThresholdFromProto(t *api.AlarmThreshold) Threshold {
+	return Threshold{HasHigh: t.HighAlarmEnabled, High: t.HighAlarmValue, HasLow: t.LowAlarmEnabled, Low: t.LowAlarmValue}
+}
+
+// DefaultThresholds are the alarm levels used by the simulation data generator before the alarm
+// threshold registry existed. They seed an empty registry.
+var DefaultThresholds = map[api.TelemetryDatumDescription]Threshold{
+	api.TelemetryDatumDescription_BRAKE_TEMP_FL:        {HasHigh: true, High: 1300.0},
+	api.TelemetryDatumDescription_BRAKE_TEMP_FR:        {HasHigh: true, High: 1300.0},
+	api.TelemetryDatumDescription_BRAKE_TEMP_RL:        {HasHigh: true, High: 1300.0},
+	api.TelemetryDatumDescription_BRAKE_TEMP_RR:        {HasHigh: true, High: 1300.0},
+	api.TelemetryDatumDescription_ENERGY_STORAGE_LEVEL: {HasHigh: true, High: 4.0, HasLow: true, Low: 0.0},
+	api.TelemetryDatumDescription_ENERGY_STORAGE_TEMP:  {HasHigh: true, High: 60.0},
+	api.TelemetryDatumDescription_ENGINE_COOLANT_TEMP:  {HasHigh: true, High: 140.0},
+	api.TelemetryDatumDescription_ENGINE_OIL_PRESSURE:  {HasHigh: true, High: 550.0, HasLow: true, Low: 40.0},
+	api.TelemetryDatumDescription_ENGINE_OIL_TEMP:      {HasHigh: true, High: 140.0},
+	api.TelemetryDatumDescription_ENGINE_RPM:           {HasHigh: true, High: 15000.0},
+	api.TelemetryDatumDescription_FUEL_CONSUMED:        {HasHigh: true, High: 125.0},
+	api.TelemetryDatumDescription_FUEL_FLOW:            {HasHigh: true, High: 100.0},
+	api.TelemetryDatumDescription_G_FORCE:              {HasHigh: true, High: 8.0},
+	api.TelemetryDatumDescription_MGUH_OUTPUT:          {HasHigh: true, High: 25.0, HasLow: true, Low: 0.0},
+	api.TelemetryDatumDescription_MGUK_OUTPUT:          {HasHigh: true, High: 25.0, HasLow: true, Low: 0.0},
+	api.TelemetryDatumDescription_SPEED:                {HasHigh: true, High: 400.0},
+	api.TelemetryDatumDescription_TIRE_PRESSURE_FL:     {HasHigh: true, High: 1.6, HasLow: true, Low: 0.8},
+	api.TelemetryDatumDescription_TIRE_PRESSURE_FR:     {HasHigh: true, High: 1.6, HasLow: true, Low: 0.8},
+	api.TelemetryDatumDescription_TIRE_PRESSURE_RL:     {HasHigh: true, High: 1.6, HasLow: true, Low: 0.8},
+	api.TelemetryDatumDescription_TIRE_PRESSURE_RR:     {HasHigh: true, High: 1.6, HasLow: true, Low: 0.8},
+	api.TelemetryDatumDescription_TIRE_TEMP_FL:         {HasHigh: true, High: 130.0, HasLow: true, Low: 70.0},
+	api.TelemetryDatumDescription_TIRE_TEMP_FR:         {HasHigh: true, High: 130.0, HasLow: true, Low: 70.0},
+	api.TelemetryDatumDescription_TIRE_TEMP_RL:         {HasHigh: true, High: 130.0, HasLow: true, Low: 70.0},
+	api.TelemetryDatumDescription_TIRE_TEMP_RR:         {HasHigh: true, High: 130.0, HasLow: true, Low: 70.0},
+}
+
+// DefaultAlarmThresholds returns DefaultThresholds as registry entries without overrides (and
+// without uuids, those are assigned by the registry).
+func DefaultAlarmThresholds() []*api.AlarmThreshold {
+	thresholds := make([]*api.AlarmThreshold, 0, len(DefaultThresholds))
+	for k, v := range DefaultThresholds {
+		thresholds = append(thresholds, &api.AlarmThreshold{DatumDescription: k, OverrideBy: &api.AlarmThreshold_OverrideBy{},
+			HighAlarmEnabled: v.HasHigh, HighAlarmValue: v.High, LowAlarmEnabled: v.HasLow, LowAlarmValue: v.Low})
+	}
+	return thresholds
+}
+
+// Specificity ranks an alarm threshold registry entry, the most specific entry that applies to a
+// datum wins. A car number override outranks a constructor override which outranks a track
+// override, an entry without overrides is the default for its description.
+func Specificity(t *api.AlarmThreshold) int {
+	var s int
+	if t.OverrideBy == nil {
+		return s
+	}
+	if t.OverrideBy.CarNumber {
+		s += 4
+	}
+	if t.OverrideBy.Constructor {
+		s += 2
+	}
+	if t.OverrideBy.Track {
+		s++
+	}
+	return s
+}
+
+// Applies reports whether the alarm threshold registry entry t applies to the datum of a car.
+func Applies(t *api.AlarmThreshold, constructor api.Constructor, carNumber int32, track api.Track) bool {
+	if t.OverrideBy == nil {
+		return true
+	}
+	if t.OverrideBy.Constructor && t.Constructor != constructor {
+		return false
+	}
+	if t.OverrideBy.CarNumber && t.CarNumber != carNumber {
+		return false
+	}
+	if t.OverrideBy.Track && t.Track != track {
+		return false
+	}
+	return true
+}
+
+// Table resolves thresholds from a set of alarm threshold registry entries. A Table is immutable.
+type Table struct {
+	entries map[api.TelemetryDatumDescription][]*api.AlarmThreshold
+}
+
+// NewTable creates a table from the alarm threshold registry entries in thresholds.
+func NewTable(thresholds []*api.AlarmThreshold) *Table {
+	t := &Table{entries: make(map[api.TelemetryDatumDescription][]*api.AlarmThreshold)}
+	for _, v := range thresholds {
+		t.entries[v.DatumDescription] = append(t.entries[v.DatumDescription], v)
+	}
+	return t
+}
+
+// Resolve returns the threshold of the most specific entry for description that applies to the
+// car, and false if there is none.
+func (t *Table) Resolve(description api.TelemetryDatumDescription, constructor api.Constructor, carNumber int32,
+	track api.Track) (Threshold, bool) {
+
+	var resolved *api.AlarmThreshold
+	for _, v := range t.entries[description] {
+		if !Applies(v, constructor, carNumber, track) {
+			continue
+		}
+		if resolved == nil || Specificity(v) > Specificity(resolved) {
+			resolved = v
+		}
+	}
+
+	if resolved == nil {
+		return Threshold{}, false
+	}
+	return ThresholdFromProto(resolved), true
+}
+
+// Evaluator evaluates telemetry datum against the alarm threshold registry. It is safe for
+// concurrent use and its table can be replaced while in use.
+type Evaluator struct {
+	mu    sync.RWMutex
+	mode  Mode
+	table *Table
+}
+
+// NewEvaluator creates an evaluator for the alarm threshold registry entries in thresholds.
+func NewEvaluator(mode Mode, thresholds []*api.AlarmThreshold) *Evaluator {
+	e := &Evaluator{mode: mode}
+	e.SetThresholds(thresholds)
+	return e
+}
+
+// Mode returns the evaluator's mode.
+func (e *Evaluator) Mode() Mode {
+	return e.mode
+}
+
+// SetThresholds replaces the evaluator's table with one built from thresholds.
+func (e *Evaluator) SetThresholds(thresholds []*api.AlarmThreshold) {
+
+	table := NewTable(thresholds)
+
+	e.mu.Lock()
+	e.table = table
+	e.mu.Unlock()
+}
+
+// Evaluate returns the server's high and low alarm flags for datum. A datum without an applicable
+// threshold never alarms, nor does a datum of a registered channel, alarm thresholds are by datum
+// description.
+func (e *Evaluator) Evaluate(datum *api.TelemetryDatum) (high bool, low bool) {
+
+	if datum.Channel != "" {
+		return false, false
+	}
+
+	e.mu.RLock()
+	table := e.table
+	e.mu.RUnlock()
+
+	t, ok := table.Resolve(datum.Description, datum.Constructor, datum.CarNumber, datum.Track)
+	if !ok {
+		return false, false
+	}
+
+	return t.HasHigh && datum.Value >= t.High, t.HasLow && datum.Value <= t.Low
+}
+
+// Apply evaluates datum according to the evaluator's mode. In Compute mode the datum's alarm flags
+// are replaced with the server's evaluation. It returns a description of the disagreement when the
+// sender's flags differ from the server's evaluation, or an empty string when they agree (or the
+// mode is Off).
+func (e *Evaluator) Apply(datum *api.TelemetryDatum) string {
+
+	if e.mode == Off {
+		return ""
+	}
+
+	high, low := e.Evaluate(datum)
+	if high == datum.HighAlarm && low == datum.LowAlarm {
+		return ""
+	}
+
+	name := datum.Description.String()
+	if datum.Channel != "" {
+		name = datum.Channel
+	}
+	mismatch := fmt.Sprintf("sender alarm flags (high: %v low: %v) disagree with the telemetry service evaluation (high: %v low: %v) of %v %v",
+		datum.HighAlarm, datum.LowAlarm, high, low, name, datum.Value)
+
+	if e.mode == Compute {
+		datum.HighAlarm = high
+		datum.LowAlarm = low
+	}
+
+	return mismatch
+}
@@ -0,0 +1,108 @@
+package alarm
+
+import (
+	"testing"
+
+	"github.com/bburch01/FOTAAS/api"
+)
+
+func TestEvaluate(t *testing.T) {
+
+	e := NewEvaluator(Verify, DefaultAlarmThresholds())
+
+	cases := []struct {
+		desc  api.TelemetryDatumDescription
+		value float64
+		high  bool
+		low   bool
+	}{
+		{api.TelemetryDatumDescription_SPEED, 350.0, false, false},
+		{api.TelemetryDatumDescription_SPEED, 400.0, true, false},
+		{api.TelemetryDatumDescription_TIRE_PRESSURE_FL, 0.8, false, true},
+		{api.TelemetryDatumDescription_TIRE_PRESSURE_FL, 1.2, false, false},
+		{api.TelemetryDatumDescription_TIRE_PRESSURE_FL, 1.7, true, false},
+		// No low threshold, so 0 never raises a low alarm.
+		{api.TelemetryDatumDescription_BRAKE_TEMP_FL, 0.0, false, false},
+		// No thresholds at all.
+		{api.TelemetryDatumDescription_G_FORCE_DIRECTION, 100.0, false, false},
+	}
+
+	for i, c := range cases {
+		high, low := e.Evaluate(&api.TelemetryDatum{Description: c.desc, Value: c.value})
+		if high != c.high || low != c.low {
+			t.Errorf("case %v: expected high: %v low: %v, got high: %v low: %v", i, c.high, c.low, high, low)
+		}
+	}
+}
+
+func TestResolve(t *testing.T) {
+
+	brakeTemp := api.TelemetryDatumDescription_BRAKE_TEMP_FL
+	table := NewTable([]*api.AlarmThreshold{
+		{DatumDescription: brakeTemp, HighAlarmEnabled: true, HighAlarmValue: 1300.0},
+		{DatumDescription: brakeTemp, OverrideBy: &api.AlarmThreshold_OverrideBy{Track: true},
+			Track: api.Track_MONTE_CARLO, HighAlarmEnabled: true, HighAlarmValue: 1200.0},
+		{DatumDescription: brakeTemp, OverrideBy: &api.AlarmThreshold_OverrideBy{Constructor: true},
+			Constructor: api.Constructor_FERRARI, HighAlarmEnabled: true, HighAlarmValue: 1250.0},
+		{DatumDescription: brakeTemp, OverrideBy: &api.AlarmThreshold_OverrideBy{Constructor: true, CarNumber: true},
+			Constructor: api.Constructor_FERRARI, CarNumber: 16, HighAlarmEnabled: true, HighAlarmValue: 1350.0},
+	})
+
+	cases := []struct {
+		constructor api.Constructor
+		carNumber   int32
+		track       api.Track
+		high        float64
+	}{
+		{api.Constructor_MERCEDES, 44, api.Track_MONZA, 1300.0},
+		{api.Constructor_MERCEDES, 44, api.Track_MONTE_CARLO, 1200.0},
+		{api.Constructor_FERRARI, 5, api.Track_MONTE_CARLO, 1250.0},
+		{api.Constructor_FERRARI, 16, api.Track_MONTE_CARLO, 1350.0},
+	}
+
+	for i, c := range cases {
+		threshold, ok := table.Resolve(brakeTemp, c.constructor, c.carNumber, c.track)
+		if !ok || threshold.High != c.high {
+			t.Errorf("case %v: expected high alarm value %v, got %v", i, c.high, threshold.High)
+		}
+	}
+
+	if _, ok := table.Resolve(api.TelemetryDatumDescription_SPEED, api.Constructor_FERRARI, 16, api.Track_MONZA); ok {
+		t.Error("expected no threshold for a description without registry entries")
+	}
+}
+
+func TestApply(t *testing.T) {
+
+	datum := api.TelemetryDatum{Description: api.TelemetryDatumDescription_SPEED, Value: 410.0}
+
+	off := NewEvaluator(Off, DefaultAlarmThresholds())
+	if mismatch := off.Apply(&datum); mismatch != "" || datum.HighAlarm {
+		t.Errorf("expected off mode to leave the datum alone, got %v", mismatch)
+	}
+
+	verify := NewEvaluator(Verify, DefaultAlarmThresholds())
+	if mismatch := verify.Apply(&datum); mismatch == "" || datum.HighAlarm {
+		t.Error("expected verify mode to report the mismatch without changing the alarm flags")
+	}
+
+	compute := NewEvaluator(Compute, DefaultAlarmThresholds())
+	if mismatch := compute.Apply(&datum); mismatch == "" || !datum.HighAlarm {
+		t.Error("expected compute mode to report the mismatch and set the high alarm flag")
+	}
+	if mismatch := compute.Apply(&datum); mismatch != "" {
+		t.Errorf("expected no mismatch once the flags agree, got %v", mismatch)
+	}
+}
+
+func TestModeForString(t *testing.T) {
+
+	for _, m := range []Mode{Off, Verify, Compute} {
+		if mode, err := ModeForString(m.String()); err != nil || mode != m {
+			t.Errorf("failed to round trip alarm mode %v", m)
+		}
+	}
+	if _, err := ModeForString("sometimes"); err == nil {
+		t.Error("expected an error for an invalid alarm mode")
+	}
+}
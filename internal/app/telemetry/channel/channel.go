@@ -0,0 +1,216 @@
+// Package channel is the telemetry service's channel registry: the telemetry channels that can be
+// ingested, each with its unit, valid range and category. Every TelemetryDatumDescription is a built
+// in channel of the same name, further channels are registered at runtime without an api change.
+package channel
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/bburch01/FOTAAS/api"
+)
+
+const (
+	// MaxNameLength and MaxUnitLength are the sizes of the telemetry_datum description and unit
+	// columns.
+	MaxNameLength = 64
+	MaxUnitLength = 32
+)
+
+// namePattern is the form of a channel name, the same as the TelemetryDatumDescription names.
+var namePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+
+// builtIn is the unit and category of each TelemetryDatumDescription.
+var builtIn = map[api.TelemetryDatumDescription]struct {
+	unit     api.TelemetryDatumUnit
+	category api.ChannelCategory
+}{
+	api.TelemetryDatumDescription_G_FORCE:              {api.TelemetryDatumUnit_G, api.ChannelCategory_CHASSIS},
+	api.TelemetryDatumDescription_G_FORCE_DIRECTION:    {api.TelemetryDatumUnit_RADIAN, api.ChannelCategory_CHASSIS},
+	api.TelemetryDatumDescription_SPEED:                {api.TelemetryDatumUnit_KPH, api.ChannelCategory_CHASSIS},
+	api.TelemetryDatumDescription_FUEL_CONSUMED:        {api.TelemetryDatumUnit_KG, api.ChannelCategory_FUEL},
+	api.TelemetryDatumDescription_FUEL_FLOW:            {api.TelemetryDatumUnit_KG_PER_HOUR, api.ChannelCategory_FUEL},
+	api.TelemetryDatumDescription_ENGINE_COOLANT_TEMP:  {api.TelemetryDatumUnit_DEGREE_CELCIUS, api.ChannelCategory_POWER_UNIT},
+	api.TelemetryDatumDescription_ENGINE_OIL_PRESSURE:  {api.TelemetryDatumUnit_KPA, api.ChannelCategory_POWER_UNIT},
+	api.TelemetryDatumDescription_ENGINE_OIL_TEMP:      {api.TelemetryDatumUnit_DEGREE_CELCIUS, api.ChannelCategory_POWER_UNIT},
+	api.TelemetryDatumDescription_ENGINE_RPM:           {api.TelemetryDatumUnit_RPM, api.ChannelCategory_POWER_UNIT},
+	api.TelemetryDatumDescription_ENERGY_STORAGE_LEVEL: {api.TelemetryDatumUnit_MJ, api.ChannelCategory_ENERGY_RECOVERY},
+	api.TelemetryDatumDescription_ENERGY_STORAGE_TEMP:  {api.TelemetryDatumUnit_DEGREE_CELCIUS, api.ChannelCategory_ENERGY_RECOVERY},
+	api.TelemetryDatumDescription_MGUK_OUTPUT:          {api.TelemetryDatumUnit_JPS, api.ChannelCategory_ENERGY_RECOVERY},
+	api.TelemetryDatumDescription_MGUH_OUTPUT:          {api.TelemetryDatumUnit_JPS, api.ChannelCategory_ENERGY_RECOVERY},
+	api.TelemetryDatumDescription_BRAKE_TEMP_FR:        {api.TelemetryDatumUnit_DEGREE_CELCIUS, api.ChannelCategory_BRAKES},
+	api.TelemetryDatumDescription_BRAKE_TEMP_FL:        {api.TelemetryDatumUnit_DEGREE_CELCIUS, api.ChannelCategory_BRAKES},
+	api.TelemetryDatumDescription_BRAKE_TEMP_RR:        {api.TelemetryDatumUnit_DEGREE_CELCIUS, api.ChannelCategory_BRAKES},
+	api.TelemetryDatumDescription_BRAKE_TEMP_RL:        {api.TelemetryDatumUnit_DEGREE_CELCIUS, api.ChannelCategory_BRAKES},
+	api.TelemetryDatumDescription_TIRE_PRESSURE_FR:     {api.TelemetryDatumUnit_BAR, api.ChannelCategory_TIRES},
+	api.TelemetryDatumDescription_TIRE_PRESSURE_FL:     {api.TelemetryDatumUnit_BAR, api.ChannelCategory_TIRES},
+	api.TelemetryDatumDescription_TIRE_PRESSURE_RR:     {api.TelemetryDatumUnit_BAR, api.ChannelCategory_TIRES},
+	api.TelemetryDatumDescription_TIRE_PRESSURE_RL:     {api.TelemetryDatumUnit_BAR, api.ChannelCategory_TIRES},
+	api.TelemetryDatumDescription_TIRE_TEMP_FR:         {api.TelemetryDatumUnit_DEGREE_CELCIUS, api.ChannelCategory_TIRES},
+	api.TelemetryDatumDescription_TIRE_TEMP_FL:         {api.TelemetryDatumUnit_DEGREE_CELCIUS, api.ChannelCategory_TIRES},
+	api.TelemetryDatumDescription_TIRE_TEMP_RR:         {api.TelemetryDatumUnit_DEGREE_CELCIUS, api.ChannelCategory_TIRES},
+	api.TelemetryDatumDescription_TIRE_TEMP_RL:         {api.TelemetryDatumUnit_DEGREE_CELCIUS, api.ChannelCategory_TIRES},
+}
+
+// BuiltIn returns the built in channels, one per TelemetryDatumDescription. They have no valid
+// range, the alarm thresholds cover their values.
+func BuiltIn() []*api.Channel {
+	channels := make([]*api.Channel, 0, len(builtIn))
+	for k, v := range builtIn {
+		channels = append(channels, &api.Channel{Name: k.String(), Unit: v.unit.String(), Category: v.category, BuiltIn: true})
+	}
+	return channels
+}
+
+// IsBuiltIn reports whether name is the name of a built in channel.
+func IsBuiltIn(name string) bool {
+	_, ok := api.TelemetryDatumDescription_value[name]
+	return ok
+}
+
+// Validate checks that c can be registered: a name of upper case letters, digits and underscores
+// that is not a built in channel, a unit, a known category and a finite valid range.
+func Validate(c *api.Channel) error {
+
+	if c == nil {
+		return errors.New("channel is required")
+	}
+	if !namePattern.MatchString(c.Name) || len(c.Name) > MaxNameLength {
+		return fmt.Errorf("invalid channel name %v, must be at most %v upper case letters, digits and underscores",
+			c.Name, MaxNameLength)
+	}
+	if IsBuiltIn(c.Name) {
+		return fmt.Errorf("invalid channel name %v, it is a built in channel", c.Name)
+	}
+	if c.Unit == "" || len(c.Unit) > MaxUnitLength {
+		return fmt.Errorf("invalid channel unit %v, must be 1 to %v characters", c.Unit, MaxUnitLength)
+	}
+	if _, ok := api.ChannelCategory_name[int32(c.Category)]; !ok {
+		return fmt.Errorf("invalid channel category %v", c.Category)
+	}
+	for _, v := range []float64{c.MinValue, c.MaxValue} {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return fmt.Errorf("invalid channel valid range %v to %v", c.MinValue, c.MaxValue)
+		}
+	}
+
+	return nil
+}
+
+// Registry holds the built in and registered channels. It is safe for concurrent use.
+type Registry struct {
+	mu       sync.RWMutex
+	channels map[string]*api.Channel
+}
+
+// NewRegistry creates a registry of the built in channels and the registered channels.
+func NewRegistry(registered []*api.Channel) *Registry {
+	r := &Registry{channels: make(map[string]*api.Channel)}
+	for _, v := range BuiltIn() {
+		r.channels[v.Name] = v
+	}
+	for _, v := range registered {
+		r.Add(v)
+	}
+	return r
+}
+
+// Add adds the registered channel c to the registry.
+func (r *Registry) Add(c *api.Channel) {
+	r.mu.Lock()
+	r.channels[c.Name] = c
+	r.mu.Unlock()
+}
+
+// Lookup returns the channel called name.
+func (r *Registry) Lookup(name string) (*api.Channel, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	c, ok := r.channels[name]
+	return c, ok
+}
+
+// List returns the channels in categories (all of them if categories is empty) ordered by category
+// and then name.
+func (r *Registry) List(categories []api.ChannelCategory) []*api.Channel {
+
+	r.mu.RLock()
+	var channels []*api.Channel
+	for _, v := range r.channels {
+		if len(categories) > 0 && !containsCategory(categories, v.Category) {
+			continue
+		}
+		channels = append(channels, v)
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(channels, func(i, j int) bool {
+		if channels[i].Category != channels[j].Category {
+			return channels[i].Category < channels[j].Category
+		}
+		return channels[i].Name < channels[j].Name
+	})
+
+	return channels
+}
+
+func containsCategory(categories []api.ChannelCategory, category api.ChannelCategory) bool {
+	for _, v := range categories {
+		if v == category {
+			return true
+		}
+	}
+	return false
+}
+
+// Resolve checks that datum is of a channel in the registry, in the unit of that channel and with
+// a value in the channel's valid range. A datum that names a built in channel in Channel is
+// rewritten to that channel's description and unit, so that it is stored the same as a datum sent
+// with the description. The ChannelUnit of a datum of a registered channel is set to the channel's
+// unit.
+func (r *Registry) Resolve(datum *api.TelemetryDatum) error {
+
+	name := datum.Channel
+	if name == "" {
+		name = datum.Description.String()
+	}
+
+	c, ok := r.Lookup(name)
+	switch {
+	case !ok && datum.Channel == "":
+		return fmt.Errorf("invalid telemetry datum description %v", datum.Description)
+	case !ok:
+		return fmt.Errorf("unknown telemetry channel %v", name)
+	}
+
+	if c.BuiltIn {
+		unit := api.TelemetryDatumUnit(api.TelemetryDatumUnit_value[c.Unit])
+		if datum.Channel != "" {
+			datum.Description = api.TelemetryDatumDescription(api.TelemetryDatumDescription_value[c.Name])
+			datum.Unit = unit
+			datum.Channel = ""
+			datum.ChannelUnit = ""
+		} else if datum.Unit != unit {
+			return fmt.Errorf("invalid telemetry datum unit for %v, expected TelemetryDatumUnit_%v got %v",
+				name, c.Unit, datum.Unit)
+		}
+	} else {
+		if datum.ChannelUnit != "" && datum.ChannelUnit != c.Unit {
+			return fmt.Errorf("invalid telemetry datum unit for %v, expected %v got %v", name, c.Unit, datum.ChannelUnit)
+		}
+		datum.ChannelUnit = c.Unit
+		datum.Description = 0
+		datum.Unit = 0
+	}
+
+	if c.MaxValue > c.MinValue && (datum.Value < c.MinValue || datum.Value > c.MaxValue) {
+		return fmt.Errorf("telemetry datum value %v is outside of the valid range of %v, %v to %v", datum.Value,
+			name, c.MinValue, c.MaxValue)
+	}
+
+	return nil
+}
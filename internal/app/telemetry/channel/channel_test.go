@@ -0,0 +1,87 @@
+package channel
+
+import (
+	"math"
+	"testing"
+
+	"github.com/bburch01/FOTAAS/api"
+)
+
+func TestValidate(t *testing.T) {
+
+	valid := api.Channel{Name: "TURBO_BOOST_PRESSURE", Unit: "kPa", MaxValue: 400, Category: api.ChannelCategory_POWER_UNIT}
+	if err := Validate(&valid); err != nil {
+		t.Error("expected a valid channel, got error: ", err)
+	}
+
+	invalid := []func(c *api.Channel){
+		func(c *api.Channel) { c.Name = "turbo_boost" },
+		func(c *api.Channel) { c.Name = "SPEED" },
+		func(c *api.Channel) { c.Unit = "" },
+		func(c *api.Channel) { c.Category = 99 },
+		func(c *api.Channel) { c.MaxValue = math.Inf(1) },
+	}
+	for i, f := range invalid {
+		c := valid
+		f(&c)
+		if err := Validate(&c); err == nil {
+			t.Errorf("expected invalid channel %v to be rejected: %v", i, c)
+		}
+	}
+}
+
+func TestResolve(t *testing.T) {
+
+	r := NewRegistry([]*api.Channel{{Name: "TURBO_BOOST_PRESSURE", Unit: "kPa", MaxValue: 400,
+		Category: api.ChannelCategory_POWER_UNIT}})
+
+	// A built in channel given by name is rewritten to its description and unit.
+	datum := api.TelemetryDatum{Channel: "ENGINE_RPM", ChannelUnit: "RPM", Value: 11000}
+	if err := r.Resolve(&datum); err != nil {
+		t.Fatal("failed to resolve built in channel with error: ", err)
+	}
+	if datum.Channel != "" || datum.ChannelUnit != "" || datum.Description != api.TelemetryDatumDescription_ENGINE_RPM ||
+		datum.Unit != api.TelemetryDatumUnit_RPM {
+		t.Errorf("expected an ENGINE_RPM datum in RPM, got %v", datum)
+	}
+
+	datum = api.TelemetryDatum{Channel: "TURBO_BOOST_PRESSURE", Value: 250}
+	if err := r.Resolve(&datum); err != nil || datum.ChannelUnit != "kPa" {
+		t.Errorf("expected a TURBO_BOOST_PRESSURE datum in kPa, got %v with error: %v", datum, err)
+	}
+
+	for _, v := range []api.TelemetryDatum{
+		{Description: api.TelemetryDatumDescription_SPEED, Unit: api.TelemetryDatumUnit_RPM},
+		{Channel: "TURBO_BOOST_PRESSURE", ChannelUnit: "bar", Value: 2},
+		{Channel: "TURBO_BOOST_PRESSURE", Value: 401},
+		{Channel: "WING_ANGLE", Value: 1},
+	} {
+		v := v
+		if err := r.Resolve(&v); err == nil {
+			t.Errorf("expected datum %v to be rejected", v)
+		}
+	}
+}
+
+func TestList(t *testing.T) {
+
+	r := NewRegistry([]*api.Channel{{Name: "TURBO_BOOST_PRESSURE", Unit: "kPa", Category: api.ChannelCategory_POWER_UNIT}})
+
+	if n := len(r.List(nil)); n != len(api.TelemetryDatumDescription_name)+1 {
+		t.Errorf("expected %v channels, got %v", len(api.TelemetryDatumDescription_name)+1, n)
+	}
+
+	channels := r.List([]api.ChannelCategory{api.ChannelCategory_BRAKES, api.ChannelCategory_POWER_UNIT})
+	if len(channels) != 9 {
+		t.Fatalf("expected 9 brake and power unit channels, got %v", len(channels))
+	}
+	for i := 1; i < len(channels); i++ {
+		a, b := channels[i-1], channels[i]
+		if a.Category > b.Category || (a.Category == b.Category && a.Name > b.Name) {
+			t.Errorf("expected channels ordered by category and name, got %v before %v", a.Name, b.Name)
+		}
+	}
+	if c := channels[4]; c.Name != "TURBO_BOOST_PRESSURE" || c.BuiltIn {
+		t.Errorf("expected the registered channel last of the power unit channels, got %v", c)
+	}
+}
@@ -0,0 +1,312 @@
+// Package export encodes telemetry data as CSV, JSON Lines or Apache Parquet files, in long (one
+// row per telemetry datum) or wide (one row per car and timestamp) layout.
+package export
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	ipbts "github.com/bburch01/FOTAAS/internal/pkg/protobuf/timestamp"
+
+	"github.com/bburch01/FOTAAS/api"
+)
+
+type kind int
+
+const (
+	kindString kind = iota
+	kindInt
+	kindDouble
+	kindBool
+	kindTimestamp
+)
+
+type column struct {
+	name string
+	kind kind
+}
+
+// row holds one value per column, a nil value is missing (e.g. a wide layout description column
+// without a datum).
+type row []interface{}
+
+// sink writes rows in a file format.
+type sink interface {
+	writeRow(r row) error
+	close() error
+}
+
+// Encoder encodes telemetry data to an io.Writer.
+type Encoder struct {
+	sink   sink
+	layout api.ExportLayout
+	// channels are the names of the registered channels with a wide layout value column, sorted.
+	channels []string
+	rows     int64
+	datums   int64
+	skipped  int64
+
+	// The wide layout rows of the most recent timestamp, they are only complete once a later
+	// timestamp has been seen.
+	pending     map[wideKey]row
+	pendingTime time.Time
+}
+
+type wideKey struct {
+	simulationID string
+	constructor  api.Constructor
+	carNumber    int32
+}
+
+var longColumns = []column{
+	{"uuid", kindString},
+	{"timestamp", kindTimestamp},
+	{"simulated", kindBool},
+	{"simulation_uuid", kindString},
+	{"gran_prix", kindString},
+	{"track", kindString},
+	{"constructor", kindString},
+	{"car_number", kindInt},
+	{"description", kindString},
+	{"unit", kindString},
+	{"value", kindDouble},
+	{"latitude", kindDouble},
+	{"longitude", kindDouble},
+	{"elevation", kindDouble},
+	{"high_alarm", kindBool},
+	{"low_alarm", kindBool},
+	{"lap", kindInt},
+	{"sector", kindInt},
+	{"lap_distance", kindDouble},
+}
+
+// wideKeyColumns are the leading columns of the wide layout, followed by one value column per
+// telemetry datum description and then one per registered channel.
+var wideKeyColumns = []column{
+	{"timestamp", kindTimestamp},
+	{"simulated", kindBool},
+	{"simulation_uuid", kindString},
+	{"gran_prix", kindString},
+	{"track", kindString},
+	{"constructor", kindString},
+	{"car_number", kindInt},
+	{"latitude", kindDouble},
+	{"longitude", kindDouble},
+	{"elevation", kindDouble},
+	{"lap", kindInt},
+	{"sector", kindInt},
+	{"lap_distance", kindDouble},
+}
+
+// wideDescriptions are the telemetry datum descriptions of the wide layout value columns, in
+// column order.
+var wideDescriptions = func() []api.TelemetryDatumDescription {
+	descriptions := make([]api.TelemetryDatumDescription, 0, len(api.TelemetryDatumDescription_name))
+	for k := range api.TelemetryDatumDescription_name {
+		descriptions = append(descriptions, api.TelemetryDatumDescription(k))
+	}
+	sort.Slice(descriptions, func(i, j int) bool { return descriptions[i] < descriptions[j] })
+	return descriptions
+}()
+
+func columns(layout api.ExportLayout, channels []string) []column {
+	if layout == api.ExportLayout_LONG {
+		return longColumns
+	}
+	cols := append([]column(nil), wideKeyColumns...)
+	for _, v := range wideDescriptions {
+		cols = append(cols, column{strings.ToLower(v.String()), kindDouble})
+	}
+	// Registered channel names are never built in channel names, so the columns are unique.
+	for _, v := range channels {
+		cols = append(cols, column{strings.ToLower(v), kindDouble})
+	}
+	return cols
+}
+
+// NewEncoder creates an encoder that writes telemetry data to w in format and layout. channels are
+// the names of the registered channels that get a wide layout value column, a datum of any other
+// registered channel (e.g. one registered during the export) is skipped by the wide layout, see
+// Skipped.
+func NewEncoder(w io.Writer, format api.ExportFormat, layout api.ExportLayout, channels []string) (*Encoder, error) {
+
+	if _, ok := api.ExportLayout_name[int32(layout)]; !ok {
+		return nil, fmt.Errorf("invalid export layout %v", layout)
+	}
+
+	if layout == api.ExportLayout_LONG {
+		channels = nil
+	}
+	channels = append([]string(nil), channels...)
+	sort.Strings(channels)
+
+	cols := columns(layout, channels)
+
+	var s sink
+	var err error
+	switch format {
+	case api.ExportFormat_CSV:
+		s, err = newCSVSink(w, cols)
+	case api.ExportFormat_JSON_LINES:
+		s = newJSONLinesSink(w, cols)
+	case api.ExportFormat_PARQUET:
+		s, err = newParquetSink(w, cols)
+	default:
+		return nil, fmt.Errorf("invalid export format %v", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &Encoder{sink: s, layout: layout, channels: channels, pending: make(map[wideKey]row)}, nil
+}
+
+// Encode encodes a batch of telemetry data. The batches must be passed in timestamp order (the
+// order of the telemetry data pages), the datum within a batch can be in any order.
+func (e *Encoder) Encode(data []*api.TelemetryDatum) error {
+
+	sorted := append([]*api.TelemetryDatum(nil), data...)
+	sort.Slice(sorted, func(i, j int) bool {
+		ti, tj := sorted[i].Timestamp, sorted[j].Timestamp
+		if ti.Seconds != tj.Seconds {
+			return ti.Seconds < tj.Seconds
+		}
+		if ti.Nanos != tj.Nanos {
+			return ti.Nanos < tj.Nanos
+		}
+		return sorted[i].Uuid < sorted[j].Uuid
+	})
+
+	for _, v := range sorted {
+		t, err := ipbts.Timestamp(v.Timestamp)
+		if err != nil {
+			return err
+		}
+		if e.layout == api.ExportLayout_LONG {
+			if err = e.write(longRow(v, t)); err != nil {
+				return err
+			}
+			e.datums++
+			continue
+		}
+		if err = e.addWide(v, t); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// longRow returns the long layout row of v. The description and unit of a datum of a registered
+// channel are the channel name and unit.
+func longRow(v *api.TelemetryDatum, t time.Time) row {
+	description, unit := v.Description.String(), v.Unit.String()
+	if v.Channel != "" {
+		description, unit = v.Channel, v.ChannelUnit
+	}
+	return row{v.Uuid, t, v.Simulated, v.SimulationUuid, v.GranPrix.String(), v.Track.String(), v.Constructor.String(),
+		v.CarNumber, description, unit, v.Value, v.Latitude, v.Longitude, v.Elevation,
+		v.HighAlarm, v.LowAlarm, v.Lap, v.Sector, v.LapDistance}
+}
+
+func (e *Encoder) addWide(v *api.TelemetryDatum, t time.Time) error {
+
+	var column int
+	if v.Channel != "" {
+		i := sort.SearchStrings(e.channels, v.Channel)
+		if i == len(e.channels) || e.channels[i] != v.Channel {
+			e.skipped++
+			return nil
+		}
+		column = len(wideDescriptions) + i
+	} else {
+		i := sort.Search(len(wideDescriptions), func(i int) bool { return wideDescriptions[i] >= v.Description })
+		if i == len(wideDescriptions) || wideDescriptions[i] != v.Description {
+			return fmt.Errorf("telemetry datum %v has invalid description %v", v.Uuid, v.Description)
+		}
+		column = i
+	}
+
+	if !t.Equal(e.pendingTime) {
+		if t.Before(e.pendingTime) {
+			return fmt.Errorf("telemetry datum %v is out of timestamp order", v.Uuid)
+		}
+		if err := e.flushWide(); err != nil {
+			return err
+		}
+		e.pendingTime = t
+	}
+
+	key := wideKey{simulationID: v.SimulationUuid, constructor: v.Constructor, carNumber: v.CarNumber}
+	r, ok := e.pending[key]
+	if !ok {
+		r = make(row, len(wideKeyColumns)+len(wideDescriptions)+len(e.channels))
+		copy(r, row{t, v.Simulated, v.SimulationUuid, v.GranPrix.String(), v.Track.String(), v.Constructor.String(),
+			v.CarNumber, v.Latitude, v.Longitude, v.Elevation, v.Lap, v.Sector, v.LapDistance})
+		e.pending[key] = r
+	}
+
+	r[len(wideKeyColumns)+column] = v.Value
+	e.datums++
+
+	return nil
+}
+
+// flushWide writes the pending wide layout rows ordered by simulation, constructor and car number.
+func (e *Encoder) flushWide() error {
+
+	keys := make([]wideKey, 0, len(e.pending))
+	for k := range e.pending {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].simulationID != keys[j].simulationID {
+			return keys[i].simulationID < keys[j].simulationID
+		}
+		if keys[i].constructor != keys[j].constructor {
+			return keys[i].constructor < keys[j].constructor
+		}
+		return keys[i].carNumber < keys[j].carNumber
+	})
+
+	for _, k := range keys {
+		if err := e.write(e.pending[k]); err != nil {
+			return err
+		}
+		delete(e.pending, k)
+	}
+
+	return nil
+}
+
+func (e *Encoder) write(r row) error {
+	e.rows++
+	return e.sink.writeRow(r)
+}
+
+// Close writes the remaining rows and completes the file, the underlying io.Writer is not closed.
+func (e *Encoder) Close() error {
+	if err := e.flushWide(); err != nil {
+		return err
+	}
+	return e.sink.close()
+}
+
+// Rows returns the number of rows written.
+func (e *Encoder) Rows() int64 {
+	return e.rows
+}
+
+// Datums returns the number of telemetry datum encoded.
+func (e *Encoder) Datums() int64 {
+	return e.datums
+}
+
+// Skipped returns the number of telemetry datum of registered channels without a wide layout value
+// column, which are not encoded.
+func (e *Encoder) Skipped() int64 {
+	return e.skipped
+}
@@ -0,0 +1,245 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	ipbts "github.com/bburch01/FOTAAS/internal/pkg/protobuf/timestamp"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/source"
+
+	"github.com/bburch01/FOTAAS/api"
+)
+
+func testDatum(t *testing.T, id string, ts time.Time, carNumber int32, description api.TelemetryDatumDescription,
+	value float64) *api.TelemetryDatum {
+
+	pbts, err := ipbts.TimestampProto(ts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &api.TelemetryDatum{Uuid: id, Timestamp: pbts, Simulated: true, SimulationUuid: "sim",
+		Constructor: api.Constructor_MERCEDES, CarNumber: carNumber, Description: description, Value: value,
+		Unit: api.TelemetryDatumUnit_RPM}
+}
+
+func testData(t *testing.T) ([]*api.TelemetryDatum, []*api.TelemetryDatum) {
+	t0 := time.Date(2019, 7, 14, 13, 0, 0, 1500000, time.UTC)
+	t1 := t0.Add(time.Millisecond)
+	// Two pages, car 44 at t1 straddles the page boundary and the datum within a page are unordered.
+	page1 := []*api.TelemetryDatum{
+		testDatum(t, "d", t1, 44, api.TelemetryDatumDescription_ENGINE_RPM, 11000),
+		testDatum(t, "a", t0, 44, api.TelemetryDatumDescription_ENGINE_RPM, 10000),
+		testDatum(t, "c", t0, 77, api.TelemetryDatumDescription_ENGINE_RPM, 10500),
+		testDatum(t, "b", t0, 44, api.TelemetryDatumDescription_SPEED, 300),
+	}
+	page2 := []*api.TelemetryDatum{
+		testDatum(t, "e", t1, 44, api.TelemetryDatumDescription_SPEED, 301),
+	}
+	return page1, page2
+}
+
+func encode(t *testing.T, format api.ExportFormat, layout api.ExportLayout) (*Encoder, []byte) {
+
+	var buf bytes.Buffer
+	enc, err := NewEncoder(&buf, format, layout, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	page1, page2 := testData(t)
+	if err = enc.Encode(page1); err != nil {
+		t.Fatal(err)
+	}
+	if err = enc.Encode(page2); err != nil {
+		t.Fatal(err)
+	}
+	if err = enc.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return enc, buf.Bytes()
+}
+
+func TestCSVLong(t *testing.T) {
+
+	enc, out := encode(t, api.ExportFormat_CSV, api.ExportLayout_LONG)
+
+	records, err := csv.NewReader(bytes.NewReader(out)).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 6 || enc.Rows() != 5 || enc.Datums() != 5 {
+		t.Fatalf("got %v records, %v rows and %v datums, want 6, 5 and 5", len(records), enc.Rows(), enc.Datums())
+	}
+	if got := strings.Join(records[0][:3], ","); got != "uuid,timestamp,simulated" {
+		t.Errorf("got header %v", got)
+	}
+
+	var ids []string
+	for _, r := range records[1:] {
+		ids = append(ids, r[0])
+	}
+	if got := strings.Join(ids, ""); got != "abcde" {
+		t.Errorf("got row order %v, want abcde", got)
+	}
+	if got := records[1][1]; got != "2019-07-14T13:00:00.0015Z" {
+		t.Errorf("got timestamp %v, want sub millisecond precision", got)
+	}
+}
+
+func TestJSONLinesWide(t *testing.T) {
+
+	enc, out := encode(t, api.ExportFormat_JSON_LINES, api.ExportLayout_WIDE)
+
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	if len(lines) != 3 || enc.Rows() != 3 {
+		t.Fatalf("got %v lines and %v rows, want 3", len(lines), enc.Rows())
+	}
+
+	var rows []map[string]interface{}
+	for _, v := range lines {
+		r := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(v), &r); err != nil {
+			t.Fatal(err)
+		}
+		rows = append(rows, r)
+	}
+
+	want := []struct {
+		carNumber float64
+		rpm       float64
+		speed     interface{}
+	}{
+		{44, 10000, 300.0},
+		{77, 10500, nil},
+		{44, 11000, 301.0},
+	}
+	for i, w := range want {
+		r := rows[i]
+		if r["car_number"] != w.carNumber || r["engine_rpm"] != w.rpm || r["speed"] != w.speed {
+			t.Errorf("row %v: got car %v rpm %v speed %v, want %+v", i, r["car_number"], r["engine_rpm"], r["speed"], w)
+		}
+		if v, ok := r["fuel_flow"]; !ok || v != nil {
+			t.Errorf("row %v: got fuel_flow %v, want null", i, v)
+		}
+	}
+}
+
+func TestEncodeOutOfOrder(t *testing.T) {
+
+	var buf bytes.Buffer
+	enc, err := NewEncoder(&buf, api.ExportFormat_CSV, api.ExportLayout_WIDE, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	page1, page2 := testData(t)
+	if err = enc.Encode(page2); err != nil {
+		t.Fatal(err)
+	}
+	if err = enc.Encode(page1); err == nil {
+		t.Error("expected an error for a batch that is out of timestamp order")
+	}
+}
+
+func TestCSVWideChannels(t *testing.T) {
+
+	var buf bytes.Buffer
+	enc, err := NewEncoder(&buf, api.ExportFormat_CSV, api.ExportLayout_WIDE, []string{"TURBO_BOOST_PRESSURE"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	page1, _ := testData(t)
+	t0, _ := ipbts.Timestamp(page1[1].Timestamp)
+	boost := testDatum(t, "f", t0, 44, api.TelemetryDatumDescription_ENGINE_RPM, 250)
+	boost.Channel, boost.ChannelUnit = "TURBO_BOOST_PRESSURE", "kPa"
+	// A channel registered after the export started has no column.
+	wing := testDatum(t, "g", t0, 44, api.TelemetryDatumDescription_ENGINE_RPM, 12)
+	wing.Channel, wing.ChannelUnit = "WING_ANGLE", "degree"
+
+	if err = enc.Encode(append(page1, boost, wing)); err != nil {
+		t.Fatal(err)
+	}
+	if err = enc.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := csv.NewReader(bytes.NewReader(buf.Bytes())).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 4 || enc.Datums() != 5 || enc.Skipped() != 1 {
+		t.Fatalf("got %v records, %v datums and %v skipped, want 4, 5 and 1", len(records), enc.Datums(), enc.Skipped())
+	}
+	header := records[0]
+	if header[len(header)-1] != "turbo_boost_pressure" {
+		t.Errorf("got last column %v, want turbo_boost_pressure", header[len(header)-1])
+	}
+	if got := records[1][len(header)-1]; got != "250" {
+		t.Errorf("got turbo_boost_pressure %v, want 250", got)
+	}
+	if got := records[2][len(header)-1]; got != "" {
+		t.Errorf("got turbo_boost_pressure %v for car 77, want none", got)
+	}
+}
+
+// bytesFile is a read only parquet file in memory.
+type bytesFile struct {
+	*bytes.Reader
+	data []byte
+}
+
+func (f *bytesFile) Write(p []byte) (int, error)                    { return 0, errWriteOnly }
+func (f *bytesFile) Close() error                                   { return nil }
+func (f *bytesFile) Create(name string) (source.ParquetFile, error) { return nil, errWriteOnly }
+func (f *bytesFile) Open(name string) (source.ParquetFile, error) {
+	return &bytesFile{Reader: bytes.NewReader(f.data), data: f.data}, nil
+}
+
+func TestParquetWide(t *testing.T) {
+
+	_, out := encode(t, api.ExportFormat_PARQUET, api.ExportLayout_WIDE)
+
+	pr, err := reader.NewParquetColumnReader(&bytesFile{Reader: bytes.NewReader(out), data: out}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pr.ReadStop()
+
+	if pr.GetNumRows() != 3 {
+		t.Fatalf("got %v rows, want 3", pr.GetNumRows())
+	}
+
+	schema := pr.Footer.Schema[1:]
+	if len(schema) != len(columns(api.ExportLayout_WIDE, nil)) {
+		t.Fatalf("got %v columns, want %v", len(schema), len(columns(api.ExportLayout_WIDE, nil)))
+	}
+
+	timestamps, _, _, err := pr.ReadColumnByIndex(0, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if timestamps[0] != time.Date(2019, 7, 14, 13, 0, 0, 1500000, time.UTC).UnixNano()/1000 {
+		t.Errorf("got timestamp %v", timestamps[0])
+	}
+
+	var speedIndex int64
+	for i, v := range schema {
+		if strings.EqualFold(v.Name, "speed") {
+			speedIndex = int64(i)
+		}
+	}
+	speeds, _, dls, err := pr.ReadColumnByIndex(speedIndex, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dls) != 3 || dls[1] != 0 || speeds[0] != 300.0 || speeds[len(speeds)-1] != 301.0 {
+		t.Errorf("got speeds %v with definition levels %v", speeds, dls)
+	}
+}
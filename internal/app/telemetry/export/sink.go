@@ -0,0 +1,196 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/source"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// formatValue formats a row value for the text formats, a missing value is an empty string.
+func formatValue(v interface{}) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case int32:
+		return strconv.FormatInt(int64(t), 10)
+	case float64:
+		return strconv.FormatFloat(t, 'g', -1, 64)
+	case bool:
+		return strconv.FormatBool(t)
+	case time.Time:
+		return t.UTC().Format(time.RFC3339Nano)
+	default:
+		return fmt.Sprintf("%v", t)
+	}
+}
+
+type csvSink struct {
+	w      *csv.Writer
+	record []string
+}
+
+func newCSVSink(w io.Writer, cols []column) (*csvSink, error) {
+
+	s := &csvSink{w: csv.NewWriter(w), record: make([]string, len(cols))}
+
+	for i, c := range cols {
+		s.record[i] = c.name
+	}
+	if err := s.w.Write(s.record); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *csvSink) writeRow(r row) error {
+	for i, v := range r {
+		s.record[i] = formatValue(v)
+	}
+	return s.w.Write(s.record)
+}
+
+func (s *csvSink) close() error {
+	s.w.Flush()
+	return s.w.Error()
+}
+
+// jsonLinesSink writes a JSON object per row with the keys in column order, a missing value is
+// null.
+type jsonLinesSink struct {
+	w    io.Writer
+	keys [][]byte
+	buf  bytes.Buffer
+}
+
+func newJSONLinesSink(w io.Writer, cols []column) *jsonLinesSink {
+	s := &jsonLinesSink{w: w}
+	for _, c := range cols {
+		key, _ := json.Marshal(c.name)
+		s.keys = append(s.keys, key)
+	}
+	return s
+}
+
+func (s *jsonLinesSink) writeRow(r row) error {
+
+	s.buf.Reset()
+	s.buf.WriteByte('{')
+	for i, v := range r {
+		if i > 0 {
+			s.buf.WriteByte(',')
+		}
+		s.buf.Write(s.keys[i])
+		s.buf.WriteByte(':')
+		if t, ok := v.(time.Time); ok {
+			v = formatValue(t)
+		}
+		value, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		s.buf.Write(value)
+	}
+	s.buf.WriteString("}\n")
+
+	_, err := s.w.Write(s.buf.Bytes())
+	return err
+}
+
+func (s *jsonLinesSink) close() error {
+	return nil
+}
+
+// parquetRowGroupSize bounds the memory used by the parquet writer, which buffers a row group
+// before writing it.
+const parquetRowGroupSize = 16 * 1024 * 1024
+
+// parquetSink writes an Apache Parquet file with an optional column per export column.
+// Timestamps are stored as TIMESTAMP_MICROS.
+type parquetSink struct {
+	pw   *writer.CSVWriter
+	cols []column
+}
+
+var parquetTypes = map[kind]string{
+	kindString:    "UTF8, encoding=PLAIN_DICTIONARY",
+	kindInt:       "INT32",
+	kindDouble:    "DOUBLE",
+	kindBool:      "BOOLEAN",
+	kindTimestamp: "TIMESTAMP_MICROS",
+}
+
+func newParquetSink(w io.Writer, cols []column) (*parquetSink, error) {
+
+	md := make([]string, len(cols))
+	for i, c := range cols {
+		md[i] = fmt.Sprintf("name=%v, type=%v", c.name, parquetTypes[c.kind])
+	}
+
+	pw, err := writer.NewCSVWriter(md, &parquetFile{w: w}, 1)
+	if err != nil {
+		return nil, err
+	}
+	pw.RowGroupSize = parquetRowGroupSize
+	pw.CompressionType = parquet.CompressionCodec_SNAPPY
+
+	return &parquetSink{pw: pw, cols: cols}, nil
+}
+
+func (s *parquetSink) writeRow(r row) error {
+	values := make([]interface{}, len(r))
+	for i, v := range r {
+		if t, ok := v.(time.Time); ok {
+			values[i] = t.UnixNano() / int64(time.Microsecond)
+			continue
+		}
+		values[i] = v
+	}
+	return s.pw.Write(values)
+}
+
+func (s *parquetSink) close() error {
+	return s.pw.WriteStop()
+}
+
+// parquetFile adapts an io.Writer to the parquet writer, which only ever writes to its file.
+type parquetFile struct {
+	w io.Writer
+}
+
+var errWriteOnly = errors.New("export parquet file is write only")
+
+func (f *parquetFile) Write(p []byte) (int, error) {
+	return f.w.Write(p)
+}
+
+func (f *parquetFile) Read(p []byte) (int, error) {
+	return 0, errWriteOnly
+}
+
+func (f *parquetFile) Seek(offset int64, whence int) (int64, error) {
+	return 0, errWriteOnly
+}
+
+func (f *parquetFile) Close() error {
+	return nil
+}
+
+func (f *parquetFile) Open(name string) (source.ParquetFile, error) {
+	return nil, errWriteOnly
+}
+
+func (f *parquetFile) Create(name string) (source.ParquetFile, error) {
+	return nil, errWriteOnly
+}
@@ -0,0 +1,254 @@
+// Package hub fans newly ingested telemetry data out to the live telemetry subscribers of the
+// telemetry service.
+package hub
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/bburch01/FOTAAS/api"
+)
+
+// SlowSubscriberPolicy determines what happens when a subscriber's buffer is full when a datum is
+// published to it.
+type SlowSubscriberPolicy int
+
+const (
+	// Drop drops the datum for that subscriber only and counts it, see Subscription.TakeDropped.
+	Drop SlowSubscriberPolicy = iota
+	// Disconnect closes the subscription with ErrSlowSubscriber.
+	Disconnect
+)
+
+func (p SlowSubscriberPolicy) String() string {
+	return [...]string{"drop", "disconnect"}[p]
+}
+
+// SlowSubscriberPolicyForString returns the SlowSubscriberPolicy named by s (case insensitive).
+func SlowSubscriberPolicyForString(s string) (SlowSubscriberPolicy, error) {
+	switch strings.ToLower(s) {
+	case "drop":
+		return Drop, nil
+	case "disconnect":
+		return Disconnect, nil
+	default:
+		return Drop, fmt.Errorf("invalid slow subscriber policy %v, valid policies are: drop, disconnect", s)
+	}
+}
+
+// ErrSlowSubscriber is the reason a subscription is closed under the Disconnect policy.
+var ErrSlowSubscriber = errors.New("subscriber did not keep up with the telemetry data rate")
+
+// ErrHubClosed is the reason every open subscription is closed when the hub is closed.
+var ErrHubClosed = errors.New("telemetry hub closed")
+
+// Filter selects the telemetry data delivered to a subscription. Empty fields match any value. A
+// datum matches Descriptions or Channels, which are channel registry names (the built in channel
+// names match the datum of that description).
+type Filter struct {
+	SimulationID string
+	Constructors []api.Constructor
+	CarNumbers   []int32
+	Descriptions []api.TelemetryDatumDescription
+	Channels     []string
+}
+
+// Matches reports whether datum passes the filter.
+func (f *Filter) Matches(datum *api.TelemetryDatum) bool {
+
+	if f.SimulationID != "" && datum.SimulationUuid != f.SimulationID {
+		return false
+	}
+
+	if len(f.Constructors) > 0 {
+		var found bool
+		for _, v := range f.Constructors {
+			if v == datum.Constructor {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(f.CarNumbers) > 0 {
+		var found bool
+		for _, v := range f.CarNumbers {
+			if v == datum.CarNumber {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(f.Descriptions) > 0 || len(f.Channels) > 0 {
+		var found bool
+		name := datum.Channel
+		if name == "" {
+			name = datum.Description.String()
+			for _, v := range f.Descriptions {
+				if v == datum.Description {
+					found = true
+					break
+				}
+			}
+		}
+		for _, v := range f.Channels {
+			if v == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Subscription is a single subscriber's view of the hub. Datum are received from C until Done is
+// closed, after which Err returns the reason the subscription was closed.
+type Subscription struct {
+	C <-chan *api.TelemetryDatum
+
+	c         chan *api.TelemetryDatum
+	filter    Filter
+	done      chan struct{}
+	closeOnce sync.Once
+	err       error
+	dropped   int64
+}
+
+// Done is closed when the subscription has been closed by the hub.
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err returns the reason the subscription was closed, nil while it is still open or if it was
+// closed by Unsubscribe.
+func (s *Subscription) Err() error {
+	select {
+	case <-s.done:
+		return s.err
+	default:
+		return nil
+	}
+}
+
+// TakeDropped returns the number of datum dropped for this subscription since the previous call.
+func (s *Subscription) TakeDropped() int64 {
+	return atomic.SwapInt64(&s.dropped, 0)
+}
+
+func (s *Subscription) close(err error) {
+	s.closeOnce.Do(func() {
+		s.err = err
+		close(s.done)
+	})
+}
+
+// Hub fans published telemetry data out to its subscriptions. Publish never blocks on a subscriber,
+// each subscription has a bounded buffer and a full buffer is handled by the hub's
+// SlowSubscriberPolicy.
+type Hub struct {
+	mu            sync.RWMutex
+	subscriptions map[*Subscription]struct{}
+	bufferSize    int
+	policy        SlowSubscriberPolicy
+	closed        bool
+}
+
+// New creates a hub whose subscriptions buffer up to bufferSize datum.
+func New(bufferSize int, policy SlowSubscriberPolicy) *Hub {
+	if bufferSize < 1 {
+		bufferSize = 1
+	}
+	return &Hub{subscriptions: make(map[*Subscription]struct{}), bufferSize: bufferSize, policy: policy}
+}
+
+// Subscribe opens a subscription to the telemetry data matching filter. The caller must call
+// Unsubscribe when done with it.
+func (h *Hub) Subscribe(filter Filter) *Subscription {
+
+	c := make(chan *api.TelemetryDatum, h.bufferSize)
+	s := &Subscription{C: c, c: c, filter: filter, done: make(chan struct{})}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		s.close(ErrHubClosed)
+		return s
+	}
+	h.subscriptions[s] = struct{}{}
+
+	return s
+}
+
+// Unsubscribe closes s and removes it from the hub.
+func (h *Hub) Unsubscribe(s *Subscription) {
+
+	h.mu.Lock()
+	delete(h.subscriptions, s)
+	h.mu.Unlock()
+
+	s.close(nil)
+}
+
+// Publish delivers every datum in data to the subscriptions whose filter it matches.
+func (h *Hub) Publish(data []*api.TelemetryDatum) {
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for s := range h.subscriptions {
+		for _, datum := range data {
+			if !s.filter.Matches(datum) {
+				continue
+			}
+			select {
+			case <-s.done:
+			case s.c <- datum:
+				continue
+			default:
+				if h.policy == Disconnect {
+					s.close(ErrSlowSubscriber)
+				} else {
+					atomic.AddInt64(&s.dropped, 1)
+					continue
+				}
+			}
+			// The subscription is closed, skip the rest of the data for it.
+			break
+		}
+	}
+}
+
+// Len returns the number of open subscriptions.
+func (h *Hub) Len() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.subscriptions)
+}
+
+// Close closes every subscription with ErrHubClosed, subsequent subscriptions are closed immediately.
+func (h *Hub) Close() {
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for s := range h.subscriptions {
+		s.close(ErrHubClosed)
+		delete(h.subscriptions, s)
+	}
+}
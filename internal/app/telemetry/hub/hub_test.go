@@ -0,0 +1,152 @@
+package hub
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/bburch01/FOTAAS/api"
+)
+
+func newDatum(uuid string, constructor api.Constructor, carNumber int32) *api.TelemetryDatum {
+	return &api.TelemetryDatum{Uuid: uuid, SimulationUuid: "sim", Constructor: constructor, CarNumber: carNumber,
+		Description: api.TelemetryDatumDescription_SPEED}
+}
+
+func TestFilterMatches(t *testing.T) {
+
+	datum := newDatum("a", api.Constructor_MERCEDES, 44)
+
+	cases := []struct {
+		filter Filter
+		match  bool
+	}{
+		{Filter{}, true},
+		{Filter{SimulationID: "sim"}, true},
+		{Filter{SimulationID: "other"}, false},
+		{Filter{Constructors: []api.Constructor{api.Constructor_FERRARI, api.Constructor_MERCEDES}}, true},
+		{Filter{Constructors: []api.Constructor{api.Constructor_FERRARI}}, false},
+		{Filter{CarNumbers: []int32{44}}, true},
+		{Filter{CarNumbers: []int32{77}}, false},
+		{Filter{Descriptions: []api.TelemetryDatumDescription{api.TelemetryDatumDescription_SPEED}}, true},
+		{Filter{Descriptions: []api.TelemetryDatumDescription{api.TelemetryDatumDescription_ENGINE_RPM}}, false},
+	}
+
+	for i, c := range cases {
+		if c.filter.Matches(datum) != c.match {
+			t.Errorf("case %v: expected match %v", i, c.match)
+		}
+	}
+}
+
+func TestPublishFanOut(t *testing.T) {
+
+	h := New(10, Drop)
+
+	all := h.Subscribe(Filter{})
+	defer h.Unsubscribe(all)
+	mercedes := h.Subscribe(Filter{Constructors: []api.Constructor{api.Constructor_MERCEDES}})
+	defer h.Unsubscribe(mercedes)
+
+	h.Publish([]*api.TelemetryDatum{newDatum("a", api.Constructor_MERCEDES, 44), newDatum("b", api.Constructor_FERRARI, 5)})
+
+	if len(all.C) != 2 {
+		t.Errorf("expected 2 datum for the unfiltered subscription, got %v", len(all.C))
+	}
+	if len(mercedes.C) != 1 || (<-mercedes.C).Uuid != "a" {
+		t.Error("expected only the MERCEDES datum for the filtered subscription")
+	}
+}
+
+func TestDropPolicy(t *testing.T) {
+
+	h := New(2, Drop)
+
+	slow := h.Subscribe(Filter{})
+	defer h.Unsubscribe(slow)
+
+	h.Publish([]*api.TelemetryDatum{newDatum("a", api.Constructor_HAAS, 8), newDatum("b", api.Constructor_HAAS, 8),
+		newDatum("c", api.Constructor_HAAS, 8)})
+
+	if len(slow.C) != 2 {
+		t.Errorf("expected a full buffer of 2 datum, got %v", len(slow.C))
+	}
+	if dropped := slow.TakeDropped(); dropped != 1 {
+		t.Errorf("expected 1 dropped datum, got %v", dropped)
+	}
+	if dropped := slow.TakeDropped(); dropped != 0 {
+		t.Errorf("expected the dropped datum count to be reset, got %v", dropped)
+	}
+	if slow.Err() != nil {
+		t.Errorf("expected the subscription to remain open, got %v", slow.Err())
+	}
+}
+
+func TestDisconnectPolicy(t *testing.T) {
+
+	h := New(1, Disconnect)
+
+	slow := h.Subscribe(Filter{})
+	defer h.Unsubscribe(slow)
+	fast := h.Subscribe(Filter{CarNumbers: []int32{20}})
+	defer h.Unsubscribe(fast)
+
+	h.Publish([]*api.TelemetryDatum{newDatum("a", api.Constructor_HAAS, 8), newDatum("b", api.Constructor_HAAS, 8)})
+
+	select {
+	case <-slow.Done():
+	default:
+		t.Fatal("expected the slow subscription to be closed")
+	}
+	if slow.Err() != ErrSlowSubscriber {
+		t.Errorf("expected ErrSlowSubscriber, got %v", slow.Err())
+	}
+	if fast.Err() != nil {
+		t.Errorf("expected the other subscription to remain open, got %v", fast.Err())
+	}
+}
+
+func TestUnsubscribeAndClose(t *testing.T) {
+
+	h := New(1, Drop)
+
+	s := h.Subscribe(Filter{})
+	h.Unsubscribe(s)
+	if h.Len() != 0 {
+		t.Errorf("expected no subscriptions, got %v", h.Len())
+	}
+	if s.Err() != nil {
+		t.Errorf("expected no error after unsubscribe, got %v", s.Err())
+	}
+
+	s = h.Subscribe(Filter{})
+	h.Close()
+	if s.Err() != ErrHubClosed {
+		t.Errorf("expected ErrHubClosed, got %v", s.Err())
+	}
+	if s = h.Subscribe(Filter{}); s.Err() != ErrHubClosed {
+		t.Errorf("expected a subscription to a closed hub to be closed, got %v", s.Err())
+	}
+}
+
+func TestConcurrentPublish(t *testing.T) {
+
+	h := New(1000, Drop)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s := h.Subscribe(Filter{})
+			for j := 0; j < 100; j++ {
+				h.Publish([]*api.TelemetryDatum{newDatum("a", api.Constructor_HAAS, 8)})
+			}
+			h.Unsubscribe(s)
+		}()
+	}
+	wg.Wait()
+
+	if h.Len() != 0 {
+		t.Errorf("expected no subscriptions, got %v", h.Len())
+	}
+}
@@ -0,0 +1,433 @@
+// Package importer reads recorded telemetry data files (CSV or JSON Lines) into telemetry datum.
+// File columns are mapped to telemetry datum fields, by default a column has the name of its field
+// (the column names of a long layout export), so exported files can be imported as is.
+package importer
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	ipbts "github.com/bburch01/FOTAAS/internal/pkg/protobuf/timestamp"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/bburch01/FOTAAS/internal/app/telemetry"
+	"github.com/bburch01/FOTAAS/internal/app/telemetry/channel"
+	"github.com/google/uuid"
+)
+
+// Format is the format of a telemetry data file.
+type Format int
+
+const (
+	CSV Format = iota
+	JSONLines
+)
+
+func (f Format) String() string {
+	return [...]string{"csv", "jsonl"}[f]
+}
+
+// FormatForString returns the Format named by s (case insensitive).
+func FormatForString(s string) (Format, error) {
+	switch strings.ToLower(s) {
+	case "csv":
+		return CSV, nil
+	case "jsonl", "json":
+		return JSONLines, nil
+	default:
+		return CSV, fmt.Errorf("invalid file format %v, valid formats are: csv, jsonl", s)
+	}
+}
+
+// FormatForPath returns the Format of a file from its extension.
+func FormatForPath(path string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return CSV, nil
+	case ".jsonl", ".json", ".ndjson":
+		return JSONLines, nil
+	default:
+		return CSV, fmt.Errorf("unknown file format for %v, specify the format", path)
+	}
+}
+
+// The telemetry datum fields that can be mapped to file columns.
+const (
+	FieldUUID           = "uuid"
+	FieldTimestamp      = "timestamp"
+	FieldSimulated      = "simulated"
+	FieldSimulationUUID = "simulation_uuid"
+	FieldGranPrix       = "gran_prix"
+	FieldTrack          = "track"
+	FieldConstructor    = "constructor"
+	FieldCarNumber      = "car_number"
+	FieldDescription    = "description"
+	FieldUnit           = "unit"
+	FieldValue          = "value"
+	FieldLatitude       = "latitude"
+	FieldLongitude      = "longitude"
+	FieldElevation      = "elevation"
+	FieldHighAlarm      = "high_alarm"
+	FieldLowAlarm       = "low_alarm"
+	FieldLap            = "lap"
+	FieldSector         = "sector"
+	FieldLapDistance    = "lap_distance"
+)
+
+// Fields are the telemetry datum fields in the order of a long layout export.
+var Fields = []string{FieldUUID, FieldTimestamp, FieldSimulated, FieldSimulationUUID, FieldGranPrix, FieldTrack,
+	FieldConstructor, FieldCarNumber, FieldDescription, FieldUnit, FieldValue, FieldLatitude, FieldLongitude,
+	FieldElevation, FieldHighAlarm, FieldLowAlarm, FieldLap, FieldSector, FieldLapDistance}
+
+// lapFields were added to Fields later, see rowUUID.
+var lapFields = map[string]bool{FieldLap: true, FieldSector: true, FieldLapDistance: true}
+
+// requiredFields must have a column or a default value.
+var requiredFields = []string{FieldTimestamp, FieldGranPrix, FieldTrack, FieldConstructor, FieldCarNumber,
+	FieldDescription, FieldUnit, FieldValue}
+
+// Mapping maps telemetry datum fields to file column names.
+type Mapping map[string]string
+
+// ParseMapping returns the default mapping overridden by entries of the form field=column.
+func ParseMapping(entries []string) (Mapping, error) {
+
+	m := make(Mapping)
+	for _, v := range Fields {
+		m[v] = v
+	}
+
+	for _, v := range entries {
+		kv := strings.SplitN(v, "=", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[1]) == "" {
+			return nil, fmt.Errorf("invalid column mapping %v, format is field=column", v)
+		}
+		field := strings.ToLower(strings.TrimSpace(kv[0]))
+		if _, ok := m[field]; !ok {
+			return nil, fmt.Errorf("invalid column mapping %v, valid fields are: %v", v, strings.Join(Fields, ", "))
+		}
+		m[field] = strings.TrimSpace(kv[1])
+	}
+
+	return m, nil
+}
+
+// Row is a telemetry datum read from a file, or the reason the row was rejected.
+type Row struct {
+	// Line is the line number of the row in the file, for a CSV file the record number counting the
+	// header as line 1.
+	Line  int
+	Datum *api.TelemetryDatum
+	Err   error
+}
+
+// Reader reads telemetry datum from a file. A datum without a uuid column gets a uuid derived from
+// its row, so importing the same file again is reported as duplicate by the telemetry service
+// instead of persisting the data twice.
+type Reader struct {
+	mapping  Mapping
+	defaults map[string]string
+	channels *channel.Registry
+	next     func() (map[string]string, int, error)
+}
+
+// rowError is an error that rejects a single row of a file.
+type rowError struct {
+	err error
+}
+
+func (e rowError) Error() string {
+	return e.err.Error()
+}
+
+// uuidNamespace is the namespace of the uuids derived from imported rows.
+var uuidNamespace = uuid.MustParse("6b0b4f1e-2c55-4a8e-9c55-3f4d5a3c9e11")
+
+// NewReader creates a reader for a file in format. Fields without a column in the file take their
+// value from defaults (keyed by field), it is an error for a required field to have neither. The
+// rows are validated against the channels of channels.
+func NewReader(r io.Reader, format Format, mapping Mapping, defaults map[string]string,
+	channels *channel.Registry) (*Reader, error) {
+
+	rdr := &Reader{mapping: mapping, defaults: defaults, channels: channels}
+
+	var columns map[string]bool
+	switch format {
+	case CSV:
+		cr := csv.NewReader(r)
+		cr.FieldsPerRecord = -1
+		header, err := cr.Read()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read csv header with error: %v", err)
+		}
+		columns = make(map[string]bool)
+		for _, v := range header {
+			columns[strings.TrimSpace(v)] = true
+		}
+		line := 1
+		rdr.next = func() (map[string]string, int, error) {
+			record, err := cr.Read()
+			line++
+			if e, ok := err.(*csv.ParseError); ok {
+				return nil, line, rowError{e}
+			}
+			if err != nil {
+				return nil, line, err
+			}
+			values := make(map[string]string)
+			for i, v := range record {
+				if i < len(header) {
+					values[strings.TrimSpace(header[i])] = v
+				}
+			}
+			return values, line, nil
+		}
+	case JSONLines:
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+		line := 0
+		rdr.next = func() (map[string]string, int, error) {
+			for scanner.Scan() {
+				line++
+				if strings.TrimSpace(scanner.Text()) == "" {
+					continue
+				}
+				values, err := jsonValues(scanner.Bytes())
+				if err != nil {
+					return nil, line, rowError{fmt.Errorf("invalid json: %v", err)}
+				}
+				return values, line, nil
+			}
+			if err := scanner.Err(); err != nil {
+				return nil, line, err
+			}
+			return nil, line, io.EOF
+		}
+	default:
+		return nil, fmt.Errorf("invalid file format %v", format)
+	}
+
+	// A JSON Lines file has no header, its columns can only be checked row by row.
+	if columns != nil {
+		for _, v := range requiredFields {
+			if !columns[mapping[v]] && defaults[v] == "" {
+				return nil, fmt.Errorf("the file has no %v column for the %v field, map the field to a column or specify a default", mapping[v], v)
+			}
+		}
+	}
+
+	return rdr, nil
+}
+
+func jsonValues(b []byte) (map[string]string, error) {
+
+	var obj map[string]interface{}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string)
+	for k, v := range obj {
+		switch t := v.(type) {
+		case nil:
+		case string:
+			values[k] = t
+		case float64:
+			values[k] = strconv.FormatFloat(t, 'g', -1, 64)
+		case bool:
+			values[k] = strconv.FormatBool(t)
+		default:
+			return nil, fmt.Errorf("invalid value for %v, must be a string, number or boolean", k)
+		}
+	}
+
+	return values, nil
+}
+
+// Next returns the next row, or io.EOF at the end of the file. A row that can't be parsed or fails
+// telemetry datum validation is returned with Err set, any other error ends the file.
+func (r *Reader) Next() (Row, error) {
+
+	values, line, err := r.next()
+	if err == io.EOF {
+		return Row{}, io.EOF
+	}
+	if e, ok := err.(rowError); ok {
+		return Row{Line: line, Err: e.err}, nil
+	}
+	if err != nil {
+		return Row{}, err
+	}
+
+	datum, err := r.parse(values)
+	if err == nil {
+		err = telemetry.Validate(datum, r.channels)
+	}
+	if err != nil {
+		return Row{Line: line, Err: err}, nil
+	}
+
+	return Row{Line: line, Datum: datum}, nil
+}
+
+func (r *Reader) parse(values map[string]string) (*api.TelemetryDatum, error) {
+
+	fields := make(map[string]string)
+	for _, f := range Fields {
+		v := strings.TrimSpace(values[r.mapping[f]])
+		if v == "" {
+			v = r.defaults[f]
+		}
+		fields[f] = v
+	}
+
+	for _, f := range requiredFields {
+		if fields[f] == "" {
+			return nil, fmt.Errorf("missing %v", f)
+		}
+	}
+
+	datum := &api.TelemetryDatum{Uuid: fields[FieldUUID], SimulationUuid: fields[FieldSimulationUUID]}
+	var err error
+
+	ts, err := parseTimestamp(fields[FieldTimestamp])
+	if err != nil {
+		return nil, err
+	}
+	if datum.Timestamp, err = ipbts.TimestampProto(ts); err != nil {
+		return nil, err
+	}
+
+	var ordinal int32
+	if ordinal, err = parseEnum(FieldGranPrix, fields[FieldGranPrix], api.GranPrix_value); err != nil {
+		return nil, err
+	}
+	datum.GranPrix = api.GranPrix(ordinal)
+	if ordinal, err = parseEnum(FieldTrack, fields[FieldTrack], api.Track_value); err != nil {
+		return nil, err
+	}
+	datum.Track = api.Track(ordinal)
+	if ordinal, err = parseEnum(FieldConstructor, fields[FieldConstructor], api.Constructor_value); err != nil {
+		return nil, err
+	}
+	datum.Constructor = api.Constructor(ordinal)
+	// A description that is not a TelemetryDatumDescription is the name of a registered channel,
+	// in the channel's unit.
+	if channel.IsBuiltIn(strings.ToUpper(fields[FieldDescription])) {
+		if ordinal, err = parseEnum(FieldDescription, fields[FieldDescription], api.TelemetryDatumDescription_value); err != nil {
+			return nil, err
+		}
+		datum.Description = api.TelemetryDatumDescription(ordinal)
+		if ordinal, err = parseEnum(FieldUnit, fields[FieldUnit], api.TelemetryDatumUnit_value); err != nil {
+			return nil, err
+		}
+		datum.Unit = api.TelemetryDatumUnit(ordinal)
+	} else {
+		datum.Channel = fields[FieldDescription]
+		datum.ChannelUnit = fields[FieldUnit]
+	}
+
+	carNumber, err := strconv.ParseInt(fields[FieldCarNumber], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid car_number %v", fields[FieldCarNumber])
+	}
+	datum.CarNumber = int32(carNumber)
+
+	for _, v := range []struct {
+		field string
+		dst   *int32
+	}{
+		{FieldLap, &datum.Lap},
+		{FieldSector, &datum.Sector},
+	} {
+		if fields[v.field] == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(fields[v.field], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %v %v", v.field, fields[v.field])
+		}
+		*v.dst = int32(n)
+	}
+
+	for _, v := range []struct {
+		field string
+		dst   *float64
+	}{
+		{FieldValue, &datum.Value},
+		{FieldLatitude, &datum.Latitude},
+		{FieldLongitude, &datum.Longitude},
+		{FieldElevation, &datum.Elevation},
+		{FieldLapDistance, &datum.LapDistance},
+	} {
+		if fields[v.field] == "" {
+			continue
+		}
+		if *v.dst, err = strconv.ParseFloat(fields[v.field], 64); err != nil {
+			return nil, fmt.Errorf("invalid %v %v", v.field, fields[v.field])
+		}
+	}
+
+	for _, v := range []struct {
+		field string
+		dst   *bool
+	}{
+		{FieldSimulated, &datum.Simulated},
+		{FieldHighAlarm, &datum.HighAlarm},
+		{FieldLowAlarm, &datum.LowAlarm},
+	} {
+		if fields[v.field] == "" {
+			continue
+		}
+		if *v.dst, err = strconv.ParseBool(fields[v.field]); err != nil {
+			return nil, fmt.Errorf("invalid %v %v", v.field, fields[v.field])
+		}
+	}
+
+	if datum.Uuid == "" {
+		datum.Uuid = rowUUID(fields)
+	}
+
+	return datum, nil
+}
+
+// rowUUID derives a uuid from the field values of a row.
+func rowUUID(fields map[string]string) string {
+	var sb strings.Builder
+	for _, f := range Fields {
+		// Skip the empty lap fields so that rows without them keep the uuids they had before the
+		// lap fields were added.
+		if lapFields[f] && fields[f] == "" {
+			continue
+		}
+		sb.WriteString(fields[f])
+		sb.WriteByte(0)
+	}
+	return uuid.NewSHA1(uuidNamespace, []byte(sb.String())).String()
+}
+
+var timestampLayouts = []string{time.RFC3339Nano, "2006-01-02 15:04:05.999999999", "2006-01-02T15:04:05.999999999"}
+
+// parseTimestamp parses an RFC 3339 timestamp, a timestamp without a zone is UTC.
+func parseTimestamp(s string) (time.Time, error) {
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid timestamp %v, format is RFC 3339 (e.g. 2019-07-14T13:10:00.125Z)", s)
+}
+
+func parseEnum(field string, s string, values map[string]int32) (int32, error) {
+	if v, ok := values[strings.ToUpper(s)]; ok {
+		return v, nil
+	}
+	return 0, fmt.Errorf("invalid %v %v", field, s)
+}
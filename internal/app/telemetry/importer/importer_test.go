@@ -0,0 +1,201 @@
+package importer
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/bburch01/FOTAAS/internal/app/telemetry/channel"
+)
+
+func readAll(t *testing.T, r *Reader) []Row {
+	var rows []Row
+	for {
+		row, err := r.Next()
+		if err == io.EOF {
+			return rows
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		rows = append(rows, row)
+	}
+}
+
+func TestCSV(t *testing.T) {
+
+	file := `time,channel,unit,value,car
+2019-07-14T13:10:00.125Z,ENGINE_RPM,RPM,11000,44
+2019-07-14 13:10:00.126,speed,kph,301.5,44
+2019-07-14T13:10:00.127Z,SPEED,RPM,301.5,44
+not a time,SPEED,KPH,301.5,44
+2019-07-14T13:10:00.128Z,SPEED,KPH,,44
+`
+	mapping, err := ParseMapping([]string{"timestamp=time", "description=channel", "car_number=car"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defaults := map[string]string{FieldGranPrix: "british", FieldTrack: "silverstone", FieldConstructor: "mercedes"}
+
+	r, err := NewReader(strings.NewReader(file), CSV, mapping, defaults, channel.NewRegistry(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := readAll(t, r)
+
+	if len(rows) != 5 {
+		t.Fatalf("got %v rows, want 5", len(rows))
+	}
+
+	d := rows[1].Datum
+	if rows[1].Err != nil || rows[1].Line != 3 {
+		t.Fatalf("row 2: got line %v error %v", rows[1].Line, rows[1].Err)
+	}
+	if d.Description != api.TelemetryDatumDescription_SPEED || d.Unit != api.TelemetryDatumUnit_KPH || d.Value != 301.5 ||
+		d.CarNumber != 44 || d.Constructor != api.Constructor_MERCEDES || d.Track != api.Track_SILVERSTONE ||
+		d.Timestamp.Nanos != 126000000 || d.Simulated {
+		t.Errorf("row 2: got %v", d)
+	}
+
+	for i, want := range []string{"", "", "invalid telemetry datum unit", "invalid timestamp", "missing value"} {
+		switch {
+		case want == "" && rows[i].Err != nil:
+			t.Errorf("row %v: got error %v", i+1, rows[i].Err)
+		case want != "" && (rows[i].Err == nil || !strings.Contains(rows[i].Err.Error(), want)):
+			t.Errorf("row %v: got error %v, want %v", i+1, rows[i].Err, want)
+		}
+	}
+}
+
+func TestCSVRegisteredChannel(t *testing.T) {
+
+	file := `timestamp,description,unit,value
+2019-07-14T13:10:00.125Z,TURBO_BOOST_PRESSURE,kPa,250
+2019-07-14T13:10:00.126Z,TURBO_BOOST_PRESSURE,kPa,450
+2019-07-14T13:10:00.127Z,TURBO_BOOST_PRESSURE,bar,2.5
+2019-07-14T13:10:00.128Z,WING_ANGLE,degree,12
+`
+	mapping, _ := ParseMapping(nil)
+	defaults := map[string]string{FieldGranPrix: "british", FieldTrack: "silverstone", FieldConstructor: "mercedes",
+		FieldCarNumber: "44"}
+	channels := channel.NewRegistry([]*api.Channel{{Name: "TURBO_BOOST_PRESSURE", Unit: "kPa", MinValue: 0, MaxValue: 400,
+		Category: api.ChannelCategory_POWER_UNIT}})
+
+	r, err := NewReader(strings.NewReader(file), CSV, mapping, defaults, channels)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := readAll(t, r)
+
+	if len(rows) != 4 {
+		t.Fatalf("got %v rows, want 4", len(rows))
+	}
+
+	if d := rows[0].Datum; rows[0].Err != nil || d.Channel != "TURBO_BOOST_PRESSURE" || d.ChannelUnit != "kPa" || d.Value != 250 {
+		t.Errorf("row 1: got %v with error %v", d, rows[0].Err)
+	}
+
+	for i, want := range []string{"", "outside of the valid range", "invalid telemetry datum unit", "unknown telemetry channel"} {
+		switch {
+		case want == "" && rows[i].Err != nil:
+			t.Errorf("row %v: got error %v", i+1, rows[i].Err)
+		case want != "" && (rows[i].Err == nil || !strings.Contains(rows[i].Err.Error(), want)):
+			t.Errorf("row %v: got error %v, want %v", i+1, rows[i].Err, want)
+		}
+	}
+}
+
+func TestCSVMissingColumn(t *testing.T) {
+
+	mapping, _ := ParseMapping(nil)
+	if _, err := NewReader(strings.NewReader("timestamp,description,unit,value\n"), CSV, mapping, nil, channel.NewRegistry(nil)); err == nil {
+		t.Error("expected an error for a file without gran_prix column or default")
+	}
+}
+
+func TestJSONLinesUUID(t *testing.T) {
+
+	file := `{"timestamp":"2019-07-14T13:10:00.125Z","gran_prix":"BRITISH","track":"SILVERSTONE","constructor":"FERRARI","car_number":16,"description":"ENGINE_RPM","unit":"RPM","value":11000,"high_alarm":true}
+
+{"timestamp":"2019-07-14T13:10:00.125Z","gran_prix":"BRITISH","track":"SILVERSTONE","constructor":"FERRARI","car_number":16,"description":"ENGINE_RPM","unit":"RPM","value":11000,"high_alarm":true}
+{"uuid":"a3c0b0b6-3a1c-4b8b-9f7e-1b2c3d4e5f60","timestamp":"2019-07-14T13:10:00.125Z","gran_prix":"BRITISH","track":"SILVERSTONE","constructor":"FERRARI","car_number":16,"description":"ENGINE_RPM","unit":"RPM","value":11001}
+{"timestamp":
+`
+	mapping, _ := ParseMapping(nil)
+	r, err := NewReader(strings.NewReader(file), JSONLines, mapping, nil, channel.NewRegistry(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := readAll(t, r)
+
+	if len(rows) != 4 {
+		t.Fatalf("got %v rows, want 4", len(rows))
+	}
+	for _, v := range rows[:3] {
+		if v.Err != nil {
+			t.Fatalf("line %v: got error %v", v.Line, v.Err)
+		}
+	}
+	if !rows[0].Datum.HighAlarm || rows[0].Datum.CarNumber != 16 {
+		t.Errorf("got %v", rows[0].Datum)
+	}
+	// Identical rows get the same uuid, so a re-import is reported as duplicate.
+	if rows[0].Datum.Uuid != rows[1].Datum.Uuid || rows[1].Line != 3 {
+		t.Errorf("got uuids %v and %v, line %v", rows[0].Datum.Uuid, rows[1].Datum.Uuid, rows[1].Line)
+	}
+	if rows[2].Datum.Uuid != "a3c0b0b6-3a1c-4b8b-9f7e-1b2c3d4e5f60" {
+		t.Errorf("got uuid %v", rows[2].Datum.Uuid)
+	}
+	if rows[3].Err == nil || rows[3].Line != 5 {
+		t.Errorf("line %v: expected a json error, got %v", rows[3].Line, rows[3].Err)
+	}
+}
+
+func TestJSONLinesNonCanonicalUUID(t *testing.T) {
+
+	row := `"timestamp":"2019-07-14T13:10:00.125Z","gran_prix":"BRITISH","track":"SILVERSTONE","constructor":"FERRARI","car_number":16,"description":"ENGINE_RPM","unit":"RPM","value":11000}`
+	var file string
+	for _, v := range []string{"A3C0B0B6-3A1C-4B8B-9F7E-1B2C3D4E5F60", "{a3c0b0b6-3a1c-4b8b-9f7e-1b2c3d4e5f60}",
+		"urn:uuid:a3c0b0b6-3a1c-4b8b-9f7e-1b2c3d4e5f60", "a3c0b0b63a1c4b8b9f7e1b2c3d4e5f60"} {
+		file += `{"uuid":"` + v + `",` + row + "\n"
+	}
+
+	mapping, _ := ParseMapping(nil)
+	r, err := NewReader(strings.NewReader(file), JSONLines, mapping, nil, channel.NewRegistry(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := readAll(t, r)
+
+	if len(rows) != 4 {
+		t.Fatalf("got %v rows, want 4", len(rows))
+	}
+	for _, v := range rows {
+		if v.Err == nil || !strings.Contains(v.Err.Error(), "canonical form") {
+			t.Errorf("line %v: got error %v, want a non canonical uuid error", v.Line, v.Err)
+		}
+	}
+}
+
+func TestParseMapping(t *testing.T) {
+	if _, err := ParseMapping([]string{"speed=SPEED"}); err == nil {
+		t.Error("expected an error for an unknown field")
+	}
+	if _, err := ParseMapping([]string{"value"}); err == nil {
+		t.Error("expected an error for a mapping without a column")
+	}
+}
+
+func TestJSONLinesInvalidRow(t *testing.T) {
+
+	mapping, _ := ParseMapping(nil)
+	r, err := NewReader(strings.NewReader("[1, 2]\n{\"value\": {\"nested\": 1}}\n"), JSONLines, mapping, nil, channel.NewRegistry(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := readAll(t, r)
+	if len(rows) != 2 || rows[0].Err == nil || rows[1].Err == nil {
+		t.Errorf("got %+v, want 2 rejected rows", rows)
+	}
+}
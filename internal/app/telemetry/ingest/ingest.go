@@ -0,0 +1,249 @@
+// Package ingest is the telemetry service's bounded in-memory ingest queue. Submitted telemetry
+// data is written by a pool of flush workers, each of which merges the queued submissions into
+// batched writes.
+package ingest
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bburch01/FOTAAS/api"
+)
+
+// ErrFull is returned by Submit when the queue has no room for the submission.
+var ErrFull = errors.New("telemetry ingest queue is full")
+
+// ErrClosed is returned by Submit after the queue has been closed.
+var ErrClosed = errors.New("telemetry ingest queue is closed")
+
+// AckModeForString returns the acknowledgement mode named by s (case insensitive), durable or
+// accepted.
+func AckModeForString(s string) (api.AckMode, error) {
+	switch strings.ToLower(s) {
+	case "durable":
+		return api.AckMode_ACK_DURABLE, nil
+	case "accepted":
+		return api.AckMode_ACK_ACCEPTED, nil
+	default:
+		return api.AckMode_ACK_DURABLE, fmt.Errorf("invalid ack mode %v, valid modes are: durable, accepted", s)
+	}
+}
+
+// Job is a submission to the queue. Value is opaque to the queue and Size is the number of telemetry
+// datum in the submission.
+type Job struct {
+	Value interface{}
+	Size  int
+	done  chan struct{}
+}
+
+// Done returns a channel that is closed once the job has been flushed.
+func (j *Job) Done() <-chan struct{} {
+	return j.done
+}
+
+// FlushFunc writes a batch of jobs and returns the number of telemetry datum that failed to be
+// written. The jobs are complete when FlushFunc returns.
+type FlushFunc func(jobs []*Job) (failed int)
+
+// Config is the configuration of a queue.
+type Config struct {
+	// Capacity is the maximum number of telemetry datum queued or being flushed. A submission larger
+	// than Capacity is only accepted by an empty queue.
+	Capacity int
+	// Workers is the number of flush workers.
+	Workers int
+	// MaxBatchSize is the number of telemetry datum after which a worker stops merging queued jobs
+	// into its batch.
+	MaxBatchSize int
+}
+
+// Stats is a snapshot of the queue's state and flush history.
+type Stats struct {
+	Capacity int
+	// Depth is the number of telemetry datum queued or being flushed.
+	Depth            int
+	Workers          int
+	Flushes          int64
+	FlushedDatum     int64
+	FailedDatum      int64
+	RejectedDatum    int64
+	LastFlushLatency time.Duration
+	MeanFlushLatency time.Duration
+	MaxFlushLatency  time.Duration
+}
+
+// Queue is a bounded queue of telemetry data submissions. It is safe for concurrent use.
+type Queue struct {
+	cfg   Config
+	flush FlushFunc
+	jobs  chan *Job
+	wg    sync.WaitGroup
+
+	mu     sync.Mutex
+	closed bool
+	depth  int
+	// space is closed, and replaced, whenever depth decreases.
+	space chan struct{}
+
+	stats             Stats
+	totalFlushLatency time.Duration
+}
+
+// New creates a queue and starts its flush workers.
+func New(cfg Config, flush FlushFunc) *Queue {
+
+	if cfg.Capacity < 1 {
+		cfg.Capacity = 1
+	}
+	if cfg.Workers < 1 {
+		cfg.Workers = 1
+	}
+	if cfg.MaxBatchSize < 1 {
+		cfg.MaxBatchSize = 1
+	}
+
+	// Every queued job holds at least one unit of capacity, so the channel never blocks a submitter.
+	q := &Queue{cfg: cfg, flush: flush, jobs: make(chan *Job, cfg.Capacity), space: make(chan struct{})}
+
+	for i := 0; i < cfg.Workers; i++ {
+		q.wg.Add(1)
+		go q.work()
+	}
+
+	return q
+}
+
+// Submit queues value, which holds size telemetry datum. When the queue is full Submit waits up to
+// wait for room and then returns ErrFull, a wait of 0 fails immediately.
+func (q *Queue) Submit(value interface{}, size int, wait time.Duration) (*Job, error) {
+
+	if size < 1 {
+		size = 1
+	}
+	job := &Job{Value: value, Size: size, done: make(chan struct{})}
+
+	var timer *time.Timer
+	defer func() {
+		if timer != nil {
+			timer.Stop()
+		}
+	}()
+
+	for {
+		q.mu.Lock()
+		if q.closed {
+			q.mu.Unlock()
+			return nil, ErrClosed
+		}
+		if q.depth == 0 || q.depth+size <= q.cfg.Capacity {
+			q.depth += size
+			q.jobs <- job
+			q.mu.Unlock()
+			return job, nil
+		}
+		space := q.space
+		q.mu.Unlock()
+
+		if wait <= 0 {
+			q.reject(size)
+			return nil, ErrFull
+		}
+		if timer == nil {
+			timer = time.NewTimer(wait)
+		}
+		select {
+		case <-space:
+		case <-timer.C:
+			q.reject(size)
+			return nil, ErrFull
+		}
+	}
+}
+
+func (q *Queue) reject(size int) {
+	q.mu.Lock()
+	q.stats.RejectedDatum += int64(size)
+	q.mu.Unlock()
+}
+
+func (q *Queue) work() {
+
+	defer q.wg.Done()
+
+	for job := range q.jobs {
+		batch := []*Job{job}
+		size := job.Size
+
+	merge:
+		for size < q.cfg.MaxBatchSize {
+			select {
+			case j, ok := <-q.jobs:
+				if !ok {
+					break merge
+				}
+				batch = append(batch, j)
+				size += j.Size
+			default:
+				break merge
+			}
+		}
+
+		start := time.Now()
+		failed := q.flush(batch)
+		latency := time.Since(start)
+
+		q.mu.Lock()
+		q.depth -= size
+		close(q.space)
+		q.space = make(chan struct{})
+		q.stats.Flushes++
+		q.stats.FlushedDatum += int64(size - failed)
+		q.stats.FailedDatum += int64(failed)
+		q.stats.LastFlushLatency = latency
+		q.totalFlushLatency += latency
+		if latency > q.stats.MaxFlushLatency {
+			q.stats.MaxFlushLatency = latency
+		}
+		q.mu.Unlock()
+
+		for _, v := range batch {
+			close(v.done)
+		}
+	}
+}
+
+// Stats returns a snapshot of the queue's state and flush history.
+func (q *Queue) Stats() Stats {
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	s := q.stats
+	s.Capacity = q.cfg.Capacity
+	s.Workers = q.cfg.Workers
+	s.Depth = q.depth
+	if s.Flushes > 0 {
+		s.MeanFlushLatency = q.totalFlushLatency / time.Duration(s.Flushes)
+	}
+
+	return s
+}
+
+// Close stops accepting submissions and waits for the queued jobs to be flushed.
+func (q *Queue) Close() {
+
+	q.mu.Lock()
+	if q.closed {
+		q.mu.Unlock()
+		return
+	}
+	q.closed = true
+	close(q.jobs)
+	q.mu.Unlock()
+
+	q.wg.Wait()
+}
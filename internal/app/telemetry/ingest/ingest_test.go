@@ -0,0 +1,112 @@
+package ingest
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSubmitFlushesMergedBatch(t *testing.T) {
+
+	var mu sync.Mutex
+	var batches [][]*Job
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+
+	q := New(Config{Capacity: 100, Workers: 1, MaxBatchSize: 100}, func(jobs []*Job) int {
+		started <- struct{}{}
+		<-release
+		mu.Lock()
+		batches = append(batches, jobs)
+		mu.Unlock()
+		return 0
+	})
+
+	// The first job occupies the worker, the rest queue up and are merged into the second flush.
+	var jobs []*Job
+	for i := 0; i < 4; i++ {
+		job, err := q.Submit(i, 10, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		jobs = append(jobs, job)
+		if i == 0 {
+			<-started
+		}
+	}
+	close(release)
+	<-started
+	for _, v := range jobs {
+		<-v.Done()
+	}
+	q.Close()
+
+	if len(batches) != 2 || len(batches[0]) != 1 || len(batches[1]) != 3 {
+		t.Fatalf("got %v batches, want a batch of 1 and a batch of 3 jobs", len(batches))
+	}
+
+	s := q.Stats()
+	if s.Flushes != 2 || s.FlushedDatum != 40 || s.Depth != 0 {
+		t.Errorf("got %v flushes, %v flushed datum and depth %v, want 2, 40 and 0", s.Flushes, s.FlushedDatum, s.Depth)
+	}
+}
+
+func TestSubmitFull(t *testing.T) {
+
+	release := make(chan struct{})
+	q := New(Config{Capacity: 10, Workers: 1, MaxBatchSize: 10}, func(jobs []*Job) int {
+		<-release
+		return 0
+	})
+
+	if _, err := q.Submit(nil, 8, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := q.Submit(nil, 3, 0); err != ErrFull {
+		t.Fatalf("got %v, want ErrFull", err)
+	}
+	start := time.Now()
+	if _, err := q.Submit(nil, 3, 20*time.Millisecond); err != ErrFull {
+		t.Fatalf("got %v, want ErrFull", err)
+	}
+	if time.Since(start) < 20*time.Millisecond {
+		t.Error("expected Submit to wait for room before failing")
+	}
+
+	// A waiting submission is accepted once the queue drains.
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+	}()
+	job, err := q.Submit(nil, 3, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-job.Done()
+	q.Close()
+
+	if s := q.Stats(); s.RejectedDatum != 6 || s.FlushedDatum != 11 {
+		t.Errorf("got %v rejected and %v flushed datum, want 6 and 11", s.RejectedDatum, s.FlushedDatum)
+	}
+	if _, err := q.Submit(nil, 1, 0); err != ErrClosed {
+		t.Errorf("got %v, want ErrClosed", err)
+	}
+}
+
+func TestSubmitLargerThanCapacity(t *testing.T) {
+
+	q := New(Config{Capacity: 10, Workers: 2, MaxBatchSize: 10}, func(jobs []*Job) int {
+		return 1
+	})
+	defer q.Close()
+
+	job, err := q.Submit(nil, 50, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-job.Done()
+
+	if s := q.Stats(); s.FailedDatum != 1 || s.FlushedDatum != 49 || s.MaxFlushLatency < s.MeanFlushLatency {
+		t.Errorf("got stats %+v", s)
+	}
+}
@@ -0,0 +1,329 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	ipbts "github.com/bburch01/FOTAAS/internal/pkg/protobuf/timestamp"
+
+	"github.com/bburch01/FOTAAS/api"
+)
+
+// MaxAggregateBuckets caps the total number of buckets (over all series) that a single aggregation
+// may produce so that the response always fits in a grpc message.
+const MaxAggregateBuckets = 100000
+
+type seriesKey struct {
+	constructor api.Constructor
+	carNumber   int32
+	description api.TelemetryDatumDescription
+	channel     string
+}
+
+// RetrieveTelemetryAggregates computes the requested aggregate functions of the telemetry data
+// matching req.Filter in buckets of req.BucketWidthMillis, with one series per car and telemetry
+// datum description or registered channel. Buckets are aligned to the unix epoch and only buckets
+// containing at least one datum are returned. If no aggregate functions are requested, all of them
+// are computed.
+func RetrieveTelemetryAggregates(req api.GetTelemetryAggregatesRequest) ([]*api.TelemetryAggregateSeries, error) {
+
+	if req.BucketWidthMillis <= 0 {
+		return nil, errors.New("bucket width must be greater than 0")
+	}
+
+	functions := make(map[api.AggregateFunction]bool)
+	for _, f := range req.AggregateFunctions {
+		functions[f] = true
+	}
+	all := len(functions) == 0
+
+	series, err := store.RetrieveTelemetryAggregates(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range series {
+		for _, b := range v.Buckets {
+			if !all && !functions[api.AggregateFunction_MIN] {
+				b.Min = 0
+			}
+			if !all && !functions[api.AggregateFunction_MAX] {
+				b.Max = 0
+			}
+			if !all && !functions[api.AggregateFunction_MEAN] {
+				b.Mean = 0
+			}
+			if !all && !functions[api.AggregateFunction_LAST] {
+				b.Last = 0
+			}
+			if !all && !functions[api.AggregateFunction_COUNT] {
+				b.Count = 0
+			}
+		}
+	}
+
+	sort.Slice(series, func(i, j int) bool {
+		if series[i].Constructor != series[j].Constructor {
+			return series[i].Constructor < series[j].Constructor
+		}
+		if series[i].CarNumber != series[j].CarNumber {
+			return series[i].CarNumber < series[j].CarNumber
+		}
+		// The built in channels, which have no channel name, come first.
+		if series[i].Channel != series[j].Channel {
+			return series[i].Channel < series[j].Channel
+		}
+		return series[i].DatumDescription < series[j].DatumDescription
+	})
+
+	return series, nil
+}
+
+// foldTelemetryAggregates computes the aggregates of the telemetry data matching req.Filter in
+// process, from the pages of the telemetry data of s. It is the RetrieveTelemetryAggregates of the
+// stores that cannot aggregate in a query.
+func foldTelemetryAggregates(s TelemetryStore, req api.GetTelemetryAggregatesRequest) ([]*api.TelemetryAggregateSeries, error) {
+
+	width := time.Duration(req.BucketWidthMillis) * time.Millisecond
+
+	var filter api.GetTelemetryDataRequest
+	if req.Filter != nil {
+		filter = *req.Filter
+	}
+	filter.PageSize = MaxPageSize
+	filter.PageToken = ""
+
+	seriesMap := make(map[seriesKey]*api.TelemetryAggregateSeries)
+	var bucketCount int
+
+	// Pages are in timestamp order, so buckets are created in order and the last value folded into a
+	// bucket is the latest.
+	for {
+		data, nextPageToken, err := s.RetrieveTelemetryDataPage(filter)
+		if err != nil {
+			return nil, err
+		}
+
+		datums := make([]*api.TelemetryDatum, 0, len(data.TelemetryDatumMap))
+		for _, v := range data.TelemetryDatumMap {
+			datums = append(datums, v)
+		}
+		sort.Slice(datums, func(i, j int) bool {
+			ti, tj := datums[i].Timestamp, datums[j].Timestamp
+			if ti.Seconds != tj.Seconds {
+				return ti.Seconds < tj.Seconds
+			}
+			if ti.Nanos != tj.Nanos {
+				return ti.Nanos < tj.Nanos
+			}
+			return datums[i].Uuid < datums[j].Uuid
+		})
+
+		for _, v := range datums {
+
+			t, err := ipbts.Timestamp(v.Timestamp)
+			if err != nil {
+				return nil, err
+			}
+
+			key := seriesKey{constructor: v.Constructor, carNumber: v.CarNumber, description: v.Description, channel: v.Channel}
+			series, ok := seriesMap[key]
+			if !ok {
+				series = &api.TelemetryAggregateSeries{Constructor: v.Constructor, CarNumber: v.CarNumber,
+					DatumDescription: v.Description, Unit: v.Unit, Channel: v.Channel, ChannelUnit: v.ChannelUnit}
+				seriesMap[key] = series
+			}
+
+			begin := time.Unix(0, t.UnixNano()/int64(width)*int64(width)).UTC()
+
+			var bucket *api.TelemetryAggregateBucket
+			if n := len(series.Buckets); n > 0 {
+				last, err := ipbts.Timestamp(series.Buckets[n-1].BucketBegin)
+				if err != nil {
+					return nil, err
+				}
+				if last.Equal(begin) {
+					bucket = series.Buckets[n-1]
+				}
+			}
+			if bucket == nil {
+				if bucketCount++; bucketCount > MaxAggregateBuckets {
+					return nil, fmt.Errorf("aggregation exceeds %v buckets, use a wider bucket or a narrower filter", MaxAggregateBuckets)
+				}
+				ts, err := ipbts.TimestampProto(begin)
+				if err != nil {
+					return nil, err
+				}
+				bucket = &api.TelemetryAggregateBucket{BucketBegin: ts, Min: v.Value, Max: v.Value}
+				series.Buckets = append(series.Buckets, bucket)
+			}
+
+			if v.Value < bucket.Min {
+				bucket.Min = v.Value
+			}
+			if v.Value > bucket.Max {
+				bucket.Max = v.Value
+			}
+			// Mean holds the running sum until all of the data has been folded in.
+			bucket.Mean += v.Value
+			bucket.Last = v.Value
+			bucket.Count++
+		}
+
+		if nextPageToken == "" {
+			break
+		}
+		filter.PageToken = nextPageToken
+	}
+
+	series := make([]*api.TelemetryAggregateSeries, 0, len(seriesMap))
+	for _, v := range seriesMap {
+		for _, b := range v.Buckets {
+			b.Mean = b.Mean / float64(b.Count)
+		}
+		series = append(series, v)
+	}
+
+	return series, nil
+}
+
+func (s *memoryStore) RetrieveTelemetryAggregates(req api.GetTelemetryAggregatesRequest) ([]*api.TelemetryAggregateSeries, error) {
+	return foldTelemetryAggregates(s, req)
+}
+
+func (s *columnarStore) RetrieveTelemetryAggregates(req api.GetTelemetryAggregatesRequest) ([]*api.TelemetryAggregateSeries, error) {
+	return foldTelemetryAggregates(s, req)
+}
+
+func (s *sqlStore) RetrieveTelemetryAggregates(req api.GetTelemetryAggregatesRequest) ([]*api.TelemetryAggregateSeries, error) {
+
+	var filter api.GetTelemetryDataRequest
+	if req.Filter != nil {
+		filter = *req.Filter
+	}
+
+	q, err := newTelemetryQuery(filter)
+	if err != nil {
+		return nil, err
+	}
+	where := q.whereClause()
+
+	// bucket is the beginning of the bucket of a datum in unix milliseconds, and nanos the unix
+	// nanoseconds of the datum.
+	millis := "(" + s.dialect.epochSeconds + " * 1000 + timestamp_nanos " + s.dialect.div + " 1000000)"
+	bucket := "(" + millis + " " + s.dialect.div + " ?) * ?"
+	nanos := "(" + s.dialect.epochSeconds + " * 1000000000 + timestamp_nanos)"
+	width := req.BucketWidthMillis
+
+	// One more bucket than the maximum is selected to find out whether the maximum is exceeded.
+	query := "select constructor, car_number, description, unit, " + bucket + " as bucket, min(value), max(value), " +
+		"avg(value), count(*) from telemetry_datum" + where +
+		" group by constructor, car_number, description, unit, bucket order by bucket limit ?"
+	args := append([]interface{}{width, width}, q.args...)
+	args = append(args, MaxAggregateBuckets+1)
+
+	logger.Debug(fmt.Sprintf("select sql: %v", query))
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to retrieve telemetry aggregates with error: %v", err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	type seriesID struct {
+		constructor string
+		carNumber   int32
+		description string
+	}
+	type bucketID struct {
+		series seriesID
+		begin  int64
+	}
+
+	seriesMap := make(map[seriesID]*api.TelemetryAggregateSeries)
+	buckets := make(map[bucketID]*api.TelemetryAggregateBucket)
+
+	for rows.Next() {
+
+		var id seriesID
+		var unit string
+		var begin int64
+		b := new(api.TelemetryAggregateBucket)
+
+		if err = rows.Scan(&id.constructor, &id.carNumber, &id.description, &unit, &begin, &b.Min, &b.Max, &b.Mean,
+			&b.Count); err != nil {
+			return nil, err
+		}
+
+		if len(buckets) == MaxAggregateBuckets {
+			return nil, fmt.Errorf("aggregation exceeds %v buckets, use a wider bucket or a narrower filter", MaxAggregateBuckets)
+		}
+
+		series, ok := seriesMap[id]
+		if !ok {
+			var datum api.TelemetryDatum
+			if err = setDatumChannel(&datum, id.description, unit); err != nil {
+				return nil, err
+			}
+			ordinal, ok := api.Constructor_value[id.constructor]
+			if !ok {
+				return nil, fmt.Errorf("invalid constructor enum: %v", id.constructor)
+			}
+			series = &api.TelemetryAggregateSeries{Constructor: api.Constructor(ordinal), CarNumber: id.carNumber,
+				DatumDescription: datum.Description, Unit: datum.Unit, Channel: datum.Channel, ChannelUnit: datum.ChannelUnit}
+			seriesMap[id] = series
+		}
+
+		if b.BucketBegin, err = ipbts.TimestampProto(time.Unix(0, begin*int64(time.Millisecond)).UTC()); err != nil {
+			return nil, err
+		}
+		series.Buckets = append(series.Buckets, b)
+		buckets[bucketID{series: id, begin: begin}] = b
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	// The last value of a bucket is the value of its latest datum, of the one with the greatest id
+	// among the datum with the latest timestamp.
+	query = "select constructor, car_number, description, l_bucket, value from telemetry_datum join " +
+		"(select constructor as l_constructor, car_number as l_car_number, description as l_description, " +
+		bucket + " as l_bucket, max(" + nanos + ") as l_nanos from telemetry_datum" + where +
+		" group by constructor, car_number, description, l_bucket) l on constructor = l_constructor and " +
+		"car_number = l_car_number and description = l_description and " + nanos + " = l_nanos" + where +
+		" order by id"
+	args = append([]interface{}{width, width}, q.args...)
+	args = append(args, q.args...)
+
+	logger.Debug(fmt.Sprintf("select sql: %v", query))
+	if rows, err = s.db.Query(query, args...); err != nil {
+		logger.Error(fmt.Sprintf("failed to retrieve telemetry aggregates with error: %v", err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id bucketID
+		var value float64
+		if err = rows.Scan(&id.series.constructor, &id.series.carNumber, &id.series.description, &id.begin,
+			&value); err != nil {
+			return nil, err
+		}
+		if b, ok := buckets[id]; ok {
+			b.Last = value
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	series := make([]*api.TelemetryAggregateSeries, 0, len(seriesMap))
+	for _, v := range seriesMap {
+		series = append(series, v)
+	}
+
+	return series, nil
+}
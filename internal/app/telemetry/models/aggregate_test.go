@@ -0,0 +1,118 @@
+package models
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	ipbts "github.com/bburch01/FOTAAS/internal/pkg/protobuf/timestamp"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/google/uuid"
+)
+
+func TestRetrieveTelemetryAggregates(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "fotaas-columnar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	columnar := newTestColumnarStore(t, dir, 4)
+	defer columnar.close()
+
+	// The sql stores aggregate in the query, the others fold the telemetry data in process.
+	for _, s := range []TelemetryStore{newMemoryStore(), newTestSQLiteStore(t), columnar} {
+		testRetrieveTelemetryAggregates(t, s)
+	}
+}
+
+func testRetrieveTelemetryAggregates(t *testing.T, s TelemetryStore) {
+
+	saved := store
+	store = s
+	defer func() { store = saved }()
+
+	simID := uuid.New().String()
+	start := time.Date(2019, 7, 14, 12, 0, 0, 0, time.UTC)
+
+	// Two cars, one channel, a sample every 250ms for 2 seconds.
+	var data []TelemetryDatum
+	for _, carNumber := range []int32{44, 77} {
+		for i := 0; i < 8; i++ {
+			ts, err := ipbts.TimestampProto(start.Add(time.Duration(i) * 250 * time.Millisecond))
+			if err != nil {
+				t.Error("failed to create timestamp with error: ", err)
+				t.FailNow()
+			}
+			data = append(data, NewFromTelemetryDatum(&api.TelemetryDatum{Uuid: uuid.New().String(), Simulated: true,
+				SimulationUuid: simID, GranPrix: api.GranPrix_GERMAN, Track: api.Track_HOCKENHEIM,
+				Constructor: api.Constructor_MERCEDES, CarNumber: carNumber, Timestamp: ts,
+				Description: api.TelemetryDatumDescription_SPEED, Unit: api.TelemetryDatumUnit_KPH, Value: float64(i)}))
+		}
+	}
+
+	if _, err := store.CreateTelemetryData(data); err != nil {
+		t.Error("failed to create telemetry data with error: ", err)
+		t.FailNow()
+	}
+
+	req := api.GetTelemetryAggregatesRequest{Filter: &api.GetTelemetryDataRequest{SimulationUuid: simID},
+		BucketWidthMillis: 1000, AggregateFunctions: []api.AggregateFunction{api.AggregateFunction_MEAN,
+			api.AggregateFunction_LAST, api.AggregateFunction_COUNT}}
+
+	series, err := RetrieveTelemetryAggregates(req)
+	if err != nil {
+		t.Error("failed to retrieve telemetry aggregates with error: ", err)
+		t.FailNow()
+	}
+
+	if len(series) != 2 || series[0].CarNumber != 44 || series[1].CarNumber != 77 {
+		t.Errorf("unexpected telemetry aggregate series: %v", series)
+		t.FailNow()
+	}
+
+	for _, s := range series {
+		if len(s.Buckets) != 2 {
+			t.Errorf("expected 2 buckets, got %v", len(s.Buckets))
+			continue
+		}
+		first, second := s.Buckets[0], s.Buckets[1]
+		if first.Count != 4 || first.Mean != 1.5 || first.Last != 3 || first.Max != 0 {
+			t.Errorf("unexpected first bucket: %v", first)
+		}
+		if second.Count != 4 || second.Mean != 5.5 || second.Last != 7 {
+			t.Errorf("unexpected second bucket: %v", second)
+		}
+	}
+
+	// Every aggregate function, of the car 44 data in buckets of 600ms.
+	req = api.GetTelemetryAggregatesRequest{Filter: &api.GetTelemetryDataRequest{SimulationUuid: simID,
+		SearchBy: &api.GetTelemetryDataRequest_SearchBy{CarNumber: true}, CarNumber: 44}, BucketWidthMillis: 600}
+	if series, err = RetrieveTelemetryAggregates(req); err != nil {
+		t.Fatal("failed to retrieve telemetry aggregates with error: ", err)
+	}
+	if len(series) != 1 || series[0].CarNumber != 44 || series[0].DatumDescription != api.TelemetryDatumDescription_SPEED ||
+		series[0].Unit != api.TelemetryDatumUnit_KPH || len(series[0].Buckets) != 3 {
+		t.Fatalf("unexpected telemetry aggregate series: %v", series)
+	}
+	for i, v := range []struct {
+		begin                time.Duration
+		count                int64
+		min, max, mean, last float64
+	}{{0, 3, 0, 2, 1, 2}, {600 * time.Millisecond, 2, 3, 4, 3.5, 4}, {1200 * time.Millisecond, 3, 5, 7, 6, 7}} {
+		b := series[0].Buckets[i]
+		begin, _ := ipbts.Timestamp(b.BucketBegin)
+		if !begin.Equal(start.Add(v.begin)) || b.Count != v.count || b.Min != v.min || b.Max != v.max ||
+			b.Mean != v.mean || b.Last != v.last {
+			t.Errorf("expected bucket %v, got %v", v, b)
+		}
+	}
+
+	req.BucketWidthMillis = 0
+	if _, err = RetrieveTelemetryAggregates(req); err == nil {
+		t.Error("expected an error for a zero bucket width")
+	}
+}
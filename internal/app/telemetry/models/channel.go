@@ -0,0 +1,149 @@
+package models
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/bburch01/FOTAAS/internal/app/telemetry/channel"
+)
+
+// ErrChannelConflict is returned when a channel with the same name already exists.
+var ErrChannelConflict = errors.New("a channel with the same name already exists")
+
+// RegisterChannel validates c and adds it to the channel registry.
+func RegisterChannel(c *api.Channel) (*api.Channel, error) {
+
+	if err := channel.Validate(c); err != nil {
+		return nil, err
+	}
+
+	n := proto.Clone(c).(*api.Channel)
+	n.BuiltIn = false
+
+	if err := store.CreateChannel(n); err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+// RetrieveChannels retrieves the registered channels, the built in channels are not persisted.
+func RetrieveChannels() ([]*api.Channel, error) {
+	return store.RetrieveChannels()
+}
+
+// setDatumChannel sets the channel of datum from its persisted description and unit. A description
+// that is not a TelemetryDatumDescription is the name of a registered channel.
+func setDatumChannel(datum *api.TelemetryDatum, description string, unit string) error {
+
+	ordinal, ok := api.TelemetryDatumDescription_value[description]
+	if !ok {
+		datum.Channel = description
+		datum.ChannelUnit = unit
+		return nil
+	}
+	datum.Description = api.TelemetryDatumDescription(ordinal)
+
+	if ordinal, ok = api.TelemetryDatumUnit_value[unit]; !ok {
+		return fmt.Errorf("invalid telemetry datum unit enum: %v", unit)
+	}
+	datum.Unit = api.TelemetryDatumUnit(ordinal)
+
+	return nil
+}
+
+// channelName returns the name of the channel of v, its description for a built in channel.
+func channelName(v *api.TelemetryDatum) string {
+	if v.Channel != "" {
+		return v.Channel
+	}
+	return v.Description.String()
+}
+
+func (s *sqlStore) CreateChannel(c *api.Channel) error {
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var name string
+	err = tx.QueryRow("select name from channel where name = ?", c.Name).Scan(&name)
+	switch {
+	case err == nil:
+		return ErrChannelConflict
+	case err != sql.ErrNoRows:
+		return err
+	}
+
+	if _, err = tx.Exec("INSERT INTO channel (name, unit, min_value, max_value, category) VALUES (?, ?, ?, ?, ?)",
+		c.Name, c.Unit, c.MinValue, c.MaxValue, c.Category.String()); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *sqlStore) RetrieveChannels() ([]*api.Channel, error) {
+
+	rows, err := s.db.Query("select name, unit, min_value, max_value, category from channel")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var channels []*api.Channel
+	for rows.Next() {
+		var c api.Channel
+		var category string
+		if err = rows.Scan(&c.Name, &c.Unit, &c.MinValue, &c.MaxValue, &category); err != nil {
+			return nil, err
+		}
+		ordinal, ok := api.ChannelCategory_value[category]
+		if !ok {
+			return nil, fmt.Errorf("invalid channel category enum: %v", category)
+		}
+		c.Category = api.ChannelCategory(ordinal)
+		channels = append(channels, &c)
+	}
+
+	return channels, rows.Err()
+}
+
+func (s *memoryStore) CreateChannel(c *api.Channel) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.channels[c.Name]; ok {
+		return ErrChannelConflict
+	}
+	s.channels[c.Name] = proto.Clone(c).(*api.Channel)
+
+	return nil
+}
+
+func (s *memoryStore) RetrieveChannels() ([]*api.Channel, error) {
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	channels := make([]*api.Channel, 0, len(s.channels))
+	for _, v := range s.channels {
+		channels = append(channels, proto.Clone(v).(*api.Channel))
+	}
+
+	return channels, nil
+}
+
+// deleteChannel removes the channel called name, it undoes a CreateChannel that could not be saved.
+func (s *memoryStore) deleteChannel(name string) {
+	s.mu.Lock()
+	delete(s.channels, name)
+	s.mu.Unlock()
+}
@@ -0,0 +1,112 @@
+package models
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	ipbts "github.com/bburch01/FOTAAS/internal/pkg/protobuf/timestamp"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/bburch01/FOTAAS/internal/app/telemetry/channel"
+	"github.com/google/uuid"
+)
+
+func TestChannelRegistry(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "fotaas-columnar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	columnar := newTestColumnarStore(t, dir, 0)
+
+	for _, s := range []TelemetryStore{newMemoryStore(), newTestSQLiteStore(t), columnar} {
+		testChannelRegistry(t, s)
+	}
+
+	// The registered channels of the columnar store survive a restart.
+	columnar.close()
+	reopened := newTestColumnarStore(t, dir, 0)
+	defer reopened.close()
+	if channels, err := reopened.RetrieveChannels(); err != nil || len(channels) != 1 {
+		t.Errorf("expected 1 registered channel after a restart, got %v with error: %v", len(channels), err)
+	}
+}
+
+func testChannelRegistry(t *testing.T, s TelemetryStore) {
+
+	saved := store
+	store = s
+	defer func() { store = saved }()
+
+	boost := &api.Channel{Name: "TURBO_BOOST_PRESSURE", Unit: "kPa", MinValue: 0, MaxValue: 400,
+		Category: api.ChannelCategory_POWER_UNIT, BuiltIn: true}
+
+	registered, err := RegisterChannel(boost)
+	if err != nil {
+		t.Error("failed to register channel with error: ", err)
+		t.FailNow()
+	}
+	if registered.BuiltIn {
+		t.Error("expected a registered channel not to be built in")
+	}
+	if _, err = RegisterChannel(boost); err != ErrChannelConflict {
+		t.Errorf("expected ErrChannelConflict, got %v", err)
+	}
+	if _, err = RegisterChannel(&api.Channel{Name: "SPEED", Unit: "KPH"}); err == nil {
+		t.Error("expected a built in channel name to be rejected")
+	}
+
+	channels, err := RetrieveChannels()
+	if err != nil || len(channels) != 1 || channels[0].Name != boost.Name || channels[0].Unit != boost.Unit ||
+		channels[0].MaxValue != boost.MaxValue || channels[0].Category != boost.Category {
+		t.Errorf("expected the registered channel, got %v with error: %v", channels, err)
+	}
+
+	// A datum of the registered channel and one of a built in channel given by name.
+	registry := channel.NewRegistry(channels)
+	ts, _ := ipbts.TimestampProto(time.Date(2019, 7, 14, 14, 10, 0, 0, time.UTC))
+	simID := uuid.New().String()
+	var data []TelemetryDatum
+	for _, name := range []string{boost.Name, "ENGINE_RPM"} {
+		datum := &api.TelemetryDatum{Uuid: uuid.New().String(), Simulated: true, SimulationUuid: simID,
+			GranPrix: api.GranPrix_BRITISH, Track: api.Track_SILVERSTONE, Constructor: api.Constructor_WILLIAMS,
+			CarNumber: 63, Timestamp: ts, Channel: name, Value: 250}
+		if err = registry.Resolve(datum); err != nil {
+			t.Error("failed to resolve telemetry datum channel with error: ", err)
+			t.FailNow()
+		}
+		data = append(data, NewFromTelemetryDatum(datum))
+	}
+	if _, err = s.CreateTelemetryData(data); err != nil {
+		t.Error("failed to create telemetry data with error: ", err)
+		t.FailNow()
+	}
+
+	req := api.GetTelemetryDataRequest{SimulationUuid: simID, SearchBy: &api.GetTelemetryDataRequest_SearchBy{},
+		Channels: []string{boost.Name}}
+	retrieved, err := s.RetrieveTelemetryData(req)
+	if err != nil {
+		t.Error("failed to retrieve telemetry data with error: ", err)
+		t.FailNow()
+	}
+	if len(retrieved.TelemetryDatumMap) != 1 {
+		t.Fatalf("expected 1 telemetry datum of channel %v, got %v", boost.Name, len(retrieved.TelemetryDatumMap))
+	}
+	if v := retrieved.TelemetryDatumMap[data[0].ID]; v == nil || v.Channel != boost.Name || v.ChannelUnit != boost.Unit {
+		t.Errorf("expected telemetry datum of channel %v in %v, got %v", boost.Name, boost.Unit, v)
+	}
+
+	// The built in channel was stored as its description.
+	req.Channels = []string{"ENGINE_RPM"}
+	if retrieved, err = s.RetrieveTelemetryData(req); err != nil || len(retrieved.TelemetryDatumMap) != 1 {
+		t.Fatalf("expected 1 ENGINE_RPM telemetry datum, got %v with error: %v", retrieved, err)
+	}
+	if v := retrieved.TelemetryDatumMap[data[1].ID]; v == nil || v.Channel != "" ||
+		v.Description != api.TelemetryDatumDescription_ENGINE_RPM || v.Unit != api.TelemetryDatumUnit_RPM {
+		t.Errorf("expected an ENGINE_RPM telemetry datum, got %v", v)
+	}
+}
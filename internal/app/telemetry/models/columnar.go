@@ -0,0 +1,1217 @@
+package models
+
+import (
+	"bytes"
+	"container/heap"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/bburch01/FOTAAS/internal/pkg/migrate"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultColumnarPath      = "fotaas-telemetry-columnar"
+	defaultColumnarBlockSize = 1024
+	// columnarWALLimit is the write ahead log size at which every series head is written out as a
+	// block and the log is truncated.
+	columnarWALLimit = 64 * 1024 * 1024
+	// columnarFrameHeaderSize is the size of the length and crc that precede a block or a write ahead
+	// log record.
+	columnarFrameHeaderSize = 8
+	columnarSeriesMagic     = "FOTAAS-SERIES-1\n"
+	columnarSeriesExt       = ".series"
+	columnarWALName         = "wal"
+	columnarThresholdsName  = "alarm_thresholds.jsonl"
+	columnarChannelsName    = "channels.jsonl"
+)
+
+var columnarCRCTable = crc32.MakeTable(crc32.Castagnoli)
+
+// errCorruptFrame is returned when a block or write ahead log record fails its crc check or is cut
+// short, e.g. by a crash during a write.
+var errCorruptFrame = errors.New("corrupt telemetry store frame")
+
+// columnarStore is a TelemetryStore for high sample rate telemetry. Each series, the telemetry data
+// of one simulation (or real session), car and channel, is a file of blocks of time ordered datum
+// in which the timestamps and values are gorilla compressed. The fields that every datum of a
+// series shares (gran prix, track, constructor, unit, ...) are only stored once, in the series file
+// header.
+//
+// New datum are appended to a write ahead log, which makes them durable, and buffered in the head
+// of their series. A head is sorted and written out as a block once it holds blockSize datum, and
+// every head is written out (and the log truncated) when the log reaches columnarWALLimit. The log
+// is replayed when the store is opened.
+//
+// The uuid of every persisted datum is indexed in memory, the index is rebuilt from the series
+// files when the store is opened.
+type columnarStore struct {
+	dir       string
+	blockSize int
+
+	mu      sync.RWMutex
+	series  map[columnarSeriesKey]*columnarSeries
+	index   map[uuid.UUID]columnarRef
+	wal     *os.File
+	walSize int64
+
+	// The alarm threshold registry is small, it is held by a memory store and saved to a file on
+	// every change.
+	thresholdMu sync.Mutex
+	thresholds  *memoryStore
+	// The channel registry is held and saved the same way.
+	channelMu sync.Mutex
+	channels  *memoryStore
+}
+
+type columnarSeries struct {
+	key    columnarSeriesKey
+	path   string
+	size   int64
+	blocks []columnarBlockRef
+	head   []columnarDatum
+}
+
+type columnarBlockRef struct {
+	// offset is the file offset of the block, after its frame header.
+	offset int64
+	length int
+	count  int
+	minT   int64
+	maxT   int64
+}
+
+// columnarRef locates a persisted datum, block is -1 for a datum in the series head.
+type columnarRef struct {
+	series *columnarSeries
+	block  int
+}
+
+// openColumnarStore opens (creating it if necessary) the columnar telemetry store in dir.
+func openColumnarStore(dir string, blockSize int) (*columnarStore, error) {
+
+	if dir == "" {
+		dir = defaultColumnarPath
+	}
+	if blockSize <= 0 {
+		blockSize = defaultColumnarBlockSize
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	s := &columnarStore{dir: dir, blockSize: blockSize, series: make(map[columnarSeriesKey]*columnarSeries),
+		index: make(map[uuid.UUID]columnarRef), thresholds: newMemoryStore(), channels: newMemoryStore()}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		path := filepath.Join(dir, f.Name())
+		switch {
+		case strings.HasSuffix(f.Name(), ".tmp"):
+			// Left behind by a crash during a series rewrite, the original is intact.
+			if err = os.Remove(path); err != nil {
+				return nil, err
+			}
+		case strings.HasSuffix(f.Name(), columnarSeriesExt):
+			if err = s.loadSeries(path); err != nil {
+				return nil, fmt.Errorf("failed to load telemetry series %v with error: %v", path, err)
+			}
+		}
+	}
+
+	if err = s.loadAlarmThresholds(); err != nil {
+		return nil, err
+	}
+	if err = s.loadChannels(); err != nil {
+		return nil, err
+	}
+
+	if s.wal, err = os.OpenFile(filepath.Join(dir, columnarWALName), os.O_RDWR|os.O_CREATE, 0644); err != nil {
+		return nil, err
+	}
+	if err = s.replayWAL(); err != nil {
+		s.wal.Close()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// close writes out the series heads and closes the write ahead log.
+func (s *columnarStore) close() error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err := s.checkpoint()
+	if cerr := s.wal.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+func (s *columnarStore) Ping() error {
+	_, err := os.Stat(s.dir)
+	return err
+}
+
+func (s *columnarStore) CreateTelemetryData(data []TelemetryDatum) (map[string]IngestStatus, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	persisted := make(map[string]sql.NullString)
+	blocks := make(map[columnarRef][]columnarDatum)
+	for i := range data {
+		hash, ok, err := s.persistedHash(data[i].ID, blocks)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			persisted[data[i].ID] = sql.NullString{String: hash, Valid: true}
+		}
+	}
+
+	statusMap, inserts, conflict := classifyTelemetryData(data, persisted)
+	if conflict {
+		return statusMap, ErrTelemetryDatumConflict
+	}
+	if len(inserts) == 0 {
+		return statusMap, nil
+	}
+
+	// Convert everything before storing anything so that a bad datum leaves the store unchanged.
+	keys := make([]columnarSeriesKey, len(inserts))
+	converted := make([]columnarDatum, len(inserts))
+	record := api.TelemetryData{TelemetryDatumMap: make(map[string]*api.TelemetryDatum, len(inserts))}
+	for i := range inserts {
+		datum, err := inserts[i].toProto()
+		if err != nil {
+			return nil, err
+		}
+		if converted[i], err = newColumnarDatum(&inserts[i]); err != nil {
+			return nil, err
+		}
+		keys[i] = newColumnarSeriesKey(&inserts[i])
+		record.TelemetryDatumMap[datum.Uuid] = datum
+	}
+
+	if err := s.appendWAL(&record); err != nil {
+		return nil, err
+	}
+
+	full := make(map[*columnarSeries]bool)
+	for i := range converted {
+		series := s.addToHead(keys[i], converted[i])
+		if len(series.head) >= s.blockSize {
+			full[series] = true
+		}
+	}
+
+	// The datum are durable in the write ahead log, so a failure to write out a head is logged
+	// rather than returned and the head is written out later.
+	for series := range full {
+		if err := s.flushHead(series); err != nil {
+			logger.Error(fmt.Sprintf("failed to write telemetry series %v block with error: %v", series.path, err))
+		}
+	}
+	if s.walSize >= columnarWALLimit {
+		if err := s.checkpoint(); err != nil {
+			logger.Error(fmt.Sprintf("failed to checkpoint telemetry write ahead log with error: %v", err))
+		}
+	}
+
+	return statusMap, nil
+}
+
+// persistedHash returns the content hash of the persisted datum with uuid id. blocks caches the
+// decoded blocks across calls.
+func (s *columnarStore) persistedHash(id string, blocks map[columnarRef][]columnarDatum) (string, bool, error) {
+
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return "", false, nil
+	}
+	ref, ok := s.index[parsed]
+	if !ok {
+		return "", false, nil
+	}
+
+	data := ref.series.head
+	if ref.block >= 0 {
+		if data, ok = blocks[ref]; !ok {
+			if data, err = ref.series.readBlock(nil, ref.block); err != nil {
+				return "", false, err
+			}
+			blocks[ref] = data
+		}
+	}
+
+	for _, v := range data {
+		if v.id == parsed {
+			td := ref.series.key.telemetryDatum(v)
+			return td.ContentHash(), true, nil
+		}
+	}
+
+	return "", false, fmt.Errorf("telemetry datum %v is missing from telemetry series %v", id, ref.series.path)
+}
+
+func (s *columnarStore) addToHead(key columnarSeriesKey, v columnarDatum) *columnarSeries {
+
+	series, ok := s.series[key]
+	if !ok {
+		keyJSON, _ := json.Marshal(key)
+		sum := sha256.Sum256(keyJSON)
+		series = &columnarSeries{key: key, path: filepath.Join(s.dir, hex.EncodeToString(sum[:16])+columnarSeriesExt)}
+		s.series[key] = series
+	}
+
+	series.head = append(series.head, v)
+	s.index[v.id] = columnarRef{series: series, block: -1}
+
+	return series
+}
+
+// flushHead writes the head of series out as a block.
+func (s *columnarStore) flushHead(series *columnarSeries) error {
+
+	if len(series.head) == 0 {
+		return nil
+	}
+
+	sortColumnarData(series.head)
+	body := encodeColumnarBlock(series.head)
+
+	var b []byte
+	if series.size == 0 {
+		b = series.header()
+	}
+	b = append(b, frame(body)...)
+
+	f, err := os.OpenFile(series.path, os.O_WRONLY|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err = f.WriteAt(b, series.size); err == nil {
+		err = f.Sync()
+	}
+	if err != nil {
+		// Drop the partial block, a block that is cut short would also be dropped on open.
+		f.Truncate(series.size)
+		f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	if series.size == 0 {
+		if err = syncDir(s.dir); err != nil {
+			return err
+		}
+	}
+
+	ref := columnarBlockRef{offset: series.size + int64(len(b)-len(body)), length: len(body), count: len(series.head),
+		minT: series.head[0].timestamp, maxT: series.head[len(series.head)-1].timestamp}
+	series.blocks = append(series.blocks, ref)
+	series.size += int64(len(b))
+	for _, v := range series.head {
+		s.index[v.id] = columnarRef{series: series, block: len(series.blocks) - 1}
+	}
+	series.head = nil
+
+	return nil
+}
+
+// checkpoint writes out every series head and truncates the write ahead log.
+func (s *columnarStore) checkpoint() error {
+
+	for _, series := range s.series {
+		if err := s.flushHead(series); err != nil {
+			return err
+		}
+	}
+
+	if err := s.wal.Truncate(0); err != nil {
+		return err
+	}
+	s.walSize = 0
+
+	return s.wal.Sync()
+}
+
+func (s *columnarStore) appendWAL(record *api.TelemetryData) error {
+
+	body, err := proto.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	b := frame(body)
+	if _, err = s.wal.WriteAt(b, s.walSize); err == nil {
+		err = s.wal.Sync()
+	}
+	if err != nil {
+		s.wal.Truncate(s.walSize)
+		return err
+	}
+	s.walSize += int64(len(b))
+
+	return nil
+}
+
+// replayWAL adds the datum in the write ahead log that are not in a series file to their series
+// heads, then checkpoints. A record cut short by a crash is dropped, it was never acknowledged.
+func (s *columnarStore) replayWAL() error {
+
+	b, err := ioutil.ReadFile(s.wal.Name())
+	if err != nil {
+		return err
+	}
+
+	var replayed int
+	var offset int
+	for offset < len(b) {
+		body, n, err := readFrame(b[offset:])
+		if err != nil {
+			logger.Warn(fmt.Sprintf("dropped %v bytes cut short at the end of telemetry write ahead log", len(b)-offset))
+			break
+		}
+		offset += n
+
+		var record api.TelemetryData
+		if err = proto.Unmarshal(body, &record); err != nil {
+			return fmt.Errorf("failed to replay telemetry write ahead log with error: %v", err)
+		}
+		for _, v := range record.TelemetryDatumMap {
+			datum := NewFromTelemetryDatum(v)
+			converted, err := newColumnarDatum(&datum)
+			if err != nil {
+				return fmt.Errorf("failed to replay telemetry write ahead log with error: %v", err)
+			}
+			if _, ok := s.index[converted.id]; ok {
+				// Written out before the log was truncated.
+				continue
+			}
+			s.addToHead(newColumnarSeriesKey(&datum), converted)
+			replayed++
+		}
+	}
+
+	if replayed > 0 {
+		logger.Info(fmt.Sprintf("replayed %v telemetry datum from the telemetry write ahead log", replayed))
+	}
+
+	return s.checkpoint()
+}
+
+// loadSeries reads the header and block index of the series file at path and indexes its datum.
+// A block cut short by a crash is truncated away, it was still in the write ahead log.
+func (s *columnarStore) loadSeries(path string) error {
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	key, offset, err := parseColumnarSeriesHeader(b)
+	if err != nil {
+		return err
+	}
+	if _, ok := s.series[key]; ok {
+		return fmt.Errorf("duplicate telemetry series %+v", key)
+	}
+
+	series := &columnarSeries{key: key, path: path}
+
+	for offset < len(b) {
+		body, n, err := readFrame(b[offset:])
+		if err != nil {
+			logger.Warn(fmt.Sprintf("dropped %v bytes cut short at the end of telemetry series %v", len(b)-offset, path))
+			if err = os.Truncate(path, int64(offset)); err != nil {
+				return err
+			}
+			break
+		}
+		h, _, err := decodeColumnarBlockHeader(body)
+		if err != nil {
+			return err
+		}
+		series.blocks = append(series.blocks, columnarBlockRef{offset: int64(offset + columnarFrameHeaderSize),
+			length: len(body), count: h.count, minT: h.minT, maxT: h.maxT})
+		for _, id := range h.ids {
+			s.index[id] = columnarRef{series: series, block: len(series.blocks) - 1}
+		}
+		offset += n
+	}
+
+	series.size = int64(offset)
+	s.series[key] = series
+
+	return nil
+}
+
+func (series *columnarSeries) header() []byte {
+	keyJSON, _ := json.Marshal(series.key)
+	b := append([]byte(columnarSeriesMagic), 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(b[len(columnarSeriesMagic):], uint32(len(keyJSON)))
+	return append(b, keyJSON...)
+}
+
+func parseColumnarSeriesHeader(b []byte) (columnarSeriesKey, int, error) {
+
+	var key columnarSeriesKey
+
+	n := len(columnarSeriesMagic)
+	if len(b) < n+4 || string(b[:n]) != columnarSeriesMagic {
+		return key, 0, errors.New("not a telemetry series file")
+	}
+	length := int(binary.BigEndian.Uint32(b[n:]))
+	if len(b) < n+4+length {
+		return key, 0, errors.New("telemetry series file header cut short")
+	}
+	if err := json.Unmarshal(b[n+4:n+4+length], &key); err != nil {
+		return key, 0, err
+	}
+
+	return key, n + 4 + length, nil
+}
+
+// readBlock reads and decodes block i of series. f is the open series file, if nil the file is
+// opened for the read.
+func (series *columnarSeries) readBlock(f *os.File, i int) ([]columnarDatum, error) {
+
+	if f == nil {
+		var err error
+		if f, err = os.Open(series.path); err != nil {
+			return nil, err
+		}
+		defer f.Close()
+	}
+
+	ref := series.blocks[i]
+	body := make([]byte, ref.length)
+	if _, err := f.ReadAt(body, ref.offset); err != nil {
+		return nil, err
+	}
+
+	return decodeColumnarBlock(body)
+}
+
+// purgeSeries rewrites series without its datum with a timestamp before before and returns the number
+// deleted. A block that ends before before is dropped and a block that starts at or after it is
+// copied as is, neither is decoded. Only a block that spans before is decoded and written out again.
+// The write ahead log must not hold any datum of the series. An emptied series is removed.
+func (s *columnarStore) purgeSeries(series *columnarSeries, before int64) (int64, error) {
+
+	var purgeable bool
+	for _, ref := range series.blocks {
+		if ref.minT < before {
+			purgeable = true
+			break
+		}
+	}
+	if !purgeable {
+		return 0, nil
+	}
+
+	f, err := os.Open(series.path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	b := series.header()
+	var blocks []columnarBlockRef
+	var blockIDs [][]uuid.UUID
+	var deleted []uuid.UUID
+
+	for _, ref := range series.blocks {
+
+		body := make([]byte, ref.length)
+		if _, err = f.ReadAt(body, ref.offset); err != nil {
+			return 0, err
+		}
+		h, _, err := decodeColumnarBlockHeader(body)
+		if err != nil {
+			return 0, err
+		}
+
+		switch {
+		case ref.maxT < before:
+			deleted = append(deleted, h.ids...)
+			continue
+		case ref.minT < before:
+			data, err := decodeColumnarBlock(body)
+			if err != nil {
+				return 0, err
+			}
+			keep := data[:0]
+			h.ids = h.ids[:0]
+			for _, v := range data {
+				if v.timestamp < before {
+					deleted = append(deleted, v.id)
+				} else {
+					keep = append(keep, v)
+					h.ids = append(h.ids, v.id)
+				}
+			}
+			body = encodeColumnarBlock(keep)
+			ref = columnarBlockRef{count: len(keep), minT: keep[0].timestamp, maxT: keep[len(keep)-1].timestamp}
+		}
+
+		ref.offset = int64(len(b) + columnarFrameHeaderSize)
+		ref.length = len(body)
+		blocks = append(blocks, ref)
+		blockIDs = append(blockIDs, h.ids)
+		b = append(b, frame(body)...)
+	}
+
+	if len(deleted) == 0 {
+		return 0, nil
+	}
+
+	if len(blocks) == 0 {
+		if err = os.Remove(series.path); err != nil && !os.IsNotExist(err) {
+			return 0, err
+		}
+		delete(s.series, series.key)
+	} else {
+		tmp := series.path + ".tmp"
+		out, err := os.Create(tmp)
+		if err != nil {
+			return 0, err
+		}
+		if _, err = out.Write(b); err == nil {
+			err = out.Sync()
+		}
+		if cerr := out.Close(); err == nil {
+			err = cerr
+		}
+		if err == nil {
+			err = os.Rename(tmp, series.path)
+		}
+		if err != nil {
+			os.Remove(tmp)
+			return 0, err
+		}
+	}
+	if err = syncDir(s.dir); err != nil {
+		return 0, err
+	}
+
+	series.blocks = blocks
+	series.size = int64(len(b))
+	for i, ids := range blockIDs {
+		for _, id := range ids {
+			s.index[id] = columnarRef{series: series, block: i}
+		}
+	}
+	for _, id := range deleted {
+		delete(s.index, id)
+	}
+
+	return int64(len(deleted)), nil
+}
+
+// frame prefixes body with its length and crc.
+func frame(body []byte) []byte {
+	b := make([]byte, columnarFrameHeaderSize, columnarFrameHeaderSize+len(body))
+	binary.BigEndian.PutUint32(b, uint32(len(body)))
+	binary.BigEndian.PutUint32(b[4:], crc32.Checksum(body, columnarCRCTable))
+	return append(b, body...)
+}
+
+// readFrame returns the body of the frame at the start of b and the size of the frame.
+func readFrame(b []byte) ([]byte, int, error) {
+	if len(b) < columnarFrameHeaderSize {
+		return nil, 0, errCorruptFrame
+	}
+	length := int(binary.BigEndian.Uint32(b))
+	if len(b) < columnarFrameHeaderSize+length {
+		return nil, 0, errCorruptFrame
+	}
+	body := b[columnarFrameHeaderSize : columnarFrameHeaderSize+length]
+	if crc32.Checksum(body, columnarCRCTable) != binary.BigEndian.Uint32(b[4:]) {
+		return nil, 0, errCorruptFrame
+	}
+	return body, columnarFrameHeaderSize + length, nil
+}
+
+// syncDir makes the creation, removal or renaming of a file in dir durable.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	err = d.Sync()
+	if cerr := d.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+func (s *columnarStore) RetrieveTelemetryData(req api.GetTelemetryDataRequest) (*api.TelemetryData, error) {
+
+	matched, err := s.match(req, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	data := api.TelemetryData{TelemetryDatumMap: make(map[string]*api.TelemetryDatum, len(matched))}
+	for _, v := range matched {
+		data.TelemetryDatumMap[v.datum.Uuid] = v.datum
+	}
+
+	return &data, nil
+}
+
+func (s *columnarStore) RetrieveTelemetryDataPage(req api.GetTelemetryDataRequest) (*api.TelemetryData, string, error) {
+
+	size := pageSize(req)
+
+	var token *pageToken
+	if req.PageToken != "" {
+		var err error
+		if token, err = decodePageToken(req.PageToken); err != nil {
+			return nil, "", err
+		}
+	}
+
+	matched, err := s.match(req, token, size)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Same order as the other stores, by timestamp and then uuid.
+	sort.Slice(matched, func(i, j int) bool { return matched[i].before(matched[j]) })
+
+	data := api.TelemetryData{TelemetryDatumMap: make(map[string]*api.TelemetryDatum, len(matched))}
+	for _, v := range matched {
+		data.TelemetryDatumMap[v.datum.Uuid] = v.datum
+	}
+
+	if len(matched) < size {
+		return &data, "", nil
+	}
+
+	nextPageToken, err := encodePageToken(matched[len(matched)-1].datum)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &data, nextPageToken, nil
+}
+
+type columnarMatch struct {
+	datum     *api.TelemetryDatum
+	timestamp int64
+}
+
+// before reports whether m comes before o in page order.
+func (m columnarMatch) before(o columnarMatch) bool {
+	if m.timestamp != o.timestamp {
+		return m.timestamp < o.timestamp
+	}
+	return m.datum.Uuid < o.datum.Uuid
+}
+
+// columnarMatches collects the datum matched by columnarStore.match. With a limit only the first
+// limit datum in page order are kept, in a heap with the last of them on top.
+type columnarMatches struct {
+	limit int
+	data  []columnarMatch
+}
+
+func (m *columnarMatches) Len() int           { return len(m.data) }
+func (m *columnarMatches) Less(i, j int) bool { return m.data[j].before(m.data[i]) }
+func (m *columnarMatches) Swap(i, j int)      { m.data[i], m.data[j] = m.data[j], m.data[i] }
+
+func (m *columnarMatches) Push(x interface{}) {
+	m.data = append(m.data, x.(columnarMatch))
+}
+
+func (m *columnarMatches) Pop() interface{} {
+	v := m.data[len(m.data)-1]
+	m.data = m.data[:len(m.data)-1]
+	return v
+}
+
+func (m *columnarMatches) add(v columnarMatch) {
+	if m.limit <= 0 {
+		m.data = append(m.data, v)
+		return
+	}
+	heap.Push(m, v)
+	if len(m.data) > m.limit {
+		heap.Pop(m)
+	}
+}
+
+// full reports whether limit datum have been kept, after which only a datum with a timestamp up to
+// last can still be kept.
+func (m *columnarMatches) full() bool {
+	return m.limit > 0 && len(m.data) >= m.limit
+}
+
+func (m *columnarMatches) last() int64 {
+	return m.data[0].timestamp
+}
+
+// columnarSource is a series head (block -1) or block that may hold datum matching a search, minT
+// is its earliest timestamp.
+type columnarSource struct {
+	series *columnarSeries
+	block  int
+	minT   int64
+}
+
+// match returns the datum matching req that come after token (if not nil) in page order, or with
+// a limit greater than 0 the first limit of them in no particular order. Series are selected by
+// their key and blocks by their time range before any datum is decoded. The heads and blocks are
+// visited in order of their earliest timestamp, so with a limit the visit stops at the first one
+// that starts after the last datum kept and a page only decodes the blocks of its time window.
+func (s *columnarStore) match(req api.GetTelemetryDataRequest, token *pageToken, limit int) ([]columnarMatch, error) {
+
+	search, err := newTelemetrySearch(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var tokenT int64
+	var tokenID uuid.UUID
+	if token != nil {
+		tokenT = token.timestamp.UnixNano()
+		tokenID = uuid.MustParse(token.id)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var sources []columnarSource
+
+	for _, series := range s.series {
+
+		if !series.key.matches(search) {
+			continue
+		}
+
+		if len(series.head) > 0 {
+			// The head is not sorted.
+			minT := series.head[0].timestamp
+			for _, v := range series.head[1:] {
+				if v.timestamp < minT {
+					minT = v.timestamp
+				}
+			}
+			sources = append(sources, columnarSource{series: series, block: -1, minT: minT})
+		}
+
+		for i, b := range series.blocks {
+			if token != nil && b.maxT < tokenT {
+				continue
+			}
+			if search.dateRange && (b.maxT < search.start.UnixNano() || b.minT >= search.end.UnixNano()) {
+				continue
+			}
+			sources = append(sources, columnarSource{series: series, block: i, minT: b.minT})
+		}
+	}
+
+	sort.Slice(sources, func(i, j int) bool { return sources[i].minT < sources[j].minT })
+
+	matched := &columnarMatches{limit: limit}
+	templates := make(map[*columnarSeries]*api.TelemetryDatum)
+	files := make(map[*columnarSeries]*os.File)
+	defer func() {
+		for _, f := range files {
+			f.Close()
+		}
+	}()
+
+	for _, src := range sources {
+
+		if matched.full() && src.minT > matched.last() {
+			break
+		}
+
+		series := src.series
+
+		template, ok := templates[series]
+		if !ok {
+			if template, err = series.key.template(); err != nil {
+				return nil, err
+			}
+			templates[series] = template
+		}
+
+		data := series.head
+		if src.block >= 0 {
+			f, ok := files[series]
+			if !ok {
+				if f, err = os.Open(series.path); err != nil {
+					return nil, err
+				}
+				files[series] = f
+			}
+			if data, err = series.readBlock(f, src.block); err != nil {
+				return nil, fmt.Errorf("failed to read telemetry series %v with error: %v", series.path, err)
+			}
+		}
+
+		for _, v := range data {
+			if token != nil && (v.timestamp < tokenT || (v.timestamp == tokenT && bytes.Compare(v.id[:], tokenID[:]) <= 0)) {
+				continue
+			}
+			if matched.full() && v.timestamp > matched.last() {
+				continue
+			}
+			td := series.key.telemetryDatum(v)
+			datum := *template
+			datum.Uuid = td.ID
+			datum.SimulationTransmitSequenceNumber = td.SimulationTransmitSequenceNumber
+			datum.Timestamp = td.Timestamp
+			datum.Latitude, datum.Longitude, datum.Elevation = v.latitude, v.longitude, v.elevation
+			datum.Value, datum.HighAlarm, datum.LowAlarm = v.value, v.hiAlarm, v.loAlarm
+			datum.Lap, datum.Sector, datum.LapDistance = v.lap, v.sector, v.lapDistance
+			if search.matches(&datum, time.Unix(0, v.timestamp)) {
+				matched.add(columnarMatch{datum: &datum, timestamp: v.timestamp})
+			}
+		}
+	}
+
+	return matched.data, nil
+}
+
+// matches reports whether the datum of the series with key can satisfy search, it is the series
+// level part of telemetrySearch.matches.
+func (key columnarSeriesKey) matches(search *telemetrySearch) bool {
+
+	switch {
+	case search.simulationID != "":
+		if key.SimulationID != search.simulationID {
+			return false
+		}
+	case key.Simulated != search.simulated:
+		return false
+	}
+
+	if len(search.constructors) > 0 && !containsString(search.constructors, key.Constructor) {
+		return false
+	}
+	if len(search.carNumbers) > 0 && !containsInt32(search.carNumbers, key.CarNumber) {
+		return false
+	}
+	if len(search.descriptions) > 0 && !containsString(search.descriptions, key.Description) {
+		return false
+	}
+	if search.granPrix != "" && key.GranPrix != search.granPrix {
+		return false
+	}
+	if search.track != "" && key.Track != search.track {
+		return false
+	}
+
+	return true
+}
+
+// purgeable reports whether the datum of the series with key are subject to criteria.
+func (key columnarSeriesKey) purgeable(criteria PurgeCriteria) bool {
+	return key.Simulated == criteria.Simulated && !containsString(criteria.KeepSimulationIDs, key.SimulationID)
+}
+
+func (s *columnarStore) CountTelemetryData(criteria PurgeCriteria) (int64, error) {
+
+	before := criteria.Before.UnixNano()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var count int64
+	for _, series := range s.series {
+		if !series.key.purgeable(criteria) {
+			continue
+		}
+		for _, v := range series.head {
+			if v.timestamp < before {
+				count++
+			}
+		}
+		for i, b := range series.blocks {
+			switch {
+			case b.maxT < before:
+				count += int64(b.count)
+			case b.minT < before:
+				data, err := series.readBlock(nil, i)
+				if err != nil {
+					return count, err
+				}
+				for _, v := range data {
+					if v.timestamp < before {
+						count++
+					}
+				}
+			}
+		}
+	}
+
+	return count, nil
+}
+
+// DeleteTelemetryData deletes the datum matching criteria a whole series at a time, so that each
+// series is rewritten once per purge. It stops once limit datum have been deleted, which may be
+// exceeded by the last series.
+func (s *columnarStore) DeleteTelemetryData(criteria PurgeCriteria, limit int) (int64, error) {
+
+	before := criteria.Before.UnixNano()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// The rewritten series must not have datum left in the write ahead log, or a replay would bring
+	// the deleted datum back.
+	if err := s.checkpoint(); err != nil {
+		return 0, err
+	}
+
+	var count int64
+	for _, series := range s.series {
+		if count >= int64(limit) {
+			break
+		}
+		if !series.key.purgeable(criteria) {
+			continue
+		}
+		deleted, err := s.purgeSeries(series, before)
+		if err != nil {
+			return count, err
+		}
+		count += deleted
+	}
+
+	return count, nil
+}
+
+// Migrate is a no-op, the columnar store's file format is versioned by the series file header.
+func (s *columnarStore) Migrate(dryRun bool) ([]migrate.Migration, error) {
+	return nil, nil
+}
+
+func (s *columnarStore) CreateAlarmThreshold(t *api.AlarmThreshold) error {
+
+	s.thresholdMu.Lock()
+	defer s.thresholdMu.Unlock()
+
+	if err := s.thresholds.CreateAlarmThreshold(t); err != nil {
+		return err
+	}
+	if err := s.saveAlarmThresholds(); err != nil {
+		s.thresholds.DeleteAlarmThreshold(t.Uuid)
+		return err
+	}
+
+	return nil
+}
+
+func (s *columnarStore) RetrieveAlarmThreshold(id string) (*api.AlarmThreshold, error) {
+	return s.thresholds.RetrieveAlarmThreshold(id)
+}
+
+func (s *columnarStore) RetrieveAlarmThresholds() ([]*api.AlarmThreshold, error) {
+	return s.thresholds.RetrieveAlarmThresholds()
+}
+
+func (s *columnarStore) UpdateAlarmThreshold(t *api.AlarmThreshold) error {
+
+	s.thresholdMu.Lock()
+	defer s.thresholdMu.Unlock()
+
+	previous, err := s.thresholds.RetrieveAlarmThreshold(t.Uuid)
+	if err != nil {
+		return err
+	}
+	if err = s.thresholds.UpdateAlarmThreshold(t); err != nil {
+		return err
+	}
+	if err = s.saveAlarmThresholds(); err != nil {
+		s.thresholds.UpdateAlarmThreshold(previous)
+		return err
+	}
+
+	return nil
+}
+
+func (s *columnarStore) DeleteAlarmThreshold(id string) error {
+
+	s.thresholdMu.Lock()
+	defer s.thresholdMu.Unlock()
+
+	previous, err := s.thresholds.RetrieveAlarmThreshold(id)
+	if err != nil {
+		return err
+	}
+	if err = s.thresholds.DeleteAlarmThreshold(id); err != nil {
+		return err
+	}
+	if err = s.saveAlarmThresholds(); err != nil {
+		s.thresholds.CreateAlarmThreshold(previous)
+		return err
+	}
+
+	return nil
+}
+
+// loadAlarmThresholds reads the alarm threshold registry file, one JSON alarm threshold per line.
+func (s *columnarStore) loadAlarmThresholds() error {
+
+	b, err := ioutil.ReadFile(filepath.Join(s.dir, columnarThresholdsName))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, line := range strings.Split(string(b), "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		var t api.AlarmThreshold
+		if err = jsonpb.UnmarshalString(line, &t); err != nil {
+			return fmt.Errorf("failed to load alarm threshold registry with error: %v", err)
+		}
+		if err = s.thresholds.CreateAlarmThreshold(&t); err != nil {
+			return fmt.Errorf("failed to load alarm threshold %v with error: %v", t.Uuid, err)
+		}
+	}
+
+	return nil
+}
+
+// saveAlarmThresholds replaces the alarm threshold registry file.
+func (s *columnarStore) saveAlarmThresholds() error {
+
+	thresholds, err := s.thresholds.RetrieveAlarmThresholds()
+	if err != nil {
+		return err
+	}
+	sort.Slice(thresholds, func(i, j int) bool { return thresholds[i].Uuid < thresholds[j].Uuid })
+
+	messages := make([]proto.Message, len(thresholds))
+	for i, v := range thresholds {
+		messages[i] = v
+	}
+
+	return s.saveJSONLines(columnarThresholdsName, messages)
+}
+
+func (s *columnarStore) CreateChannel(c *api.Channel) error {
+
+	s.channelMu.Lock()
+	defer s.channelMu.Unlock()
+
+	if err := s.channels.CreateChannel(c); err != nil {
+		return err
+	}
+	if err := s.saveChannels(); err != nil {
+		s.channels.deleteChannel(c.Name)
+		return err
+	}
+
+	return nil
+}
+
+func (s *columnarStore) RetrieveChannels() ([]*api.Channel, error) {
+	return s.channels.RetrieveChannels()
+}
+
+// loadChannels reads the channel registry file, one JSON channel per line.
+func (s *columnarStore) loadChannels() error {
+
+	b, err := ioutil.ReadFile(filepath.Join(s.dir, columnarChannelsName))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, line := range strings.Split(string(b), "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		var c api.Channel
+		if err = jsonpb.UnmarshalString(line, &c); err != nil {
+			return fmt.Errorf("failed to load channel registry with error: %v", err)
+		}
+		if err = s.channels.CreateChannel(&c); err != nil {
+			return fmt.Errorf("failed to load channel %v with error: %v", c.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// saveChannels replaces the channel registry file.
+func (s *columnarStore) saveChannels() error {
+
+	channels, err := s.channels.RetrieveChannels()
+	if err != nil {
+		return err
+	}
+	sort.Slice(channels, func(i, j int) bool { return channels[i].Name < channels[j].Name })
+
+	messages := make([]proto.Message, len(channels))
+	for i, v := range channels {
+		messages[i] = v
+	}
+
+	return s.saveJSONLines(columnarChannelsName, messages)
+}
+
+// saveJSONLines replaces the file name in the store's directory with messages, one JSON message per
+// line.
+func (s *columnarStore) saveJSONLines(name string, messages []proto.Message) error {
+
+	var sb strings.Builder
+	var m jsonpb.Marshaler
+	for _, v := range messages {
+		line, err := m.MarshalToString(v)
+		if err != nil {
+			return err
+		}
+		sb.WriteString(line)
+		sb.WriteString("\n")
+	}
+
+	path := filepath.Join(s.dir, name)
+	if err := ioutil.WriteFile(path+".tmp", []byte(sb.String()), 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(path+".tmp", path); err != nil {
+		return err
+	}
+
+	return syncDir(s.dir)
+}
@@ -0,0 +1,323 @@
+package models
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	ipbts "github.com/bburch01/FOTAAS/internal/pkg/protobuf/timestamp"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/bburch01/FOTAAS/internal/pkg/compress/gorilla"
+	"github.com/google/uuid"
+)
+
+// errCorruptBlock is returned when a columnar block does not decode.
+var errCorruptBlock = errors.New("corrupt telemetry series block")
+
+// columnarDatum is a telemetry datum of a columnar series, the fields that are the same for every
+// datum of the series are in the series key.
+type columnarDatum struct {
+	id          uuid.UUID
+	timestamp   int64
+	value       float64
+	latitude    float64
+	longitude   float64
+	elevation   float64
+	sequenceNum int32
+	hiAlarm     bool
+	loAlarm     bool
+	lap         int32
+	sector      int32
+	lapDistance float64
+}
+
+func sortColumnarData(data []columnarDatum) {
+	sort.Slice(data, func(i, j int) bool {
+		if data[i].timestamp != data[j].timestamp {
+			return data[i].timestamp < data[j].timestamp
+		}
+		// The byte order of uuids is the order of their strings, which is the page order.
+		return bytes.Compare(data[i].id[:], data[j].id[:]) < 0
+	})
+}
+
+// encodeColumnarBlock encodes data, which must be sorted by timestamp, as a block:
+//
+//	uvarint count, varint min and max timestamp (unix nanoseconds)
+//	count 16 byte uuids
+//	the gorilla compressed (timestamp, value) samples
+//	the gorilla compressed latitude, longitude and elevation columns
+//	the varint deltas of the simulation transmit sequence numbers
+//	the high and low alarm flags, 2 bits per datum
+//	the varint deltas of the laps and of the sectors
+//	the gorilla compressed lap distance column
+//
+// Every column after the uuids is prefixed by its uvarint length. The lap columns were added later,
+// a block without them decodes with no lap, sector or lap distance.
+func encodeColumnarBlock(data []columnarDatum) []byte {
+
+	samples := gorilla.NewEncoder()
+	latitudes := gorilla.NewFloatEncoder()
+	longitudes := gorilla.NewFloatEncoder()
+	elevations := gorilla.NewFloatEncoder()
+	sequenceNums := make([]byte, 0, len(data))
+	alarms := make([]byte, (len(data)*2+7)/8)
+	laps := make([]byte, 0, len(data))
+	sectors := make([]byte, 0, len(data))
+	lapDistances := gorilla.NewFloatEncoder()
+
+	var prevSequenceNum, prevLap, prevSector int32
+	var scratch [binary.MaxVarintLen64]byte
+
+	for i, v := range data {
+		samples.Append(v.timestamp, v.value)
+		latitudes.Append(v.latitude)
+		longitudes.Append(v.longitude)
+		elevations.Append(v.elevation)
+		n := binary.PutVarint(scratch[:], int64(v.sequenceNum)-int64(prevSequenceNum))
+		sequenceNums = append(sequenceNums, scratch[:n]...)
+		prevSequenceNum = v.sequenceNum
+		if v.hiAlarm {
+			alarms[i*2/8] |= 1 << uint(i*2%8)
+		}
+		if v.loAlarm {
+			alarms[(i*2+1)/8] |= 1 << uint((i*2+1)%8)
+		}
+		laps = appendVarint(laps, int64(v.lap)-int64(prevLap))
+		prevLap = v.lap
+		sectors = appendVarint(sectors, int64(v.sector)-int64(prevSector))
+		prevSector = v.sector
+		lapDistances.Append(v.lapDistance)
+	}
+
+	b := make([]byte, 0, 32+len(data)*20)
+	b = appendUvarint(b, uint64(len(data)))
+	if len(data) > 0 {
+		b = appendVarint(b, data[0].timestamp)
+		b = appendVarint(b, data[len(data)-1].timestamp)
+	} else {
+		b = appendVarint(b, 0)
+		b = appendVarint(b, 0)
+	}
+	for _, v := range data {
+		b = append(b, v.id[:]...)
+	}
+	for _, column := range [][]byte{samples.Bytes(), latitudes.Bytes(), longitudes.Bytes(), elevations.Bytes(),
+		sequenceNums, alarms, laps, sectors, lapDistances.Bytes()} {
+		b = appendUvarint(b, uint64(len(column)))
+		b = append(b, column...)
+	}
+
+	return b
+}
+
+// columnarBlockHeader is the part of a block that is read without decoding the columns.
+type columnarBlockHeader struct {
+	count int
+	minT  int64
+	maxT  int64
+	ids   []uuid.UUID
+}
+
+func decodeColumnarBlockHeader(b []byte) (*columnarBlockHeader, []byte, error) {
+
+	r := blockReader{b: b}
+	count := int(r.uvarint())
+	h := &columnarBlockHeader{count: count, minT: r.varint(), maxT: r.varint()}
+	if r.err != nil || count < 0 || count*16 > len(r.b) {
+		return nil, nil, errCorruptBlock
+	}
+
+	h.ids = make([]uuid.UUID, count)
+	for i := range h.ids {
+		copy(h.ids[i][:], r.b[i*16:])
+	}
+
+	return h, r.b[count*16:], nil
+}
+
+func decodeColumnarBlock(b []byte) ([]columnarDatum, error) {
+
+	h, rest, err := decodeColumnarBlockHeader(b)
+	if err != nil {
+		return nil, err
+	}
+
+	r := blockReader{b: rest}
+	samplesColumn := r.column()
+	latitudesColumn := r.column()
+	longitudesColumn := r.column()
+	elevationsColumn := r.column()
+	sequenceNumsColumn := r.column()
+	alarmsColumn := r.column()
+	if r.err != nil || len(alarmsColumn) < (h.count*2+7)/8 {
+		return nil, errCorruptBlock
+	}
+	var lapsColumn, sectorsColumn, lapDistancesColumn []byte
+	if len(r.b) > 0 {
+		lapsColumn = r.column()
+		sectorsColumn = r.column()
+		lapDistancesColumn = r.column()
+		if r.err != nil {
+			return nil, errCorruptBlock
+		}
+	}
+
+	data := make([]columnarDatum, h.count)
+
+	samples := gorilla.NewDecoder(samplesColumn)
+	latitudes := gorilla.NewFloatDecoder(latitudesColumn)
+	longitudes := gorilla.NewFloatDecoder(longitudesColumn)
+	elevations := gorilla.NewFloatDecoder(elevationsColumn)
+	sequenceNums := blockReader{b: sequenceNumsColumn}
+	laps := blockReader{b: lapsColumn}
+	sectors := blockReader{b: sectorsColumn}
+	var lapDistances *gorilla.FloatDecoder
+	if lapDistancesColumn != nil {
+		lapDistances = gorilla.NewFloatDecoder(lapDistancesColumn)
+	}
+
+	var sequenceNum, lap, sector int64
+	for i := range data {
+		if !samples.Next() || !latitudes.Next() || !longitudes.Next() || !elevations.Next() {
+			return nil, errCorruptBlock
+		}
+		sequenceNum += sequenceNums.varint()
+		if sequenceNums.err != nil {
+			return nil, errCorruptBlock
+		}
+		data[i] = columnarDatum{id: h.ids[i], latitude: latitudes.At(), longitude: longitudes.At(),
+			elevation: elevations.At(), sequenceNum: int32(sequenceNum),
+			hiAlarm: alarmsColumn[i*2/8]&(1<<uint(i*2%8)) != 0, loAlarm: alarmsColumn[(i*2+1)/8]&(1<<uint((i*2+1)%8)) != 0}
+		data[i].timestamp, data[i].value = samples.At()
+		if lapDistances == nil {
+			continue
+		}
+		lap += laps.varint()
+		sector += sectors.varint()
+		if laps.err != nil || sectors.err != nil || !lapDistances.Next() {
+			return nil, errCorruptBlock
+		}
+		data[i].lap, data[i].sector, data[i].lapDistance = int32(lap), int32(sector), lapDistances.At()
+	}
+
+	return data, nil
+}
+
+type blockReader struct {
+	b   []byte
+	err error
+}
+
+func (r *blockReader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(r.b)
+	if n <= 0 {
+		r.err = errCorruptBlock
+		return 0
+	}
+	r.b = r.b[n:]
+	return v
+}
+
+func (r *blockReader) varint() int64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(r.b)
+	if n <= 0 {
+		r.err = errCorruptBlock
+		return 0
+	}
+	r.b = r.b[n:]
+	return v
+}
+
+func (r *blockReader) column() []byte {
+	n := r.uvarint()
+	if r.err != nil {
+		return nil
+	}
+	if n > uint64(len(r.b)) {
+		r.err = errCorruptBlock
+		return nil
+	}
+	column := r.b[:n]
+	r.b = r.b[n:]
+	return column
+}
+
+func appendUvarint(b []byte, v uint64) []byte {
+	var scratch [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(scratch[:], v)
+	return append(b, scratch[:n]...)
+}
+
+func appendVarint(b []byte, v int64) []byte {
+	var scratch [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(scratch[:], v)
+	return append(b, scratch[:n]...)
+}
+
+// columnarSeriesKey identifies a series, every datum of a series has the same key fields.
+type columnarSeriesKey struct {
+	Simulated    bool   `json:"simulated"`
+	SimulationID string `json:"simulation_id"`
+	GranPrix     string `json:"gran_prix"`
+	Track        string `json:"track"`
+	Constructor  string `json:"constructor"`
+	CarNumber    int32  `json:"car_number"`
+	Description  string `json:"description"`
+	Unit         string `json:"unit"`
+}
+
+func newColumnarSeriesKey(td *TelemetryDatum) columnarSeriesKey {
+	return columnarSeriesKey{Simulated: td.Simulated, SimulationID: td.SimulationID, GranPrix: td.GranPrix,
+		Track: td.Track, Constructor: td.Constructor, CarNumber: td.CarNumber, Description: td.Description,
+		Unit: td.Unit}
+}
+
+func newColumnarDatum(td *TelemetryDatum) (columnarDatum, error) {
+
+	// The uuid is stored as 16 bytes, so only its canonical string form round trips. telemetry.Validate
+	// rejects any other form at ingest, for every store.
+	id, err := uuid.Parse(td.ID)
+	if err != nil || id.String() != td.ID {
+		return columnarDatum{}, fmt.Errorf("invalid telemetry datum uuid %v, the columnar store requires the canonical lower case form", td.ID)
+	}
+	t, err := ipbts.Timestamp(td.Timestamp)
+	if err != nil {
+		return columnarDatum{}, err
+	}
+
+	return columnarDatum{id: id, timestamp: t.UnixNano(), value: td.Value, latitude: td.Latitude,
+		longitude: td.Longitude, elevation: td.Elevation, sequenceNum: td.SimulationTransmitSequenceNumber,
+		hiAlarm: td.HiAlarm, loAlarm: td.LoAlarm, lap: td.Lap, sector: td.Sector, lapDistance: td.LapDistance}, nil
+}
+
+// telemetryDatum rebuilds the telemetry datum v of the series with key.
+func (key columnarSeriesKey) telemetryDatum(v columnarDatum) TelemetryDatum {
+
+	ts, _ := ipbts.TimestampProto(time.Unix(0, v.timestamp).UTC())
+
+	return TelemetryDatum{ID: v.id.String(), Simulated: key.Simulated, SimulationID: key.SimulationID,
+		SimulationTransmitSequenceNumber: v.sequenceNum, GranPrix: key.GranPrix, Track: key.Track,
+		Constructor: key.Constructor, CarNumber: key.CarNumber, Timestamp: ts, Latitude: v.latitude,
+		Longitude: v.longitude, Elevation: v.elevation, Description: key.Description, Unit: key.Unit,
+		Value: v.value, HiAlarm: v.hiAlarm, LoAlarm: v.loAlarm, Lap: v.lap, Sector: v.sector, LapDistance: v.lapDistance}
+}
+
+// template returns the protobuf telemetry datum with the key fields of the series set, it fails
+// if a key field is not a valid enum.
+func (key columnarSeriesKey) template() (*api.TelemetryDatum, error) {
+	td := TelemetryDatum{Simulated: key.Simulated, SimulationID: key.SimulationID, GranPrix: key.GranPrix,
+		Track: key.Track, Constructor: key.Constructor, CarNumber: key.CarNumber, Description: key.Description,
+		Unit: key.Unit}
+	return td.toProto()
+}
@@ -0,0 +1,246 @@
+package models
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ipbts "github.com/bburch01/FOTAAS/internal/pkg/protobuf/timestamp"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/google/uuid"
+)
+
+func newTestColumnarStore(t *testing.T, dir string, blockSize int) *columnarStore {
+	s, err := openColumnarStore(dir, blockSize)
+	if err != nil {
+		t.Error("failed to open columnar telemetry store with error: ", err)
+		t.FailNow()
+	}
+	return s
+}
+
+func TestColumnarStore(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "fotaas-columnar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// A block size of 2 so that the data is split across blocks and heads.
+	s := newTestColumnarStore(t, dir, 2)
+	defer s.close()
+
+	testTelemetryStore(t, s)
+}
+
+func TestColumnarStorePages(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "fotaas-columnar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s := newTestColumnarStore(t, dir, 100)
+	defer s.close()
+
+	simID := uuid.New().String()
+	start := time.Date(2019, 7, 14, 14, 10, 0, 0, time.UTC)
+
+	// 1050 samples at 1 kHz on each of three channels. Every batch is sent before the one ahead of it
+	// so that the blocks of a series overlap in time, and the last 50 samples stay in the heads.
+	var data []TelemetryDatum
+	for batch := 0; batch < 1050; batch += 150 {
+		var batchData []TelemetryDatum
+		for i := batch; i < batch+150; i++ {
+			ts, err := ipbts.TimestampProto(start.Add(time.Duration(i^1) * time.Millisecond))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, d := range []api.TelemetryDatumDescription{api.TelemetryDatumDescription_SPEED,
+				api.TelemetryDatumDescription_ENGINE_RPM, api.TelemetryDatumDescription_G_FORCE} {
+				batchData = append(batchData, NewFromTelemetryDatum(&api.TelemetryDatum{Uuid: uuid.New().String(),
+					Simulated: true, SimulationUuid: simID, SimulationTransmitSequenceNumber: int32(i), Timestamp: ts,
+					Constructor: api.Constructor_MERCEDES, CarNumber: 44, Description: d, Value: float64(i)}))
+			}
+		}
+		if batch%300 == 0 {
+			data = append(batchData, data...)
+		} else {
+			data = append(data, batchData...)
+		}
+	}
+	for start := 0; start < len(data); start += 450 {
+		if _, err = s.CreateTelemetryData(data[start : start+450]); err != nil {
+			t.Error("failed to create telemetry data with error: ", err)
+			t.FailNow()
+		}
+	}
+
+	// Every datum is retrieved once, in page order, when paging through the series.
+	req := api.GetTelemetryDataRequest{SimulationUuid: simID, SearchBy: &api.GetTelemetryDataRequest_SearchBy{},
+		PageSize: 250}
+	seen := make(map[string]bool)
+	var last columnarMatch
+	for pages := 0; ; pages++ {
+		page, nextPageToken, err := s.RetrieveTelemetryDataPage(req)
+		if err != nil {
+			t.Error("failed to retrieve telemetry data page with error: ", err)
+			t.FailNow()
+		}
+		if nextPageToken != "" && len(page.TelemetryDatumMap) != 250 {
+			t.Errorf("expected a full page of 250 telemetry datum, got %v", len(page.TelemetryDatumMap))
+		}
+		var matched []columnarMatch
+		for _, v := range page.TelemetryDatumMap {
+			ts, _ := ipbts.Timestamp(v.Timestamp)
+			matched = append(matched, columnarMatch{datum: v, timestamp: ts.UnixNano()})
+			if seen[v.Uuid] {
+				t.Fatalf("telemetry datum %v retrieved twice", v.Uuid)
+			}
+			seen[v.Uuid] = true
+		}
+		for _, v := range matched {
+			if last.datum != nil && !last.before(v) {
+				t.Fatalf("telemetry datum %v retrieved after %v", v.datum, last.datum)
+			}
+		}
+		for _, v := range matched {
+			if last.datum == nil || last.before(v) {
+				last = v
+			}
+		}
+		if nextPageToken == "" {
+			break
+		}
+		req.PageToken = nextPageToken
+	}
+	if len(seen) != len(data) {
+		t.Errorf("expected %v paged telemetry datum, got %v", len(data), len(seen))
+	}
+}
+
+func TestColumnarStoreRecovery(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "fotaas-columnar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s := newTestColumnarStore(t, dir, 1000)
+
+	simID := uuid.New().String()
+	start := time.Date(2019, 7, 14, 14, 10, 0, 0, time.UTC)
+
+	// 2500 samples at 1 kHz on each of two channels, so each series has two blocks and a head.
+	var data []TelemetryDatum
+	for i := 0; i < 2500; i++ {
+		ts, err := ipbts.TimestampProto(start.Add(time.Duration(i) * time.Millisecond))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, d := range []api.TelemetryDatumDescription{api.TelemetryDatumDescription_SPEED,
+			api.TelemetryDatumDescription_ENGINE_RPM} {
+			data = append(data, NewFromTelemetryDatum(&api.TelemetryDatum{Uuid: uuid.New().String(), Simulated: true,
+				SimulationUuid: simID, SimulationTransmitSequenceNumber: int32(i), GranPrix: api.GranPrix_GERMAN,
+				Track: api.Track_HOCKENHEIM, Constructor: api.Constructor_MERCEDES, CarNumber: 44, Timestamp: ts,
+				Latitude: 49.327, Longitude: 8.565, Elevation: 101.25, Description: d,
+				Value: 250 + float64(i/100), LowAlarm: i%1000 == 0}))
+		}
+	}
+	for start := 0; start < len(data); start += 500 {
+		if _, err = s.CreateTelemetryData(data[start : start+500]); err != nil {
+			t.Error("failed to create telemetry data with error: ", err)
+			t.FailNow()
+		}
+	}
+
+	threshold := &api.AlarmThreshold{Uuid: uuid.New().String(), DatumDescription: api.TelemetryDatumDescription_SPEED,
+		OverrideBy: &api.AlarmThreshold_OverrideBy{}, HighAlarmEnabled: true, HighAlarmValue: 360}
+	if err = s.CreateAlarmThreshold(threshold); err != nil {
+		t.Error("failed to create alarm threshold with error: ", err)
+		t.FailNow()
+	}
+
+	// Reopen without closing, as after a crash, with a torn record at the end of the write ahead log.
+	// The heads are recovered from the log and the torn record is dropped.
+	f, err := os.OpenFile(filepath.Join(dir, columnarWALName), os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Write([]byte{0, 0, 1, 0, 1, 2, 3})
+	f.Close()
+
+	r := newTestColumnarStore(t, dir, 1000)
+	s.wal.Close()
+
+	req := api.GetTelemetryDataRequest{SimulationUuid: simID, SearchBy: &api.GetTelemetryDataRequest_SearchBy{}}
+	retrieved, err := r.RetrieveTelemetryData(req)
+	if err != nil {
+		t.Error("failed to retrieve telemetry data with error: ", err)
+		t.FailNow()
+	}
+	if len(retrieved.TelemetryDatumMap) != len(data) {
+		t.Fatalf("expected %v recovered telemetry datum, got %v", len(data), len(retrieved.TelemetryDatumMap))
+	}
+	for _, v := range data {
+		got := NewFromTelemetryDatum(retrieved.TelemetryDatumMap[v.ID])
+		if got.ContentHash() != v.ContentHash() {
+			t.Fatalf("recovered telemetry datum %+v does not match %+v", got, v)
+		}
+	}
+
+	if statusMap, err := r.CreateTelemetryData(data[:10]); err != nil || statusMap[data[0].ID] != Duplicate {
+		t.Errorf("expected recovered telemetry data to be a duplicate, got %v with error: %v", statusMap[data[0].ID], err)
+	}
+
+	if _, err = r.RetrieveAlarmThreshold(threshold.Uuid); err != nil {
+		t.Error("failed to retrieve persisted alarm threshold with error: ", err)
+	}
+
+	// Purge the first 1.5 seconds of each channel. The first block of each series is dropped and the
+	// second is cut, a whole series is purged by a delete even if that exceeds the limit.
+	criteria := PurgeCriteria{Simulated: true, Before: start.Add(1500 * time.Millisecond)}
+	if count, err := r.CountTelemetryData(criteria); err != nil || count != 3000 {
+		t.Errorf("expected 3000 purgeable telemetry datum, got %v with error: %v", count, err)
+	}
+	for _, expected := range []int64{1500, 1500, 0} {
+		count, err := r.DeleteTelemetryData(criteria, 1000)
+		if err != nil {
+			t.Error("failed to delete telemetry data with error: ", err)
+			t.FailNow()
+		}
+		if count != expected {
+			t.Errorf("expected %v deleted telemetry datum, got %v", expected, count)
+		}
+	}
+
+	// The datum after the cut are still retrievable.
+	if retrieved, err = r.RetrieveTelemetryData(req); err != nil || len(retrieved.TelemetryDatumMap) != len(data)-3000 {
+		t.Errorf("expected %v telemetry datum after the purge, got %v with error: %v", len(data)-3000,
+			len(retrieved.TelemetryDatumMap), err)
+	}
+	for _, v := range data {
+		ts, _ := ipbts.Timestamp(v.Timestamp)
+		if _, kept := retrieved.TelemetryDatumMap[v.ID]; kept == ts.Before(criteria.Before) {
+			t.Fatalf("telemetry datum %v at %v was not purged as expected", v.ID, ts)
+		}
+	}
+
+	// The deleted datum stay deleted across a restart.
+	r.close()
+	r = newTestColumnarStore(t, dir, 1000)
+	defer r.close()
+	if count, err := r.CountTelemetryData(PurgeCriteria{Simulated: true, Before: start.Add(time.Hour)}); err != nil ||
+		count != int64(len(data)-3000) {
+		t.Errorf("expected %v telemetry datum after the purge, got %v with error: %v", len(data)-3000, count, err)
+	}
+	if statusMap, err := r.CreateTelemetryData(data[len(data)-10:]); err != nil || statusMap[data[len(data)-1].ID] != Duplicate {
+		t.Errorf("expected the kept telemetry data to be a duplicate, got %v with error: %v", statusMap[data[len(data)-1].ID], err)
+	}
+}
@@ -2,8 +2,10 @@ package models
 
 import (
 	"database/sql"
+	"fmt"
 	"log"
 	"os"
+	"strconv"
 	"time"
 
 	_ "github.com/go-sql-driver/mysql"
@@ -13,8 +15,17 @@ import (
 	logging "github.com/bburch01/FOTAAS/internal/pkg/logging"
 )
 
-var db *sql.DB
+const (
+	// telemetryDatumColumnCount is the number of placeholders each telemetry_datum row
+	// contributes to a multi-row insert.
+	telemetryDatumColumnCount = 22
+	// mysql limits a prepared statement to 65535 placeholders.
+	maxInsertBatchSize     = 65535 / telemetryDatumColumnCount
+	defaultInsertBatchSize = 500
+)
+
 var logger *zap.Logger
+var insertBatchSize = defaultInsertBatchSize
 
 func init() {
 
@@ -40,31 +51,83 @@ func init() {
 
 }
 
+// InitDB opens the TelemetryStore selected by the TELEMETRY_STORE environment variable and applies
+// its pending schema migrations.
 func InitDB() error {
+
+	if err := OpenDB(); err != nil {
+		return err
+	}
+
+	applied, err := MigrateDB(false)
+	for _, m := range applied {
+		logger.Info(fmt.Sprintf("applied telemetry schema migration %v: %v", m.Version, m.Description))
+	}
+	return err
+}
+
+// OpenDB opens the TelemetryStore selected by the TELEMETRY_STORE environment variable without
+// migrating it.
+func OpenDB() error {
+
+	var err error
+
+	if v := os.Getenv("TELEMETRY_INSERT_BATCH_SIZE"); v != "" {
+		if insertBatchSize, err = strconv.Atoi(v); err != nil {
+			return fmt.Errorf("invalid TELEMETRY_INSERT_BATCH_SIZE %v: %v", v, err)
+		}
+		if insertBatchSize <= 0 || insertBatchSize > maxInsertBatchSize {
+			return fmt.Errorf("invalid TELEMETRY_INSERT_BATCH_SIZE %v, must be between 1 and %v", v, maxInsertBatchSize)
+		}
+	}
+
+	switch storeType := os.Getenv("TELEMETRY_STORE"); storeType {
+	case "", "mysql":
+		store, err = openMySQLStore()
+	case "sqlite":
+		store, err = openSQLiteStore(os.Getenv("TELEMETRY_SQLITE_PATH"))
+	case "memory":
+		store = newMemoryStore()
+	case "columnar":
+		var blockSize int
+		if blockSize, err = envInt("TELEMETRY_COLUMNAR_BLOCK_SIZE", defaultColumnarBlockSize); err != nil {
+			return err
+		}
+		store, err = openColumnarStore(os.Getenv("TELEMETRY_COLUMNAR_PATH"), blockSize)
+	default:
+		return fmt.Errorf("invalid TELEMETRY_STORE %v, valid stores are: mysql, sqlite, memory, columnar", storeType)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = PingDB(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func openMySQLStore() (*sqlStore, error) {
 	dbDriver := os.Getenv("DB_DRIVER")
 	dbHost := os.Getenv("DB_HOST")
 	dbUser := os.Getenv("DB_USER")
 	dbPass := os.Getenv("DB_PASSWORD")
 	dbName := os.Getenv("TELEMETRY_SERVICE_DB_NAME")
-	var err error
 
 	dbConURL := dbUser + ":" + dbPass + "@tcp(" + dbHost + ")" + "/" + dbName + "?parseTime=true"
-	db, err = sql.Open(dbDriver, dbConURL)
+	db, err := sql.Open(dbDriver, dbConURL)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	db.SetConnMaxLifetime(time.Duration(86400))
 	db.SetMaxIdleConns(8)
 
-	if err = PingDB(); err != nil {
-		return err
-	}
-	return nil
+	return &sqlStore{db: db, batchSize: insertBatchSize, migrations: mysqlMigrations, dialect: mysqlDialect}, nil
 }
 
 func PingDB() error {
-	if err := db.Ping(); err != nil {
+	if err := store.Ping(); err != nil {
 		return err
 	}
 	return nil
@@ -0,0 +1,203 @@
+package models
+
+import (
+	"database/sql"
+	"sort"
+	"sync"
+	"time"
+
+	ipbts "github.com/bburch01/FOTAAS/internal/pkg/protobuf/timestamp"
+
+	"github.com/bburch01/FOTAAS/api"
+)
+
+// memoryStore is an in process TelemetryStore. It is intended for development and testing, the
+// telemetry data is lost when the service exits.
+type memoryStore struct {
+	mu         sync.RWMutex
+	datums     map[string]*memoryDatum
+	thresholds map[string]*api.AlarmThreshold
+	channels   map[string]*api.Channel
+}
+
+type memoryDatum struct {
+	datum     *api.TelemetryDatum
+	timestamp time.Time
+	hash      string
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{datums: make(map[string]*memoryDatum), thresholds: make(map[string]*api.AlarmThreshold),
+		channels: make(map[string]*api.Channel)}
+}
+
+func (s *memoryStore) Ping() error {
+	return nil
+}
+
+func (s *memoryStore) CreateTelemetryData(data []TelemetryDatum) (map[string]IngestStatus, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	persisted := make(map[string]sql.NullString)
+	for i := range data {
+		if v, ok := s.datums[data[i].ID]; ok {
+			persisted[data[i].ID] = sql.NullString{String: v.hash, Valid: true}
+		}
+	}
+
+	statusMap, inserts, conflict := classifyTelemetryData(data, persisted)
+	if conflict {
+		return statusMap, ErrTelemetryDatumConflict
+	}
+
+	// Convert everything before storing anything so that a bad datum leaves the store unchanged.
+	converted := make([]*memoryDatum, 0, len(inserts))
+	for i := range inserts {
+		datum, err := inserts[i].toProto()
+		if err != nil {
+			return nil, err
+		}
+		t, err := ipbts.Timestamp(datum.Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		converted = append(converted, &memoryDatum{datum: datum, timestamp: t, hash: inserts[i].ContentHash()})
+	}
+
+	for _, v := range converted {
+		s.datums[v.datum.Uuid] = v
+	}
+
+	return statusMap, nil
+}
+
+func (s *memoryStore) RetrieveTelemetryData(req api.GetTelemetryDataRequest) (*api.TelemetryData, error) {
+
+	matched, err := s.match(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data := api.TelemetryData{TelemetryDatumMap: make(map[string]*api.TelemetryDatum)}
+	for _, v := range matched {
+		data.TelemetryDatumMap[v.datum.Uuid] = v.datum
+	}
+
+	return &data, nil
+}
+
+func (s *memoryStore) RetrieveTelemetryDataPage(req api.GetTelemetryDataRequest) (*api.TelemetryData, string, error) {
+
+	size := pageSize(req)
+
+	matched, err := s.match(req)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Same order as the sql stores, by timestamp and then uuid.
+	sort.Slice(matched, func(i, j int) bool {
+		if !matched[i].timestamp.Equal(matched[j].timestamp) {
+			return matched[i].timestamp.Before(matched[j].timestamp)
+		}
+		return matched[i].datum.Uuid < matched[j].datum.Uuid
+	})
+
+	start := 0
+	if req.PageToken != "" {
+		token, err := decodePageToken(req.PageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		start = sort.Search(len(matched), func(i int) bool {
+			t := matched[i].timestamp
+			return t.After(token.timestamp) || (t.Equal(token.timestamp) && matched[i].datum.Uuid > token.id)
+		})
+	}
+
+	end := start + size
+	if end > len(matched) {
+		end = len(matched)
+	}
+
+	data := api.TelemetryData{TelemetryDatumMap: make(map[string]*api.TelemetryDatum)}
+	var last *api.TelemetryDatum
+	for _, v := range matched[start:end] {
+		data.TelemetryDatumMap[v.datum.Uuid] = v.datum
+		last = v.datum
+	}
+
+	if end-start < size {
+		return &data, "", nil
+	}
+
+	nextPageToken, err := encodePageToken(last)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &data, nextPageToken, nil
+}
+
+func (s *memoryStore) match(req api.GetTelemetryDataRequest) ([]*memoryDatum, error) {
+
+	search, err := newTelemetrySearch(req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var matched []*memoryDatum
+	for _, v := range s.datums {
+		if search.matches(v.datum, v.timestamp) {
+			matched = append(matched, v)
+		}
+	}
+
+	return matched, nil
+}
+
+func (s *memoryStore) CountTelemetryData(criteria PurgeCriteria) (int64, error) {
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var count int64
+	for _, v := range s.datums {
+		if purgeMatches(criteria, v) {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (s *memoryStore) DeleteTelemetryData(criteria PurgeCriteria, limit int) (int64, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var count int64
+	for k, v := range s.datums {
+		if count >= int64(limit) {
+			break
+		}
+		if purgeMatches(criteria, v) {
+			delete(s.datums, k)
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func purgeMatches(criteria PurgeCriteria, v *memoryDatum) bool {
+	if v.datum.Simulated != criteria.Simulated || !v.timestamp.Before(criteria.Before) {
+		return false
+	}
+	return !containsString(criteria.KeepSimulationIDs, v.datum.SimulationUuid)
+}
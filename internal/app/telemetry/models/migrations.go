@@ -0,0 +1,175 @@
+package models
+
+import (
+	"github.com/bburch01/FOTAAS/internal/pkg/migrate"
+)
+
+// The mysql enum column types of the telemetry database, the values are the protobuf enum names.
+const (
+	granPrixEnum = `ENUM('UNITED_STATES', 'AZERBAIJAN', 'SPANISH', 'GERMAN', 'HUNGARIAN',
+        'BRAZILIAN', 'SINGAPORE', 'AUSTRALIAN', 'MEXICAN', 'MONACO',
+        'CANADIAN', 'ITALIAN', 'FRENCH', 'BAHRAIN', 'CHINESE',
+        'BRITISH', 'RUSSIAN', 'BELGIAN', 'AUSTRIAN', 'JAPANESE', 'ABU_DHABI')`
+	trackEnum = `ENUM('AUSTIN', 'BAKU', 'CATALUNYA_BARCELONA', 'HOCKENHEIM', 'HUNGARORING',
+        'INTERLAGOS_SAU_PAULO', 'MARINA_BAY', 'MELBOURNE', 'MEXICO_CITY', 'MONTE_CARLO',
+        'MONTREAL', 'MONZA', 'PAUL_RICARD_LE_CASTELLET', 'SAKHIR', 'SHANGHAI',
+        'SILVERSTONE', 'SOCHI', 'SPA_FRANCORCHAMPS', 'SPIELBERG_RED_BULL_RING', 'SUZUKA', 'YAS_MARINA')`
+	constructorEnum = `ENUM('ALPHA_ROMEO', 'FERRARI', 'HAAS', 'MCLAREN', 'MERCEDES',
+        'RACING_POINT', 'RED_BULL_RACING', 'SCUDERIA_TORO_ROSO', 'WILLIAMS')`
+	telemetryDatumDescriptionEnum = `ENUM('G_FORCE', 'G_FORCE_DIRECTION', 'FUEL_CONSUMED', 'FUEL_FLOW', 'ENGINE_COOLANT_TEMP',
+        'ENGINE_OIL_PRESSURE', 'ENGINE_OIL_TEMP', 'ENGINE_RPM', 'BRAKE_TEMP_FR', 'BRAKE_TEMP_FL',
+        'BRAKE_TEMP_RR', 'BRAKE_TEMP_RL', 'ENERGY_STORAGE_LEVEL', 'ENERGY_STORAGE_TEMP',
+        'MGUK_OUTPUT', 'MGUH_OUTPUT', 'SPEED', 'TIRE_PRESSURE_FR', 'TIRE_PRESSURE_FL',
+        'TIRE_PRESSURE_RR', 'TIRE_PRESSURE_RL', 'TIRE_TEMP_FR', 'TIRE_TEMP_FL',
+        'TIRE_TEMP_RR', 'TIRE_TEMP_RL')`
+	telemetryDatumUnitEnum = `ENUM('G', 'KG_PER_HOUR', 'DEGREE_CELCIUS', 'MJ', 'JPS',
+        'RPM', 'BAR', 'KG', 'KPH', 'METER', 'RADIAN', 'KPA')`
+)
+
+// mysqlMigrations is the schema history of the mysql telemetry database. Never change a migration
+// that has been released, add a new one.
+var mysqlMigrations = []migrate.Migration{
+	{Version: 1, Description: "create telemetry_datum", Statements: []string{`CREATE TABLE IF NOT EXISTS telemetry_datum
+(
+  id VARCHAR(36) CHARACTER SET UTF8MB4 NOT NULL,
+  simulated BOOLEAN NOT NULL,
+  simulation_id VARCHAR(36) CHARACTER SET UTF8MB4,
+  simulation_transmit_sequence_number INTEGER NOT NULL,
+  gran_prix ` + granPrixEnum + ` NOT NULL,
+  track ` + trackEnum + ` NOT NULL,
+  constructor ` + constructorEnum + ` NOT NULL,
+  car_number INTEGER NOT NULL,
+  timestamp TIMESTAMP NULL,
+  latitude INTEGER NOT NULL,
+  longitude INTEGER NOT NULL,
+  elevation INTEGER NOT NULL,
+  description ` + telemetryDatumDescriptionEnum + ` NOT NULL,
+  unit ` + telemetryDatumUnitEnum + ` NOT NULL,
+  value FLOAT NOT NULL,
+  hi_alarm BOOLEAN NOT NULL,
+  lo_alarm BOOLEAN NOT NULL,
+  PRIMARY KEY (id)
+) ENGINE=InnoDB DEFAULT CHARSET=UTF8MB4`}},
+	{Version: 2, Description: "add telemetry_datum content_hash", Statements: []string{
+		`ALTER TABLE telemetry_datum ADD COLUMN content_hash CHAR(64) CHARACTER SET UTF8MB4 AFTER lo_alarm`}},
+	{Version: 3, Description: "preserve telemetry_datum timestamp and position precision", Statements: []string{
+		`ALTER TABLE telemetry_datum
+  MODIFY COLUMN timestamp TIMESTAMP(6) NULL,
+  ADD COLUMN timestamp_nanos INTEGER NOT NULL DEFAULT 0 AFTER timestamp,
+  MODIFY COLUMN latitude DOUBLE NOT NULL,
+  MODIFY COLUMN longitude DOUBLE NOT NULL,
+  MODIFY COLUMN elevation DOUBLE NOT NULL,
+  MODIFY COLUMN value DOUBLE NOT NULL`}},
+	{Version: 4, Description: "add telemetry_datum retention index", Statements: []string{
+		`ALTER TABLE telemetry_datum ADD KEY telemetry_datum_simulated_timestamp (simulated, timestamp)`}},
+	{Version: 5, Description: "create alarm_threshold", Statements: []string{`CREATE TABLE IF NOT EXISTS alarm_threshold
+(
+  id VARCHAR(36) CHARACTER SET UTF8MB4 NOT NULL,
+  description ` + telemetryDatumDescriptionEnum + ` NOT NULL,
+  constructor VARCHAR(32) CHARACTER SET UTF8MB4 NOT NULL DEFAULT '',
+  car_number INTEGER NOT NULL DEFAULT 0,
+  track VARCHAR(32) CHARACTER SET UTF8MB4 NOT NULL DEFAULT '',
+  high_alarm_enabled BOOLEAN NOT NULL,
+  high_alarm_value DOUBLE NOT NULL,
+  low_alarm_enabled BOOLEAN NOT NULL,
+  low_alarm_value DOUBLE NOT NULL,
+  PRIMARY KEY (id),
+  UNIQUE KEY alarm_threshold_scope (description, constructor, car_number, track)
+) ENGINE=InnoDB DEFAULT CHARSET=UTF8MB4`}},
+	{Version: 6, Description: "add telemetry_datum lap, sector and lap_distance", Statements: []string{
+		`ALTER TABLE telemetry_datum
+  ADD COLUMN lap INTEGER NOT NULL DEFAULT 0 AFTER car_number,
+  ADD COLUMN sector INTEGER NOT NULL DEFAULT 0 AFTER lap,
+  ADD COLUMN lap_distance DOUBLE NOT NULL DEFAULT 0 AFTER sector,
+  ADD KEY telemetry_datum_simulation_lap (simulation_id, car_number, lap)`}},
+	{Version: 7, Description: "telemetry_datum description and unit hold channel names", Statements: []string{
+		`ALTER TABLE telemetry_datum
+  MODIFY COLUMN description VARCHAR(64) CHARACTER SET UTF8MB4 NOT NULL,
+  MODIFY COLUMN unit VARCHAR(32) CHARACTER SET UTF8MB4 NOT NULL`}},
+	{Version: 8, Description: "create channel", Statements: []string{`CREATE TABLE IF NOT EXISTS channel
+(
+  name VARCHAR(64) CHARACTER SET UTF8MB4 NOT NULL,
+  unit VARCHAR(32) CHARACTER SET UTF8MB4 NOT NULL,
+  min_value DOUBLE NOT NULL,
+  max_value DOUBLE NOT NULL,
+  category VARCHAR(32) CHARACTER SET UTF8MB4 NOT NULL,
+  PRIMARY KEY (name)
+) ENGINE=InnoDB DEFAULT CHARSET=UTF8MB4`}},
+}
+
+// sqliteMigrations is the schema history of the sqlite telemetry database. The enum columns are
+// plain text, the values written are always the protobuf enum names. Never change a migration that
+// has been released, add a new one.
+var sqliteMigrations = []migrate.Migration{
+	{Version: 1, Description: "create telemetry_datum", Statements: []string{`CREATE TABLE IF NOT EXISTS telemetry_datum
+(
+  id VARCHAR(36) NOT NULL,
+  simulated BOOLEAN NOT NULL,
+  simulation_id VARCHAR(36),
+  simulation_transmit_sequence_number INTEGER NOT NULL,
+  gran_prix TEXT NOT NULL,
+  track TEXT NOT NULL,
+  constructor TEXT NOT NULL,
+  car_number INTEGER NOT NULL,
+  timestamp TIMESTAMP NULL,
+  timestamp_nanos INTEGER NOT NULL DEFAULT 0,
+  latitude DOUBLE NOT NULL,
+  longitude DOUBLE NOT NULL,
+  elevation DOUBLE NOT NULL,
+  description TEXT NOT NULL,
+  unit TEXT NOT NULL,
+  value DOUBLE NOT NULL,
+  hi_alarm BOOLEAN NOT NULL,
+  lo_alarm BOOLEAN NOT NULL,
+  content_hash CHAR(64),
+  PRIMARY KEY (id)
+)`,
+		`CREATE INDEX IF NOT EXISTS telemetry_datum_timestamp ON telemetry_datum (timestamp, timestamp_nanos, id)`,
+		`CREATE INDEX IF NOT EXISTS telemetry_datum_simulated_timestamp ON telemetry_datum (simulated, timestamp)`}},
+	{Version: 2, Description: "create alarm_threshold", Statements: []string{`CREATE TABLE IF NOT EXISTS alarm_threshold
+(
+  id VARCHAR(36) NOT NULL,
+  description TEXT NOT NULL,
+  constructor TEXT NOT NULL DEFAULT '',
+  car_number INTEGER NOT NULL DEFAULT 0,
+  track TEXT NOT NULL DEFAULT '',
+  high_alarm_enabled BOOLEAN NOT NULL,
+  high_alarm_value DOUBLE NOT NULL,
+  low_alarm_enabled BOOLEAN NOT NULL,
+  low_alarm_value DOUBLE NOT NULL,
+  PRIMARY KEY (id),
+  UNIQUE (description, constructor, car_number, track)
+)`}},
+	{Version: 3, Description: "add telemetry_datum lap, sector and lap_distance", Statements: []string{
+		`ALTER TABLE telemetry_datum ADD COLUMN lap INTEGER NOT NULL DEFAULT 0`,
+		`ALTER TABLE telemetry_datum ADD COLUMN sector INTEGER NOT NULL DEFAULT 0`,
+		`ALTER TABLE telemetry_datum ADD COLUMN lap_distance DOUBLE NOT NULL DEFAULT 0`,
+		`CREATE INDEX IF NOT EXISTS telemetry_datum_simulation_lap ON telemetry_datum (simulation_id, car_number, lap)`}},
+	{Version: 4, Description: "create channel", Statements: []string{`CREATE TABLE IF NOT EXISTS channel
+(
+  name VARCHAR(64) NOT NULL,
+  unit VARCHAR(32) NOT NULL,
+  min_value DOUBLE NOT NULL,
+  max_value DOUBLE NOT NULL,
+  category TEXT NOT NULL,
+  PRIMARY KEY (name)
+)`}},
+}
+
+// MigrateDB applies the pending schema migrations of the telemetry store and returns them. With
+// dryRun set nothing is applied and the pending migrations are returned.
+func MigrateDB(dryRun bool) ([]migrate.Migration, error) {
+	return store.Migrate(dryRun)
+}
+
+func (s *sqlStore) Migrate(dryRun bool) ([]migrate.Migration, error) {
+	if dryRun {
+		return migrate.Pending(s.db, s.migrations)
+	}
+	return migrate.Apply(s.db, s.migrations)
+}
+
+// Migrate is a no-op, the memory store has no schema.
+func (s *memoryStore) Migrate(dryRun bool) ([]migrate.Migration, error) {
+	return nil, nil
+}
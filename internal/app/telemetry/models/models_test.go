@@ -134,3 +134,64 @@ func TestRetrieveTelemetryData(t *testing.T) {
 	logger.Debug(fmt.Sprintf("telemetry data datum count: %v", len(data.TelemetryDatumMap)))
 
 }
+
+func TestNewTelemetryQuery(t *testing.T) {
+
+	req := new(api.GetTelemetryDataRequest)
+	req.SearchBy = new(api.GetTelemetryDataRequest_SearchBy)
+	req.SimulationUuid = "x' or '1'='1"
+	req.Constructor = api.Constructor_HAAS
+	req.Constructors = []api.Constructor{api.Constructor_MERCEDES, api.Constructor_FERRARI}
+	req.CarNumbers = []int32{44}
+	req.Lap = 12
+	req.SearchBy.Constructor = true
+	req.SearchBy.Lap = true
+	req.SearchBy.HighAlarm = true
+
+	q, err := newTelemetryQuery(*req)
+	if err != nil {
+		t.Error("failed to build telemetry query with error: ", err)
+		t.FailNow()
+	}
+
+	expectedSQL := "select " + telemetryDatumSelectColumns + " from telemetry_datum where simulation_id = ? and constructor in (?, ?, ?) and car_number = ? and lap = ? and hi_alarm = true"
+	if q.sql() != expectedSQL {
+		t.Errorf("unexpected telemetry query sql: %v", q.sql())
+	}
+
+	if len(q.args) != 6 || q.args[0] != req.SimulationUuid || q.args[1] != "HAAS" || q.args[4] != int32(44) ||
+		q.args[5] != int32(12) {
+		t.Errorf("unexpected telemetry query args: %v", q.args)
+	}
+
+}
+
+func TestValidateTelemetryDataRequest(t *testing.T) {
+
+	begin, _ := ipbts.TimestampProto(time.Date(2019, 7, 14, 0, 0, 0, 0, time.UTC))
+	end, _ := ipbts.TimestampProto(time.Date(2019, 7, 15, 0, 0, 0, 0, time.UTC))
+	token, _ := encodePageToken(&api.TelemetryDatum{Uuid: "2f0c2f3e-5f8e-4f55-9c4e-3b9e8e0c6a11", Timestamp: begin})
+
+	valid := api.GetTelemetryDataRequest{SearchBy: &api.GetTelemetryDataRequest_SearchBy{DateRange: true},
+		DateRangeBegin: begin, DateRangeEnd: end, PageToken: token}
+	if err := ValidateTelemetryDataRequest(valid); err != nil {
+		t.Error("expected a valid telemetry data request, got error: ", err)
+	}
+
+	// The same day is a valid date range.
+	valid.DateRangeEnd = begin
+	if err := ValidateTelemetryDataRequest(valid); err != nil {
+		t.Error("expected a single day date range to be valid, got error: ", err)
+	}
+
+	for _, v := range []api.GetTelemetryDataRequest{
+		{PageToken: "not a token"},
+		{SearchBy: &api.GetTelemetryDataRequest_SearchBy{DateRange: true}, DateRangeBegin: end, DateRangeEnd: begin},
+		{SearchBy: &api.GetTelemetryDataRequest_SearchBy{DateRange: true}, DateRangeBegin: begin},
+	} {
+		if err := ValidateTelemetryDataRequest(v); err == nil {
+			t.Errorf("expected telemetry data request to be rejected: %v", v)
+		}
+	}
+
+}
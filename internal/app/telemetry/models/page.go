@@ -0,0 +1,68 @@
+package models
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	ipbts "github.com/bburch01/FOTAAS/internal/pkg/protobuf/timestamp"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultPageSize is the telemetry datum page size used when a request does not specify one.
+	DefaultPageSize = 1000
+	// MaxPageSize caps the page size so that a single page always fits in a grpc message.
+	MaxPageSize = 10000
+)
+
+// pageToken is the keyset cursor for paged telemetry data retrieval. Pages are ordered by
+// (timestamp, id) so the cursor is the timestamp and id of the last datum of the previous page.
+type pageToken struct {
+	timestamp time.Time
+	id        string
+}
+
+func encodePageToken(last *api.TelemetryDatum) (string, error) {
+
+	if last == nil {
+		return "", nil
+	}
+
+	t, err := ipbts.Timestamp(last.Timestamp)
+	if err != nil {
+		return "", err
+	}
+
+	token := strconv.FormatInt(t.UnixNano(), 10) + "|" + last.Uuid
+
+	return base64.RawURLEncoding.EncodeToString([]byte(token)), nil
+}
+
+func decodePageToken(s string) (*pageToken, error) {
+
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.New("invalid page token")
+	}
+
+	parts := strings.Split(string(b), "|")
+	if len(parts) != 2 {
+		return nil, errors.New("invalid page token")
+	}
+
+	nanos, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, errors.New("invalid page token")
+	}
+
+	if _, err := uuid.Parse(parts[1]); err != nil {
+		return nil, errors.New("invalid page token")
+	}
+
+	return &pageToken{timestamp: time.Unix(0, nanos).UTC(), id: parts[1]}, nil
+}
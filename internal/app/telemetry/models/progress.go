@@ -0,0 +1,132 @@
+package models
+
+import (
+	"sort"
+
+	"github.com/bburch01/FOTAAS/api"
+)
+
+// RetrieveTransmitProgress retrieves, for each car of the simulation with id simulationID, the last
+// simulation transmit sequence number up to which every sequence number of the car is stored, ordered
+// by constructor and car number. A car without sequence number 0 has no entry. The telemetry of a car
+// is transmitted one sequence number per batch but batches acknowledged on acceptance are stored out
+// of order, or not at all when their flush fails, so the stored telemetry of a car can have gaps
+// below its highest sequence number. A simulation resumed after the returned sequence number
+// retransmits the gaps.
+func RetrieveTransmitProgress(simulationID string) ([]*api.CarTransmitProgress, error) {
+
+	progress, err := store.RetrieveTransmitProgress(simulationID)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(progress, func(i, j int) bool {
+		if progress[i].Constructor != progress[j].Constructor {
+			return progress[i].Constructor < progress[j].Constructor
+		}
+		return progress[i].CarNumber < progress[j].CarNumber
+	})
+
+	return progress, nil
+}
+
+// carKey identifies a car of a simulation.
+type carKey struct {
+	constructor string
+	carNumber   int32
+}
+
+// transmitProgress accumulates the stored sequence numbers of each car.
+type transmitProgress map[carKey]map[int32]bool
+
+func (p transmitProgress) add(constructor string, carNumber int32, sequenceNum int32) {
+	key := carKey{constructor: constructor, carNumber: carNumber}
+	if p[key] == nil {
+		p[key] = make(map[int32]bool)
+	}
+	p[key][sequenceNum] = true
+}
+
+// list returns the last sequence number of each car that is preceded by every lower sequence number.
+func (p transmitProgress) list() []*api.CarTransmitProgress {
+	progress := make([]*api.CarTransmitProgress, 0, len(p))
+	for k, v := range p {
+		last := int32(-1)
+		for v[last+1] {
+			last++
+		}
+		if last < 0 {
+			continue
+		}
+		progress = append(progress, &api.CarTransmitProgress{Constructor: api.Constructor(api.Constructor_value[k.constructor]),
+			CarNumber: k.carNumber, LastTransmitSequenceNumber: last})
+	}
+	return progress
+}
+
+func (s *sqlStore) RetrieveTransmitProgress(simulationID string) ([]*api.CarTransmitProgress, error) {
+
+	rows, err := s.db.Query(`select distinct constructor, car_number, simulation_transmit_sequence_number from telemetry_datum
+		where simulation_id = ?`, simulationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	p := make(transmitProgress)
+	for rows.Next() {
+		var constructor string
+		var carNumber, sequenceNum int32
+		if err = rows.Scan(&constructor, &carNumber, &sequenceNum); err != nil {
+			return nil, err
+		}
+		p.add(constructor, carNumber, sequenceNum)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return p.list(), nil
+}
+
+func (s *memoryStore) RetrieveTransmitProgress(simulationID string) ([]*api.CarTransmitProgress, error) {
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	p := make(transmitProgress)
+	for _, v := range s.datums {
+		if v.datum.SimulationUuid == simulationID {
+			p.add(v.datum.Constructor.String(), v.datum.CarNumber, v.datum.SimulationTransmitSequenceNumber)
+		}
+	}
+
+	return p.list(), nil
+}
+
+func (s *columnarStore) RetrieveTransmitProgress(simulationID string) ([]*api.CarTransmitProgress, error) {
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	p := make(transmitProgress)
+	for _, series := range s.series {
+		if series.key.SimulationID != simulationID {
+			continue
+		}
+		for _, v := range series.head {
+			p.add(series.key.Constructor, series.key.CarNumber, v.sequenceNum)
+		}
+		for i := range series.blocks {
+			data, err := series.readBlock(nil, i)
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range data {
+				p.add(series.key.Constructor, series.key.CarNumber, v.sequenceNum)
+			}
+		}
+	}
+
+	return p.list(), nil
+}
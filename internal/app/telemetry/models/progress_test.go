@@ -0,0 +1,91 @@
+package models
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	ipbts "github.com/bburch01/FOTAAS/internal/pkg/protobuf/timestamp"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/google/uuid"
+)
+
+func TestTransmitProgress(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "fotaas-columnar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// A block size of 4 puts most of the columnar data in blocks rather than series heads.
+	columnar := newTestColumnarStore(t, dir, 4)
+	defer columnar.close()
+
+	for _, s := range []TelemetryStore{newMemoryStore(), newTestSQLiteStore(t), columnar} {
+		testTransmitProgress(t, s)
+	}
+}
+
+func testTransmitProgress(t *testing.T, s TelemetryStore) {
+
+	saved := store
+	store = s
+	defer func() { store = saved }()
+
+	simID := uuid.New().String()
+	start := time.Date(2019, 9, 8, 13, 10, 0, 0, time.UTC)
+
+	// Car 44 was transmitted up to sequence number 9 and car 77 up to 6 but its sequence numbers 3
+	// and 5 were lost, car 33 lost its sequence number 0 and another simulation is transmitted up to 20.
+	var data []TelemetryDatum
+	for _, car := range []struct {
+		simID       string
+		constructor api.Constructor
+		carNumber   int32
+		last        int32
+		lost        map[int32]bool
+	}{{simID, api.Constructor_MERCEDES, 44, 9, nil}, {simID, api.Constructor_MERCEDES, 77, 6, map[int32]bool{3: true, 5: true}},
+		{simID, api.Constructor_RED_BULL_RACING, 33, 4, map[int32]bool{0: true}},
+		{uuid.New().String(), api.Constructor_FERRARI, 16, 20, nil}} {
+		for i := int32(0); i <= car.last; i++ {
+			if car.lost[i] {
+				continue
+			}
+			for d, u := range map[api.TelemetryDatumDescription]api.TelemetryDatumUnit{
+				api.TelemetryDatumDescription_SPEED: api.TelemetryDatumUnit_KPH, api.TelemetryDatumDescription_ENGINE_RPM: api.TelemetryDatumUnit_RPM} {
+				ts, _ := ipbts.TimestampProto(start.Add(time.Duration(i) * time.Second))
+				data = append(data, NewFromTelemetryDatum(&api.TelemetryDatum{Uuid: uuid.New().String(), Simulated: true,
+					SimulationUuid: car.simID, SimulationTransmitSequenceNumber: i, GranPrix: api.GranPrix_ITALIAN,
+					Track: api.Track_MONZA, Constructor: car.constructor, CarNumber: car.carNumber, Timestamp: ts,
+					Description: d, Unit: u, Value: 300}))
+			}
+		}
+	}
+	if _, err := s.CreateTelemetryData(data); err != nil {
+		t.Fatal("failed to create telemetry data with error: ", err)
+	}
+
+	progress, err := RetrieveTransmitProgress(simID)
+	if err != nil {
+		t.Fatal("failed to retrieve transmit progress with error: ", err)
+	}
+	if len(progress) != 2 {
+		t.Fatalf("expected the transmit progress of 2 cars, got %v", progress)
+	}
+	for i, v := range []struct {
+		carNumber int32
+		last      int32
+	}{{44, 9}, {77, 2}} {
+		if progress[i].Constructor != api.Constructor_MERCEDES || progress[i].CarNumber != v.carNumber ||
+			progress[i].LastTransmitSequenceNumber != v.last {
+			t.Errorf("expected car %v transmitted up to %v, got %v", v.carNumber, v.last, progress[i])
+		}
+	}
+
+	if progress, err = RetrieveTransmitProgress(uuid.New().String()); err != nil || len(progress) != 0 {
+		t.Errorf("expected no transmit progress for an unknown simulation, got %v with error: %v", progress, err)
+	}
+}
@@ -0,0 +1,308 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	ipbts "github.com/bburch01/FOTAAS/internal/pkg/protobuf/timestamp"
+
+	"github.com/bburch01/FOTAAS/api"
+)
+
+// telemetryDatumSelectColumns are the telemetry_datum columns read by scanTelemetryData, in scan order.
+const telemetryDatumSelectColumns = `id, simulated, simulation_id, simulation_transmit_sequence_number, gran_prix, track, constructor,
+	car_number, lap, sector, lap_distance, timestamp, timestamp_nanos, latitude, longitude, elevation, description, unit, value, hi_alarm, lo_alarm`
+
+// telemetryQuery accumulates the where clause conditions and their placeholder arguments for a
+// telemetry_datum select. Values are never written into the sql text, they are always passed to
+// the driver as arguments.
+type telemetryQuery struct {
+	conditions []string
+	args       []interface{}
+}
+
+// where adds a condition containing zero or more ? placeholders along with the matching arguments.
+func (q *telemetryQuery) where(condition string, args ...interface{}) {
+	q.conditions = append(q.conditions, condition)
+	q.args = append(q.args, args...)
+}
+
+// whereIn adds a column = ? condition for a single value or a column in (?, ...) condition for
+// several values. An empty value list adds nothing.
+func (q *telemetryQuery) whereIn(column string, values []interface{}) {
+	switch len(values) {
+	case 0:
+		return
+	case 1:
+		q.where(column+" = ?", values[0])
+	default:
+		q.where(column+" in (?"+strings.Repeat(", ?", len(values)-1)+")", values...)
+	}
+}
+
+func (q *telemetryQuery) sql() string {
+	return "select " + telemetryDatumSelectColumns + " from telemetry_datum" + q.whereClause()
+}
+
+func (q *telemetryQuery) whereClause() string {
+	var sb strings.Builder
+	for i, c := range q.conditions {
+		if i == 0 {
+			sb.WriteString(" where ")
+		} else {
+			sb.WriteString(" and ")
+		}
+		sb.WriteString(c)
+	}
+	return sb.String()
+}
+
+// telemetrySearch is the normalized form of the search criteria in a GetTelemetryDataRequest that
+// every TelemetryStore applies. A single value search (e.g. req.Constructor with
+// req.SearchBy.Constructor set) and the corresponding list (e.g. req.Constructors) are combined
+// into one list, an empty list matches any value.
+type telemetrySearch struct {
+	simulationID string
+	simulated    bool
+	constructors []string
+	carNumbers   []int32
+	laps         []int32
+	sectors      []int32
+	descriptions []string
+	granPrix     string
+	track        string
+	highAlarm    bool
+	lowAlarm     bool
+	dateRange    bool
+	// start is inclusive and end is exclusive.
+	start time.Time
+	end   time.Time
+}
+
+func newTelemetrySearch(req api.GetTelemetryDataRequest) (*telemetrySearch, error) {
+
+	search := new(telemetrySearch)
+
+	searchBy := req.SearchBy
+	if searchBy == nil {
+		searchBy = new(api.GetTelemetryDataRequest_SearchBy)
+	}
+
+	search.simulationID = req.SimulationUuid
+	search.simulated = req.Simulated
+
+	if searchBy.Constructor {
+		search.constructors = append(search.constructors, req.Constructor.String())
+	}
+	for _, v := range req.Constructors {
+		search.constructors = append(search.constructors, v.String())
+	}
+
+	if searchBy.CarNumber {
+		search.carNumbers = append(search.carNumbers, req.CarNumber)
+	}
+	search.carNumbers = append(search.carNumbers, req.CarNumbers...)
+
+	if searchBy.Lap {
+		search.laps = append(search.laps, req.Lap)
+	}
+	search.laps = append(search.laps, req.Laps...)
+
+	if searchBy.Sector {
+		search.sectors = append(search.sectors, req.Sector)
+	}
+	search.sectors = append(search.sectors, req.Sectors...)
+
+	if searchBy.DatumDescription {
+		search.descriptions = append(search.descriptions, req.DatumDescription.String())
+	}
+	for _, v := range req.DatumDescriptions {
+		search.descriptions = append(search.descriptions, v.String())
+	}
+	// The descriptions of registered channels are their names.
+	search.descriptions = append(search.descriptions, req.Channels...)
+
+	if searchBy.GranPrix {
+		search.granPrix = req.GranPrix.String()
+	}
+	if searchBy.Track {
+		search.track = req.Track.String()
+	}
+
+	search.highAlarm = searchBy.HighAlarm
+	search.lowAlarm = searchBy.LowAlarm
+
+	if searchBy.DateRange {
+
+		var startTs, endTs time.Time
+		var err error
+
+		if startTs, err = ipbts.Timestamp(req.DateRangeBegin); err != nil {
+			return nil, err
+		}
+
+		if endTs, err = ipbts.Timestamp(req.DateRangeEnd); err != nil {
+			return nil, err
+		}
+
+		// The date range is inclusive of both the start and end days. The end is the start of the day
+		// after the end day (exclusive) so that sub-second timestamps late in the end day are included.
+		search.dateRange = true
+		search.start = time.Date(startTs.Year(), startTs.Month(), startTs.Day(), 0, 0, 0, 0, time.UTC)
+		search.end = time.Date(endTs.Year(), endTs.Month(), endTs.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1)
+	}
+
+	return search, nil
+}
+
+// matches reports whether v, with timestamp t, satisfies the search. It is the in process
+// equivalent of the where clause built by newTelemetryQuery.
+func (search *telemetrySearch) matches(v *api.TelemetryDatum, t time.Time) bool {
+
+	switch {
+	case search.simulationID != "":
+		if v.SimulationUuid != search.simulationID {
+			return false
+		}
+	case v.Simulated != search.simulated:
+		return false
+	}
+
+	if len(search.constructors) > 0 && !containsString(search.constructors, v.Constructor.String()) {
+		return false
+	}
+
+	if len(search.carNumbers) > 0 && !containsInt32(search.carNumbers, v.CarNumber) {
+		return false
+	}
+	if len(search.laps) > 0 && !containsInt32(search.laps, v.Lap) {
+		return false
+	}
+	if len(search.sectors) > 0 && !containsInt32(search.sectors, v.Sector) {
+		return false
+	}
+
+	if len(search.descriptions) > 0 && !containsString(search.descriptions, channelName(v)) {
+		return false
+	}
+
+	if search.granPrix != "" && v.GranPrix.String() != search.granPrix {
+		return false
+	}
+	if search.track != "" && v.Track.String() != search.track {
+		return false
+	}
+
+	switch {
+	case search.highAlarm && search.lowAlarm:
+		if !v.HighAlarm && !v.LowAlarm {
+			return false
+		}
+	case search.highAlarm:
+		if !v.HighAlarm {
+			return false
+		}
+	case search.lowAlarm:
+		if !v.LowAlarm {
+			return false
+		}
+	}
+
+	if search.dateRange && (t.Before(search.start) || !t.Before(search.end)) {
+		return false
+	}
+
+	return true
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInt32(values []int32, n int32) bool {
+	for _, v := range values {
+		if v == n {
+			return true
+		}
+	}
+	return false
+}
+
+// newTelemetryQuery translates the search criteria in req into a parameterized telemetry_datum
+// select.
+func newTelemetryQuery(req api.GetTelemetryDataRequest) (*telemetryQuery, error) {
+
+	search, err := newTelemetrySearch(req)
+	if err != nil {
+		return nil, err
+	}
+
+	q := new(telemetryQuery)
+
+	// If no simulation uuid is given, select by the simulated flag only.
+	switch {
+	case search.simulationID != "":
+		q.where("simulation_id = ?", search.simulationID)
+	case search.simulated:
+		q.where("simulated = true")
+	default:
+		q.where("simulated = false")
+	}
+
+	var constructors []interface{}
+	for _, v := range search.constructors {
+		constructors = append(constructors, v)
+	}
+	q.whereIn("constructor", constructors)
+
+	var carNumbers []interface{}
+	for _, v := range search.carNumbers {
+		carNumbers = append(carNumbers, v)
+	}
+	q.whereIn("car_number", carNumbers)
+
+	var laps []interface{}
+	for _, v := range search.laps {
+		laps = append(laps, v)
+	}
+	q.whereIn("lap", laps)
+
+	var sectors []interface{}
+	for _, v := range search.sectors {
+		sectors = append(sectors, v)
+	}
+	q.whereIn("sector", sectors)
+
+	var descriptions []interface{}
+	for _, v := range search.descriptions {
+		descriptions = append(descriptions, v)
+	}
+	q.whereIn("description", descriptions)
+
+	if search.granPrix != "" {
+		q.where("gran_prix = ?", search.granPrix)
+	}
+	if search.track != "" {
+		q.where("track = ?", search.track)
+	}
+
+	switch {
+	case search.highAlarm && search.lowAlarm:
+		q.where("(lo_alarm = true or hi_alarm = true)")
+	case search.highAlarm:
+		q.where("hi_alarm = true")
+	case search.lowAlarm:
+		q.where("lo_alarm = true")
+	}
+
+	if search.dateRange {
+		q.where("timestamp >= ? and timestamp < ?", search.start.Format(timestampLayout), search.end.Format(timestampLayout))
+	}
+
+	return q, nil
+}
@@ -0,0 +1,122 @@
+package models
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultPurgeBatchSize = 1000
+
+// RetentionPolicy determines how long telemetry data is kept. A retention of 0 days keeps that
+// kind of telemetry data forever. The telemetry data of the simulations in KeepSimulationIDs is
+// always kept.
+type RetentionPolicy struct {
+	SimulatedRetentionDays int
+	RealRetentionDays      int
+	KeepSimulationIDs      []string
+	// BatchSize is the maximum number of datum deleted at a time, see TelemetryStore.DeleteTelemetryData.
+	BatchSize int
+}
+
+// PurgeResult is the number of datum purged (or, for a dry run, that would be purged).
+type PurgeResult struct {
+	SimulatedCount int64
+	RealCount      int64
+}
+
+// purgeMutex keeps the background purge worker and an on demand purge from running at the same time.
+var purgeMutex sync.Mutex
+
+// RetentionPolicyFromEnv loads the retention policy from the TELEMETRY_RETENTION_SIMULATED_DAYS,
+// TELEMETRY_RETENTION_REAL_DAYS, TELEMETRY_RETENTION_KEEP_SIMULATION_IDS (comma separated) and
+// TELEMETRY_PURGE_BATCH_SIZE environment variables.
+func RetentionPolicyFromEnv() (RetentionPolicy, error) {
+
+	var policy RetentionPolicy
+	var err error
+
+	if policy.SimulatedRetentionDays, err = envInt("TELEMETRY_RETENTION_SIMULATED_DAYS", 0); err != nil {
+		return policy, err
+	}
+	if policy.RealRetentionDays, err = envInt("TELEMETRY_RETENTION_REAL_DAYS", 0); err != nil {
+		return policy, err
+	}
+	if policy.BatchSize, err = envInt("TELEMETRY_PURGE_BATCH_SIZE", defaultPurgeBatchSize); err != nil {
+		return policy, err
+	}
+	if policy.BatchSize == 0 {
+		return policy, fmt.Errorf("invalid TELEMETRY_PURGE_BATCH_SIZE, must be greater than 0")
+	}
+
+	for _, v := range strings.Split(os.Getenv("TELEMETRY_RETENTION_KEEP_SIMULATION_IDS"), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			policy.KeepSimulationIDs = append(policy.KeepSimulationIDs, v)
+		}
+	}
+
+	return policy, nil
+}
+
+func envInt(name string, defaultValue int) (int, error) {
+	v := os.Getenv(name)
+	if v == "" {
+		return defaultValue, nil
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil || i < 0 {
+		return 0, fmt.Errorf("invalid %v %v, must be an integer greater than or equal to 0", name, v)
+	}
+	return i, nil
+}
+
+// PurgeTelemetryData deletes the telemetry data that is older than policy allows as of now, in
+// batches of at most policy.BatchSize datum. With dryRun set nothing is deleted and the result is
+// the number of datum that would be deleted.
+func PurgeTelemetryData(policy RetentionPolicy, now time.Time, dryRun bool) (PurgeResult, error) {
+
+	var result PurgeResult
+	var err error
+
+	purgeMutex.Lock()
+	defer purgeMutex.Unlock()
+
+	if policy.SimulatedRetentionDays > 0 {
+		criteria := PurgeCriteria{Simulated: true, Before: now.AddDate(0, 0, -policy.SimulatedRetentionDays),
+			KeepSimulationIDs: policy.KeepSimulationIDs}
+		if result.SimulatedCount, err = purge(criteria, policy.BatchSize, dryRun); err != nil {
+			return result, err
+		}
+	}
+
+	if policy.RealRetentionDays > 0 {
+		criteria := PurgeCriteria{Simulated: false, Before: now.AddDate(0, 0, -policy.RealRetentionDays)}
+		if result.RealCount, err = purge(criteria, policy.BatchSize, dryRun); err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+func purge(criteria PurgeCriteria, batchSize int, dryRun bool) (int64, error) {
+
+	if dryRun {
+		return store.CountTelemetryData(criteria)
+	}
+
+	var total int64
+	for {
+		count, err := store.DeleteTelemetryData(criteria, batchSize)
+		if err != nil {
+			return total, err
+		}
+		total += count
+		if count < int64(batchSize) {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package models
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	ipbts "github.com/bburch01/FOTAAS/internal/pkg/protobuf/timestamp"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/google/uuid"
+)
+
+func TestPurgeTelemetryData(t *testing.T) {
+
+	for _, s := range []TelemetryStore{newMemoryStore(), newTestSQLiteStore(t)} {
+		testPurgeTelemetryData(t, s)
+	}
+}
+
+func newTestSQLiteStore(t *testing.T) TelemetryStore {
+	s, err := openSQLiteStore(":memory:")
+	if err != nil {
+		t.Error("failed to open sqlite telemetry store with error: ", err)
+		t.FailNow()
+	}
+	if _, err = s.Migrate(false); err != nil {
+		t.Error("failed to migrate sqlite telemetry store with error: ", err)
+		t.FailNow()
+	}
+	return s
+}
+
+func testPurgeTelemetryData(t *testing.T, s TelemetryStore) {
+
+	saved := store
+	store = s
+	defer func() { store = saved }()
+
+	now := time.Date(2019, 8, 1, 0, 0, 0, 0, time.UTC)
+	keepID := uuid.New().String()
+	purgeID := uuid.New().String()
+
+	var data []TelemetryDatum
+	add := func(simulated bool, simID string, age time.Duration) {
+		ts, err := ipbts.TimestampProto(now.Add(-age))
+		if err != nil {
+			t.Error("failed to create timestamp with error: ", err)
+			t.FailNow()
+		}
+		data = append(data, NewFromTelemetryDatum(&api.TelemetryDatum{Uuid: uuid.New().String(), Simulated: simulated,
+			SimulationUuid: simID, Timestamp: ts, Description: api.TelemetryDatumDescription_SPEED,
+			Unit: api.TelemetryDatumUnit_KPH}))
+	}
+
+	day := 24 * time.Hour
+	for i := 0; i < 5; i++ {
+		add(true, purgeID, 10*day)
+		add(true, keepID, 10*day)
+		add(true, purgeID, day)
+		add(false, "", 40*day)
+		add(false, "", 10*day)
+	}
+
+	if _, err := store.CreateTelemetryData(data); err != nil {
+		t.Error("failed to create telemetry data with error: ", err)
+		t.FailNow()
+	}
+
+	policy := RetentionPolicy{SimulatedRetentionDays: 7, RealRetentionDays: 30, KeepSimulationIDs: []string{keepID}, BatchSize: 2}
+
+	result, err := PurgeTelemetryData(policy, now, true)
+	if err != nil {
+		t.Error("failed to preview telemetry data purge with error: ", err)
+		t.FailNow()
+	}
+	if result.SimulatedCount != 5 || result.RealCount != 5 {
+		t.Errorf("unexpected purge preview: %+v", result)
+	}
+
+	if result, err = PurgeTelemetryData(policy, now, false); err != nil {
+		t.Error("failed to purge telemetry data with error: ", err)
+		t.FailNow()
+	}
+	if result.SimulatedCount != 5 || result.RealCount != 5 {
+		t.Errorf("unexpected purge result: %+v", result)
+	}
+
+	if result, err = PurgeTelemetryData(policy, now, true); err != nil {
+		t.Error("failed to preview telemetry data purge with error: ", err)
+		t.FailNow()
+	}
+	if result.SimulatedCount != 0 || result.RealCount != 0 {
+		t.Errorf("expected nothing left to purge, got %+v", result)
+	}
+
+	remaining, err := store.RetrieveTelemetryData(api.GetTelemetryDataRequest{Simulated: true})
+	if err != nil {
+		t.Error("failed to retrieve telemetry data with error: ", err)
+		t.FailNow()
+	}
+	if len(remaining.TelemetryDatumMap) != 10 {
+		t.Errorf("expected 10 simulated telemetry datum to remain, got %v", len(remaining.TelemetryDatumMap))
+	}
+}
+
+func TestPurgeTelemetryDataDefaultBatchSize(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "fotaas-columnar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	columnar := newTestColumnarStore(t, dir, 100)
+	defer columnar.close()
+
+	for _, s := range []TelemetryStore{newMemoryStore(), newTestSQLiteStore(t), columnar} {
+		testPurgeTelemetryDataDefaultBatchSize(t, s)
+	}
+}
+
+// testPurgeTelemetryDataDefaultBatchSize purges more datum than sqlite allows placeholders in a
+// statement with the default batch size.
+func testPurgeTelemetryDataDefaultBatchSize(t *testing.T, s TelemetryStore) {
+
+	saved := store
+	store = s
+	defer func() { store = saved }()
+
+	now := time.Date(2019, 8, 1, 0, 0, 0, 0, time.UTC)
+	simID := uuid.New().String()
+
+	ts, err := ipbts.TimestampProto(now.AddDate(0, 0, -10))
+	if err != nil {
+		t.Error("failed to create timestamp with error: ", err)
+		t.FailNow()
+	}
+
+	count := 2*defaultPurgeBatchSize + 500
+	data := make([]TelemetryDatum, 0, count)
+	for i := 0; i < count; i++ {
+		data = append(data, NewFromTelemetryDatum(&api.TelemetryDatum{Uuid: uuid.New().String(), Simulated: true,
+			SimulationUuid: simID, SimulationTransmitSequenceNumber: int32(i), Timestamp: ts,
+			Description: api.TelemetryDatumDescription_SPEED, Unit: api.TelemetryDatumUnit_KPH}))
+	}
+
+	if _, err = store.CreateTelemetryData(data); err != nil {
+		t.Error("failed to create telemetry data with error: ", err)
+		t.FailNow()
+	}
+
+	policy := RetentionPolicy{SimulatedRetentionDays: 7, BatchSize: defaultPurgeBatchSize}
+
+	result, err := PurgeTelemetryData(policy, now, false)
+	if err != nil {
+		t.Error("failed to purge telemetry data with error: ", err)
+		t.FailNow()
+	}
+	if result.SimulatedCount != int64(count) {
+		t.Errorf("expected %v telemetry datum to be purged, got %+v", count, result)
+	}
+
+	if result, err = PurgeTelemetryData(policy, now, true); err != nil || result.SimulatedCount != 0 {
+		t.Errorf("expected nothing left to purge, got %+v with error: %v", result, err)
+	}
+}
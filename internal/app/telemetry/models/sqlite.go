@@ -0,0 +1,39 @@
+package models
+
+import (
+	"database/sql"
+
+	// The sqlite driver requires cgo.
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const (
+	defaultSQLitePath = "fotaas-telemetry.db"
+	// sqlite limits a prepared statement to 999 placeholders.
+	maxSQLiteInsertBatchSize = 999 / telemetryDatumColumnCount
+)
+
+// openSQLiteStore opens (creating it if necessary) the sqlite telemetry database at path, its schema
+// is created by Migrate.
+func openSQLiteStore(path string) (*sqlStore, error) {
+
+	if path == "" {
+		path = defaultSQLitePath
+	}
+
+	db, err := sql.Open("sqlite3", path+"?_busy_timeout=5000")
+	if err != nil {
+		return nil, err
+	}
+
+	// sqlite serializes writers anyway, a single connection also keeps a ":memory:" database from
+	// being opened once per pooled connection.
+	db.SetMaxOpenConns(1)
+
+	batchSize := insertBatchSize
+	if batchSize > maxSQLiteInsertBatchSize {
+		batchSize = maxSQLiteInsertBatchSize
+	}
+
+	return &sqlStore{db: db, batchSize: batchSize, migrations: sqliteMigrations, dialect: sqliteDialect}, nil
+}
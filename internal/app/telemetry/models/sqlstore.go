@@ -0,0 +1,416 @@
+package models
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bburch01/FOTAAS/internal/pkg/migrate"
+	ipbts "github.com/bburch01/FOTAAS/internal/pkg/protobuf/timestamp"
+
+	"github.com/bburch01/FOTAAS/api"
+)
+
+// sqlStore is the TelemetryStore for the sql databases (mysql and sqlite), the telemetry_datum
+// queries are written in the subset of sql that both support.
+type sqlStore struct {
+	db *sql.DB
+	// batchSize is the maximum number of rows in a multi-row insert.
+	batchSize int
+	// migrations is the schema history of the database's dialect.
+	migrations []migrate.Migration
+	// dialect is the sql that differs between the databases.
+	dialect sqlDialect
+}
+
+// sqlDialect is the sql that differs between the databases of the sql stores.
+type sqlDialect struct {
+	// epochSeconds is the expression of the whole unix seconds of the timestamp column, which holds
+	// the utc time.
+	epochSeconds string
+	// div is the integer division operator.
+	div string
+}
+
+var mysqlDialect = sqlDialect{epochSeconds: "timestampdiff(second, '1970-01-01 00:00:00', timestamp)", div: "div"}
+
+var sqliteDialect = sqlDialect{epochSeconds: "cast(strftime('%s', timestamp) as integer)", div: "/"}
+
+func (s *sqlStore) Ping() error {
+	return s.db.Ping()
+}
+
+// CreateTelemetryData persists all of data in a single transaction using multi-row inserts of at
+// most batchSize rows each and returns the ingest status of each datum keyed by uuid. Datum that
+// were already persisted with identical content are skipped so that a retried transmission is
+// safe. If any datum is in conflict, ErrTelemetryDatumConflict is returned along with the statuses
+// and nothing is persisted. On any other error nothing is persisted either.
+func (s *sqlStore) CreateTelemetryData(data []TelemetryDatum) (map[string]IngestStatus, error) {
+
+	if len(data) == 0 {
+		return make(map[string]IngestStatus), nil
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	rollback := func() {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			logger.Error(fmt.Sprintf("failed to rollback telemetry datum insert with error: %v", rbErr))
+		}
+	}
+
+	persisted, err := s.retrieveContentHashes(tx, data)
+	if err != nil {
+		rollback()
+		return nil, err
+	}
+
+	statusMap, inserts, conflict := classifyTelemetryData(data, persisted)
+	if conflict {
+		rollback()
+		return statusMap, ErrTelemetryDatumConflict
+	}
+
+	for start := 0; start < len(inserts); start += s.batchSize {
+		end := start + s.batchSize
+		if end > len(inserts) {
+			end = len(inserts)
+		}
+		if err = insertTelemetryDatumBatch(tx, inserts[start:end]); err != nil {
+			rollback()
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return statusMap, nil
+}
+
+// retrieveContentHashes returns the content hash of every datum in data that has already been
+// persisted, keyed by uuid.
+func (s *sqlStore) retrieveContentHashes(tx *sql.Tx, data []TelemetryDatum) (map[string]sql.NullString, error) {
+
+	hashes := make(map[string]sql.NullString)
+
+	for start := 0; start < len(data); start += s.batchSize {
+		end := start + s.batchSize
+		if end > len(data) {
+			end = len(data)
+		}
+
+		args := make([]interface{}, 0, end-start)
+		for _, td := range data[start:end] {
+			args = append(args, td.ID)
+		}
+
+		query := "select id, content_hash from telemetry_datum where id in (?" + strings.Repeat(", ?", len(args)-1) + ")"
+		rows, err := tx.Query(query, args...)
+		if err != nil {
+			return nil, err
+		}
+
+		for rows.Next() {
+			var id string
+			var hash sql.NullString
+			if err = rows.Scan(&id, &hash); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			hashes[id] = hash
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return hashes, nil
+}
+
+func insertTelemetryDatumBatch(tx *sql.Tx, batch []TelemetryDatum) error {
+
+	var sb strings.Builder
+	args := make([]interface{}, 0, len(batch)*telemetryDatumColumnCount)
+
+	sb.WriteString(`INSERT INTO telemetry_datum (id, simulated, simulation_id, simulation_transmit_sequence_number, gran_prix, track,
+		constructor, car_number, lap, sector, lap_distance, timestamp, timestamp_nanos, latitude, longitude, elevation, description, unit,
+		value, hi_alarm, lo_alarm, content_hash) VALUES `)
+
+	for i, td := range batch {
+
+		t, err := ipbts.Timestamp(td.Timestamp)
+		if err != nil {
+			return err
+		}
+
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString("(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+
+		args = append(args, td.ID, td.Simulated, td.SimulationID, td.SimulationTransmitSequenceNumber, td.GranPrix, td.Track,
+			td.Constructor, td.CarNumber, td.Lap, td.Sector, td.LapDistance, t.Format(timestampLayout), t.Nanosecond(), td.Latitude,
+			td.Longitude, td.Elevation, td.Description, td.Unit, td.Value, td.HiAlarm, td.LoAlarm, td.ContentHash())
+	}
+
+	_, err := tx.Exec(sb.String(), args...)
+	return err
+}
+
+func (s *sqlStore) RetrieveTelemetryData(req api.GetTelemetryDataRequest) (*api.TelemetryData, error) {
+
+	q, err := newTelemetryQuery(req)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug(fmt.Sprintf("select sql: %v", q.sql()))
+	rows, err := s.db.Query(q.sql(), q.args...)
+
+	switch {
+	case err == sql.ErrNoRows:
+		// no rows & no errors, caller needs to check for nil TelemetryData
+		return nil, nil
+	case err != nil:
+		logger.Error(fmt.Sprintf("failed to retrieve telemetry data with error: %v", err))
+		return nil, err
+	default:
+		defer rows.Close()
+		data, _, err := scanTelemetryData(rows)
+		if err != nil {
+			return nil, err
+		}
+		return data, nil
+	}
+}
+
+func (s *sqlStore) RetrieveTelemetryDataPage(req api.GetTelemetryDataRequest) (*api.TelemetryData, string, error) {
+
+	size := pageSize(req)
+
+	q, err := newTelemetryQuery(req)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if req.PageToken != "" {
+		token, err := decodePageToken(req.PageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		ts := token.timestamp.Format(timestampLayout)
+		nanos := token.timestamp.Nanosecond()
+		q.where("(timestamp > ? or (timestamp = ? and (timestamp_nanos > ? or (timestamp_nanos = ? and id > ?))))",
+			ts, ts, nanos, nanos, token.id)
+	}
+
+	query := q.sql() + " order by timestamp, timestamp_nanos, id limit ?"
+	args := append(q.args, size)
+
+	logger.Debug(fmt.Sprintf("select sql: %v", query))
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to retrieve telemetry data with error: %v", err))
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	data, last, err := scanTelemetryData(rows)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// A short page means that the last matching row has been retrieved.
+	if len(data.TelemetryDatumMap) < size {
+		return data, "", nil
+	}
+
+	nextPageToken, err := encodePageToken(last)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return data, nextPageToken, nil
+}
+
+// scanTelemetryData scans every row in rows into a TelemetryData map and also returns the last
+// datum scanned (nil if there were no rows).
+func scanTelemetryData(rows *sql.Rows) (*api.TelemetryData, *api.TelemetryDatum, error) {
+
+	var txSeqNum, carNumber, tsNanos int32
+	var granPrix, track, constructor, datumDescription, datumUnit string
+	var ts time.Time
+	var last *api.TelemetryDatum
+
+	data := api.TelemetryData{}
+	datumMap := make(map[string]*api.TelemetryDatum)
+
+	for rows.Next() {
+
+		datum := api.TelemetryDatum{}
+
+		err := rows.Scan(&datum.Uuid, &datum.Simulated, &datum.SimulationUuid, &txSeqNum, &granPrix,
+			&track, &constructor, &carNumber, &datum.Lap, &datum.Sector, &datum.LapDistance, &ts, &tsNanos, &datum.Latitude,
+			&datum.Longitude, &datum.Elevation, &datumDescription, &datumUnit, &datum.Value, &datum.HighAlarm, &datum.LowAlarm)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if err = setDatumChannel(&datum, datumDescription, datumUnit); err != nil {
+			return nil, nil, err
+		}
+
+		tsProto, err := ipbts.TimestampProto(time.Unix(ts.Unix(), int64(tsNanos)).UTC())
+		if err != nil {
+			return nil, nil, errors.New("failed to convert timestamp to protobuf format")
+		}
+		datum.Timestamp = tsProto
+
+		ordinal, ok := api.GranPrix_value[granPrix]
+		if !ok {
+			return nil, nil, fmt.Errorf("invalid gran prix enum: %v", granPrix)
+		}
+		datum.GranPrix = api.GranPrix(ordinal)
+
+		ordinal, ok = api.Track_value[track]
+		if !ok {
+			return nil, nil, fmt.Errorf("invalid track enum: %v", track)
+		}
+		datum.Track = api.Track(ordinal)
+
+		ordinal, ok = api.Constructor_value[constructor]
+		if !ok {
+			return nil, nil, fmt.Errorf("invalid constructor enum: %v", constructor)
+		}
+		datum.Constructor = api.Constructor(ordinal)
+
+		datum.CarNumber = carNumber
+		datum.SimulationTransmitSequenceNumber = txSeqNum
+
+		datumMap[datum.Uuid] = &datum
+		last = &datum
+
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	data.TelemetryDatumMap = datumMap
+
+	return &data, last, nil
+}
+
+func purgeWhere(criteria PurgeCriteria) *telemetryQuery {
+
+	q := new(telemetryQuery)
+	if criteria.Simulated {
+		q.where("simulated = true")
+	} else {
+		q.where("simulated = false")
+	}
+	q.where("timestamp < ?", criteria.Before.UTC().Format(timestampLayout))
+	if len(criteria.KeepSimulationIDs) > 0 {
+		args := make([]interface{}, 0, len(criteria.KeepSimulationIDs))
+		for _, v := range criteria.KeepSimulationIDs {
+			args = append(args, v)
+		}
+		q.where("(simulation_id is null or simulation_id not in (?"+strings.Repeat(", ?", len(args)-1)+"))", args...)
+	}
+
+	return q
+}
+
+func (s *sqlStore) CountTelemetryData(criteria PurgeCriteria) (int64, error) {
+
+	q := purgeWhere(criteria)
+
+	var count int64
+	query := "select count(*) from telemetry_datum" + q.whereClause()
+	if err := s.db.QueryRow(query, q.args...).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (s *sqlStore) DeleteTelemetryData(criteria PurgeCriteria, limit int) (int64, error) {
+
+	q := purgeWhere(criteria)
+
+	// Select the batch first rather than delete with a limit, mysql does not allow a limit in an in
+	// subquery and sqlite only supports delete ... limit when compiled with it.
+	rows, err := s.db.Query("select id from telemetry_datum"+q.whereClause()+" limit ?", append(q.args, limit)...)
+	if err != nil {
+		return 0, err
+	}
+
+	var ids []interface{}
+	for rows.Next() {
+		var id string
+		if err = rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		ids = append(ids, id)
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil {
+		return 0, err
+	}
+
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	// Delete the batch in chunks of at most batchSize ids, same as retrieveContentHashes, since
+	// limit may be larger than the number of placeholders sqlite allows in a statement.
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+
+	rollback := func() {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			logger.Error(fmt.Sprintf("failed to rollback telemetry datum delete with error: %v", rbErr))
+		}
+	}
+
+	var count int64
+	for start := 0; start < len(ids); start += s.batchSize {
+		end := start + s.batchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		query := "delete from telemetry_datum where id in (?" + strings.Repeat(", ?", end-start-1) + ")"
+		result, err := tx.Exec(query, ids[start:end]...)
+		if err != nil {
+			rollback()
+			return 0, err
+		}
+
+		n, err := result.RowsAffected()
+		if err != nil {
+			rollback()
+			return 0, err
+		}
+		count += n
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
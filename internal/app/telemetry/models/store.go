@@ -0,0 +1,76 @@
+package models
+
+import (
+	"time"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/bburch01/FOTAAS/internal/pkg/migrate"
+)
+
+// TelemetryStore is the storage backend of the telemetry service. The backend is selected by the
+// TELEMETRY_STORE environment variable when InitDB is called:
+//
+//	mysql    - (default) the mysql database TELEMETRY_SERVICE_DB_NAME on DB_HOST
+//	sqlite   - an embedded sqlite database in the file TELEMETRY_SQLITE_PATH
+//	memory   - an in process store that is lost when the service exits
+//	columnar - compressed per channel series files in the directory TELEMETRY_COLUMNAR_PATH, for
+//	           high sample rate telemetry
+//
+// The sqlite and memory stores allow the telemetry service to run without a database server (e.g.
+// during development and in CI).
+type TelemetryStore interface {
+	// CreateTelemetryData persists data and returns the ingest status of each datum keyed by uuid.
+	// Either all of the new datum in data are persisted or, on error, none of them are. If any datum
+	// is in conflict ErrTelemetryDatumConflict is returned along with the statuses.
+	CreateTelemetryData(data []TelemetryDatum) (map[string]IngestStatus, error)
+	// RetrieveTelemetryData retrieves all of the telemetry data matching req.
+	RetrieveTelemetryData(req api.GetTelemetryDataRequest) (*api.TelemetryData, error)
+	// RetrieveTelemetryDataPage retrieves the page of the telemetry data matching req that follows
+	// req.PageToken, along with the token of the next page (empty when there are no more pages).
+	RetrieveTelemetryDataPage(req api.GetTelemetryDataRequest) (*api.TelemetryData, string, error)
+	// RetrieveTelemetryAggregates computes the aggregates of the telemetry data matching req.Filter
+	// in buckets of req.BucketWidthMillis (which is greater than 0), with the buckets of each series
+	// in time order and every aggregate function computed.
+	RetrieveTelemetryAggregates(req api.GetTelemetryAggregatesRequest) ([]*api.TelemetryAggregateSeries, error)
+	// CountTelemetryData returns the number of datum matching criteria.
+	CountTelemetryData(criteria PurgeCriteria) (int64, error)
+	// DeleteTelemetryData deletes at most limit of the datum matching criteria (a store may exceed
+	// limit to delete in larger units, e.g. the columnar store deletes a series at a time) and
+	// returns the number deleted.
+	DeleteTelemetryData(criteria PurgeCriteria, limit int) (int64, error)
+	// CreateAlarmThreshold adds t to the alarm threshold registry, ErrAlarmThresholdConflict is
+	// returned if a threshold with the same datum description and overrides exists.
+	CreateAlarmThreshold(t *api.AlarmThreshold) error
+	// RetrieveAlarmThreshold retrieves the alarm threshold with uuid id, ErrAlarmThresholdNotFound
+	// is returned if there is none.
+	RetrieveAlarmThreshold(id string) (*api.AlarmThreshold, error)
+	// RetrieveAlarmThresholds retrieves every alarm threshold in the registry, in no particular order.
+	RetrieveAlarmThresholds() ([]*api.AlarmThreshold, error)
+	// UpdateAlarmThreshold replaces the alarm threshold with uuid t.Uuid with t.
+	UpdateAlarmThreshold(t *api.AlarmThreshold) error
+	// DeleteAlarmThreshold deletes the alarm threshold with uuid id.
+	DeleteAlarmThreshold(id string) error
+	// CreateChannel adds c to the channel registry, ErrChannelConflict is returned if a channel with
+	// the same name exists.
+	CreateChannel(c *api.Channel) error
+	// RetrieveChannels retrieves every registered channel, in no particular order.
+	RetrieveChannels() ([]*api.Channel, error)
+	// RetrieveTransmitProgress retrieves the last simulation transmit sequence number up to which
+	// every sequence number is stored for each car of the simulation with id simulationID.
+	RetrieveTransmitProgress(simulationID string) ([]*api.CarTransmitProgress, error)
+	// Migrate applies the pending schema migrations and returns them, with dryRun set it only
+	// returns them.
+	Migrate(dryRun bool) ([]migrate.Migration, error)
+	// Ping checks that the store is available.
+	Ping() error
+}
+
+var store TelemetryStore
+
+// PurgeCriteria selects the telemetry data to be purged: the simulated (or real) datum with a
+// timestamp before Before, except for the datum of the simulations in KeepSimulationIDs.
+type PurgeCriteria struct {
+	Simulated         bool
+	Before            time.Time
+	KeepSimulationIDs []string
+}
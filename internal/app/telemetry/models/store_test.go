@@ -0,0 +1,162 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	ipbts "github.com/bburch01/FOTAAS/internal/pkg/protobuf/timestamp"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/uuid"
+)
+
+// These tests run against the stores that do not need a database server. Run them with
+// TELEMETRY_STORE=memory (or sqlite) so that the package test init does not need mysql either.
+
+func TestMemoryStore(t *testing.T) {
+	testTelemetryStore(t, newMemoryStore())
+}
+
+func TestSQLiteStore(t *testing.T) {
+
+	s := newTestSQLiteStore(t)
+	defer s.(*sqlStore).db.Close()
+
+	testTelemetryStore(t, s)
+
+	// The schema is already current, so there is nothing left to migrate.
+	if pending, err := s.Migrate(true); err != nil || len(pending) != 0 {
+		t.Errorf("expected no pending migrations, got %v with error: %v", len(pending), err)
+	}
+}
+
+func testTelemetryStore(t *testing.T, s TelemetryStore) {
+
+	if err := s.Ping(); err != nil {
+		t.Error("failed to ping telemetry store with error: ", err)
+		t.FailNow()
+	}
+
+	simID := uuid.New().String()
+	start := time.Date(2019, 7, 14, 23, 59, 59, 999999999, time.UTC)
+
+	var data []TelemetryDatum
+	for i, c := range []api.Constructor{api.Constructor_HAAS, api.Constructor_MERCEDES, api.Constructor_FERRARI} {
+		ts, err := ipbts.TimestampProto(start.Add(time.Duration(i) * 1234567 * time.Nanosecond))
+		if err != nil {
+			t.Error("failed to create timestamp with error: ", err)
+			t.FailNow()
+		}
+		data = append(data, NewFromTelemetryDatum(&api.TelemetryDatum{Uuid: uuid.New().String(), Simulated: true,
+			SimulationUuid: simID, SimulationTransmitSequenceNumber: int32(i), GranPrix: api.GranPrix_GERMAN,
+			Track: api.Track_HOCKENHEIM, Constructor: c, CarNumber: int32(i + 1), Lap: int32(11 + (i+1)/2),
+			Sector: int32(i + 1), LapDistance: 1234.5 + float64(i)*1500, Timestamp: ts,
+			Latitude: 49.327 + float64(i)/1e7, Longitude: 8.565 + float64(i)/1e7, Elevation: 101.25,
+			Description: api.TelemetryDatumDescription_SPEED, Unit: api.TelemetryDatumUnit_KPH, Value: 287.123456789,
+			HighAlarm: i == 2}))
+	}
+
+	statusMap, err := s.CreateTelemetryData(data)
+	if err != nil {
+		t.Error("failed to create telemetry data with error: ", err)
+		t.FailNow()
+	}
+	for _, v := range data {
+		if statusMap[v.ID] != Inserted {
+			t.Errorf("expected telemetry datum %v to be inserted, got %v", v.ID, statusMap[v.ID])
+		}
+	}
+
+	// A retry of the same telemetry data is a duplicate.
+	if statusMap, err = s.CreateTelemetryData(data); err != nil {
+		t.Error("failed to create duplicate telemetry data with error: ", err)
+		t.FailNow()
+	}
+	for _, v := range data {
+		if statusMap[v.ID] != Duplicate {
+			t.Errorf("expected telemetry datum %v to be a duplicate, got %v", v.ID, statusMap[v.ID])
+		}
+	}
+
+	// A changed datum with the same uuid is a conflict and nothing in the request is persisted.
+	changed := data[0]
+	changed.Value++
+	extra := data[1]
+	extra.ID = uuid.New().String()
+	if statusMap, err = s.CreateTelemetryData([]TelemetryDatum{changed, extra}); err != ErrTelemetryDatumConflict {
+		t.Errorf("expected a telemetry datum conflict, got %v", err)
+	}
+	if statusMap[changed.ID] != Conflict {
+		t.Errorf("expected telemetry datum %v to be a conflict, got %v", changed.ID, statusMap[changed.ID])
+	}
+
+	req := api.GetTelemetryDataRequest{SimulationUuid: simID, SearchBy: &api.GetTelemetryDataRequest_SearchBy{}}
+
+	all, err := s.RetrieveTelemetryData(req)
+	if err != nil {
+		t.Error("failed to retrieve telemetry data with error: ", err)
+		t.FailNow()
+	}
+	if len(all.TelemetryDatumMap) != len(data) {
+		t.Errorf("expected %v telemetry datum, got %v", len(data), len(all.TelemetryDatumMap))
+	}
+
+	// Timestamps and positions round trip exactly.
+	for _, v := range data {
+		expected, err := v.toProto()
+		if err != nil {
+			t.Error("failed to convert telemetry datum with error: ", err)
+			t.FailNow()
+		}
+		if !proto.Equal(expected, all.TelemetryDatumMap[v.ID]) {
+			t.Errorf("telemetry datum did not round trip, expected %v got %v", expected, all.TelemetryDatumMap[v.ID])
+		}
+	}
+
+	req.Constructors = []api.Constructor{api.Constructor_HAAS, api.Constructor_FERRARI}
+	req.SearchBy.DateRange = true
+	req.DateRangeBegin, _ = ipbts.TimestampProto(start)
+	req.DateRangeEnd, _ = ipbts.TimestampProto(start)
+	filtered, err := s.RetrieveTelemetryData(req)
+	if err != nil {
+		t.Error("failed to retrieve filtered telemetry data with error: ", err)
+		t.FailNow()
+	}
+	// Only the HAAS datum is on the end day, the FERRARI datum is just after midnight.
+	if len(filtered.TelemetryDatumMap) != 1 || filtered.TelemetryDatumMap[data[0].ID] == nil {
+		t.Errorf("unexpected filtered telemetry data: %v", filtered.TelemetryDatumMap)
+	}
+
+	// Laps 11, 12 and 12 in sectors 1, 2 and 3.
+	lapReq := api.GetTelemetryDataRequest{SimulationUuid: simID, Lap: 12, Sectors: []int32{1, 2},
+		SearchBy: &api.GetTelemetryDataRequest_SearchBy{Lap: true}}
+	filtered, err = s.RetrieveTelemetryData(lapReq)
+	if err != nil {
+		t.Error("failed to retrieve lap filtered telemetry data with error: ", err)
+		t.FailNow()
+	}
+	if len(filtered.TelemetryDatumMap) != 1 || filtered.TelemetryDatumMap[data[1].ID] == nil {
+		t.Errorf("unexpected lap filtered telemetry data: %v", filtered.TelemetryDatumMap)
+	}
+
+	pageReq := api.GetTelemetryDataRequest{SimulationUuid: simID, PageSize: 2}
+	var paged []string
+	for {
+		page, nextPageToken, err := s.RetrieveTelemetryDataPage(pageReq)
+		if err != nil {
+			t.Error("failed to retrieve telemetry data page with error: ", err)
+			t.FailNow()
+		}
+		for k := range page.TelemetryDatumMap {
+			paged = append(paged, k)
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageReq.PageToken = nextPageToken
+	}
+	if len(paged) != len(data) {
+		t.Errorf("expected %v paged telemetry datum, got %v", len(data), len(paged))
+	}
+}
@@ -1,7 +1,9 @@
 package models
 
 import (
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strconv"
@@ -14,6 +16,11 @@ import (
 	"github.com/bburch01/FOTAAS/api"
 )
 
+// timestampLayout formats a time for a TIMESTAMP(6) column. TIMESTAMP(6) only has microsecond
+// precision so the full nanosecond of the second is also stored in timestamp_nanos, which is what
+// the timestamp is rebuilt from on retrieval.
+const timestampLayout = "2006-01-02 15:04:05.000000"
+
 type TelemetryDatum struct {
 	ID                               string
 	Simulated                        bool
@@ -23,6 +30,9 @@ type TelemetryDatum struct {
 	Track                            string
 	Constructor                      string
 	CarNumber                        int32
+	Lap                              int32
+	Sector                           int32
+	LapDistance                      float64
 	Timestamp                        *pbts.Timestamp
 	Latitude                         float64
 	Longitude                        float64
@@ -34,204 +44,264 @@ type TelemetryDatum struct {
 	LoAlarm                          bool
 }
 
+// Create persists td on its own, see CreateTelemetryData.
 func (td *TelemetryDatum) Create() error {
 
-	sqlStatement := `
-		INSERT INTO telemetry_datum (id, simulated, simulation_id, simulation_transmit_sequence_number, gran_prix, track, constructor,
-			car_number, timestamp, latitude, longitude, elevation, description, unit, value, hi_alarm, lo_alarm)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	_, err := store.CreateTelemetryData([]TelemetryDatum{*td})
+	return err
+}
 
-	pstmt, err := db.Prepare(sqlStatement)
-	if err != nil {
-		return err
-	}
-	defer pstmt.Close()
+// IngestStatus is the outcome of CreateTelemetryData for a single telemetry datum.
+type IngestStatus int
+
+const (
+	// Inserted means the datum was not previously persisted and has been inserted.
+	Inserted IngestStatus = iota
+	// Duplicate means a datum with the same uuid and identical content was already persisted
+	// (e.g. a retried transmission), it has been skipped.
+	Duplicate
+	// Conflict means a datum with the same uuid but different content was already persisted, or
+	// appears more than once in the request with different content.
+	Conflict
+)
 
-	var t time.Time
-	t, err = ipbts.Timestamp(td.Timestamp)
-	if err != nil {
-		return err
-	}
+// ErrTelemetryDatumConflict is returned by CreateTelemetryData when at least one datum conflicts
+// with a previously persisted datum.
+var ErrTelemetryDatumConflict = errors.New("telemetry datum conflicts with a previously persisted telemetry datum with the same uuid")
+
+// ContentHash returns a sha256 hex digest of the content of td as transmitted. It is stored with
+// the datum so that a re-transmitted datum can be told apart from a different datum that reuses
+// the same uuid.
+func (td *TelemetryDatum) ContentHash() string {
 
-	// Format the timestamp to what mysql likes
-	ts := t.Format("2006-01-02 15:04:05")
+	var sb strings.Builder
 
-	_, err = pstmt.Exec(td.ID, td.Simulated, td.SimulationID, td.SimulationTransmitSequenceNumber, td.GranPrix, td.Track, td.Constructor,
-		td.CarNumber, ts, td.Latitude, td.Longitude, td.Elevation, td.Description,
-		td.Unit, td.Value, td.HiAlarm, td.LoAlarm)
-	if err != nil {
-		return err
+	sb.WriteString(td.ID)
+	sb.WriteString("|")
+	sb.WriteString(strconv.FormatBool(td.Simulated))
+	sb.WriteString("|")
+	sb.WriteString(td.SimulationID)
+	sb.WriteString("|")
+	sb.WriteString(strconv.Itoa(int(td.SimulationTransmitSequenceNumber)))
+	sb.WriteString("|")
+	sb.WriteString(td.GranPrix)
+	sb.WriteString("|")
+	sb.WriteString(td.Track)
+	sb.WriteString("|")
+	sb.WriteString(td.Constructor)
+	sb.WriteString("|")
+	sb.WriteString(strconv.Itoa(int(td.CarNumber)))
+	sb.WriteString("|")
+	if td.Timestamp != nil {
+		sb.WriteString(strconv.FormatInt(td.Timestamp.Seconds, 10))
+		sb.WriteString(".")
+		sb.WriteString(strconv.Itoa(int(td.Timestamp.Nanos)))
+	}
+	sb.WriteString("|")
+	sb.WriteString(strconv.FormatFloat(td.Latitude, 'g', -1, 64))
+	sb.WriteString("|")
+	sb.WriteString(strconv.FormatFloat(td.Longitude, 'g', -1, 64))
+	sb.WriteString("|")
+	sb.WriteString(strconv.FormatFloat(td.Elevation, 'g', -1, 64))
+	sb.WriteString("|")
+	sb.WriteString(td.Description)
+	sb.WriteString("|")
+	sb.WriteString(td.Unit)
+	sb.WriteString("|")
+	sb.WriteString(strconv.FormatFloat(td.Value, 'g', -1, 64))
+	sb.WriteString("|")
+	sb.WriteString(strconv.FormatBool(td.HiAlarm))
+	sb.WriteString("|")
+	sb.WriteString(strconv.FormatBool(td.LoAlarm))
+	// The lap fields were added later, they are only hashed when set so that the hash of a datum
+	// without them is unchanged.
+	if td.Lap != 0 || td.Sector != 0 || td.LapDistance != 0 {
+		sb.WriteString("|")
+		sb.WriteString(strconv.Itoa(int(td.Lap)))
+		sb.WriteString("|")
+		sb.WriteString(strconv.Itoa(int(td.Sector)))
+		sb.WriteString("|")
+		sb.WriteString(strconv.FormatFloat(td.LapDistance, 'g', -1, 64))
 	}
 
-	return nil
+	sum := sha256.Sum256([]byte(sb.String()))
 
+	return hex.EncodeToString(sum[:])
 }
 
-func RetrieveTelemetryData(req api.GetTelemetryDataRequest) (*api.TelemetryData, error) {
-
-	data := api.TelemetryData{}
-	datumMap := make(map[string]*api.TelemetryDatum)
-
-	var txSeqNum, carNumber int32
-	var granPrix, track, constructor, datumDescription, datumUnit string
-	var ts time.Time
+// NewFromTelemetryDatum converts a protobuf telemetry datum into a FOTAAS domain model object.
+func NewFromTelemetryDatum(v *api.TelemetryDatum) TelemetryDatum {
 
-	// Build the select query based on the search by flags in the request. If none of the search flags
-	// are set, select by simulation uuid only.
-	var sb strings.Builder
-
-	if req.Simulated || req.SimulationUuid != "" {
-		if req.SimulationUuid != "" {
-			sb.WriteString("select * from telemetry_datum where simulation_id = '")
-			sb.WriteString(req.SimulationUuid)
-			sb.WriteString("'")
-		} else {
-			sb.WriteString("select * from telemetry_datum where simulated = true")
-		}
-	} else {
-		sb.WriteString("select * from telemetry_datum where simulated = false")
-	}
+	var datum TelemetryDatum
 
-	if req.SearchBy.Constructor {
-		sb.WriteString(" and constructor = '")
-		sb.WriteString(req.Constructor.String())
-		sb.WriteString("'")
+	datum.ID = v.Uuid
+	if v.Simulated {
+		datum.Simulated = v.Simulated
+		datum.SimulationID = v.SimulationUuid
+		datum.SimulationTransmitSequenceNumber = v.SimulationTransmitSequenceNumber
 	}
-	if req.SearchBy.CarNumber {
-		sb.WriteString(" and car_number = ")
-		sb.WriteString(strconv.Itoa(int(req.CarNumber)))
+	datum.Description = v.Description.String()
+	datum.Unit = v.Unit.String()
+	if v.Channel != "" {
+		datum.Description = v.Channel
+		datum.Unit = v.ChannelUnit
 	}
-	if req.SearchBy.DatumDescription {
-		sb.WriteString(" and description = '")
-		sb.WriteString(req.DatumDescription.String())
-		sb.WriteString("'")
-	}
-	if req.SearchBy.GranPrix {
-		sb.WriteString(" and gran_prix = '")
-		sb.WriteString(req.GranPrix.String())
-		sb.WriteString("'")
-	}
-	if req.SearchBy.Track {
-		sb.WriteString(" and track = '")
-		sb.WriteString(req.Track.String())
-		sb.WriteString("'")
-	}
-	if req.SearchBy.HighAlarm && !req.SearchBy.LowAlarm {
-		sb.WriteString(" and hi_alarm = true")
-	}
-	if req.SearchBy.LowAlarm && !req.SearchBy.HighAlarm {
-		sb.WriteString(" and lo_alarm = true")
-	}
-	if req.SearchBy.LowAlarm && req.SearchBy.HighAlarm {
-		sb.WriteString(" and (lo_alarm = true or hi_alarm = true)")
-	}
-	if req.SearchBy.DateRange {
 
-		var startTs, endTs time.Time
-		var err error
+	datum.GranPrix = v.GranPrix.String()
+	datum.Track = v.Track.String()
+	datum.Constructor = v.Constructor.String()
+	datum.CarNumber = v.CarNumber
+	datum.Lap = v.Lap
+	datum.Sector = v.Sector
+	datum.LapDistance = v.LapDistance
+
+	datum.Timestamp = v.Timestamp
+	datum.Latitude = v.Latitude
+	datum.Longitude = v.Longitude
+	datum.Elevation = v.Elevation
+	datum.Value = v.Value
+	datum.HiAlarm = v.HighAlarm
+	datum.LoAlarm = v.LowAlarm
+
+	return datum
+}
 
-		if startTs, err = ipbts.Timestamp(req.DateRangeBegin); err != nil {
-			return nil, err
-		}
+// CreateTelemetryData persists data in the configured TelemetryStore, see TelemetryStore.
+func CreateTelemetryData(data []TelemetryDatum) (map[string]IngestStatus, error) {
+	return store.CreateTelemetryData(data)
+}
 
-		if endTs, err = ipbts.Timestamp(req.DateRangeEnd); err != nil {
-			return nil, err
-		}
+// RetrieveTelemetryData retrieves all of the telemetry data matching req from the configured TelemetryStore.
+func RetrieveTelemetryData(req api.GetTelemetryDataRequest) (*api.TelemetryData, error) {
+	return store.RetrieveTelemetryData(req)
+}
 
-		sb.WriteString(" and timestamp between '")
-
-		sb.WriteString(strconv.Itoa(startTs.Year()))
-		sb.WriteString("-")
-		sb.WriteString(strconv.Itoa(int(startTs.Month())))
-		sb.WriteString("-")
-		sb.WriteString(strconv.Itoa(startTs.Day()))
-		sb.WriteString(" 00:00:00")
-		sb.WriteString("' and '")
-		sb.WriteString(strconv.Itoa(endTs.Year()))
-		sb.WriteString("-")
-		sb.WriteString(strconv.Itoa(int(endTs.Month())))
-		sb.WriteString("-")
-		sb.WriteString(strconv.Itoa(endTs.Day()))
-		sb.WriteString(" 23:59:59")
-		sb.WriteString("'")
-	}
+// RetrieveTelemetryDataPage retrieves a single page of the telemetry data matching req, ordered by
+// timestamp and then uuid, from the configured TelemetryStore. The returned page token is passed
+// back in req.PageToken to retrieve the next page and is empty when there are no more pages.
+func RetrieveTelemetryDataPage(req api.GetTelemetryDataRequest) (*api.TelemetryData, string, error) {
+	return store.RetrieveTelemetryDataPage(req)
+}
 
-	logger.Debug(fmt.Sprintf("select sql: %v", sb.String()))
-	rows, err := db.Query(sb.String())
+// ValidateTelemetryDataRequest rejects a req that cannot be retrieved, i.e. one with a malformed
+// page token or a date range that begins after it ends, so that it can be reported before any
+// telemetry data is sent.
+func ValidateTelemetryDataRequest(req api.GetTelemetryDataRequest) error {
 
-	switch {
-	case err == sql.ErrNoRows:
-		// no rows & no errors, caller needs to check for nil TelemetryData
-		return nil, nil
-	case err != nil:
-		logger.Error(fmt.Sprintf("failed to retrieve telemetry data with error: %v", err))
-		return nil, err
-	default:
+	if req.PageToken != "" {
+		if _, err := decodePageToken(req.PageToken); err != nil {
+			return err
+		}
+	}
 
-		defer rows.Close()
+	if req.SearchBy != nil && req.SearchBy.DateRange {
 
-		for rows.Next() {
+		begin, err := ipbts.Timestamp(req.DateRangeBegin)
+		if err != nil {
+			return fmt.Errorf("invalid date range begin: %v", err)
+		}
 
-			datum := api.TelemetryDatum{}
+		end, err := ipbts.Timestamp(req.DateRangeEnd)
+		if err != nil {
+			return fmt.Errorf("invalid date range end: %v", err)
+		}
 
-			err := rows.Scan(&datum.Uuid, &datum.Simulated, &datum.SimulationUuid, &txSeqNum, &granPrix,
-				&track, &constructor, &carNumber, &ts, &datum.Latitude, &datum.Longitude, &datum.Elevation, &datumDescription,
-				&datumUnit, &datum.Value, &datum.HighAlarm, &datum.LowAlarm)
+		if begin.After(end) {
+			return fmt.Errorf("invalid date range, begin %v is after end %v", begin.Format(time.RFC3339),
+				end.Format(time.RFC3339))
+		}
+	}
 
-			if err != nil {
-				return nil, err
-			}
+	return nil
+}
 
-			ordinal, ok := api.TelemetryDatumDescription_value[datumDescription]
-			if !ok {
-				return nil, fmt.Errorf("invalid telemetry datum description enum: %v", datumDescription)
-			}
-			datum.Description = api.TelemetryDatumDescription(ordinal)
+// classifyTelemetryData determines the ingest status of every datum in data given the content
+// hashes of the datum already persisted (keyed by uuid, a null hash is a datum persisted before
+// content hashing was introduced). It returns the statuses, the datum that need to be inserted and
+// whether any datum is in conflict.
+func classifyTelemetryData(data []TelemetryDatum, persisted map[string]sql.NullString) (map[string]IngestStatus, []TelemetryDatum, bool) {
 
-			ordinal, ok = api.TelemetryDatumUnit_value[datumUnit]
-			if !ok {
-				return nil, fmt.Errorf("invalid telemetry datum unit enum: %v", datumUnit)
-			}
-			datum.Unit = api.TelemetryDatumUnit(ordinal)
+	statusMap := make(map[string]IngestStatus)
 
-			tsProto, err := ipbts.TimestampProto(ts)
-			if err != nil {
-				return nil, errors.New("failed to convert timestamp to protobuf format")
-			}
-			datum.Timestamp = tsProto
+	hashes := make(map[string]string)
+	for i := range data {
+		hashes[data[i].ID] = data[i].ContentHash()
+	}
 
-			ordinal, ok = api.GranPrix_value[granPrix]
-			if !ok {
-				return nil, fmt.Errorf("invalid gran prix enum: %v", granPrix)
+	var conflict bool
+	inserts := make([]TelemetryDatum, 0, len(data))
+
+	for i := range data {
+		id := data[i].ID
+		hash := data[i].ContentHash()
+		if persistedHash, ok := persisted[id]; ok {
+			// Rows persisted before content hashing was introduced have no hash and cannot be
+			// verified as identical, treat them as a conflict rather than silently accept the datum.
+			if persistedHash.Valid && persistedHash.String == hash {
+				statusMap[id] = Duplicate
+			} else {
+				statusMap[id] = Conflict
+				conflict = true
 			}
-			datum.GranPrix = api.GranPrix(ordinal)
+			continue
+		}
+		if hashes[id] != hash {
+			statusMap[id] = Conflict
+			conflict = true
+			continue
+		}
+		if _, ok := statusMap[id]; ok {
+			// Same uuid and content repeated within the request, insert it once.
+			continue
+		}
+		statusMap[id] = Inserted
+		inserts = append(inserts, data[i])
+	}
 
-			ordinal, ok = api.Track_value[track]
-			if !ok {
-				return nil, fmt.Errorf("invalid track enum: %v", track)
-			}
-			datum.Track = api.Track(ordinal)
+	return statusMap, inserts, conflict
+}
 
-			ordinal, ok = api.Constructor_value[constructor]
-			if !ok {
-				return nil, fmt.Errorf("invalid constructor enum: %v", constructor)
-			}
-			datum.Constructor = api.Constructor(ordinal)
+// pageSize returns the page size to use for req.
+func pageSize(req api.GetTelemetryDataRequest) int {
+	size := int(req.PageSize)
+	switch {
+	case size <= 0:
+		size = DefaultPageSize
+	case size > MaxPageSize:
+		size = MaxPageSize
+	}
+	return size
+}
 
-			datum.CarNumber = carNumber
+// toProto converts td back into its protobuf representation.
+func (td *TelemetryDatum) toProto() (*api.TelemetryDatum, error) {
 
-			datumMap[datum.Uuid] = &datum
+	datum := api.TelemetryDatum{Uuid: td.ID, Simulated: td.Simulated, SimulationUuid: td.SimulationID,
+		SimulationTransmitSequenceNumber: td.SimulationTransmitSequenceNumber, CarNumber: td.CarNumber,
+		Lap: td.Lap, Sector: td.Sector, LapDistance: td.LapDistance, Timestamp: td.Timestamp, Latitude: td.Latitude, Longitude: td.Longitude, Elevation: td.Elevation,
+		Value: td.Value, HighAlarm: td.HiAlarm, LowAlarm: td.LoAlarm}
 
-		}
-		err = rows.Err()
-		if err != nil {
-			return nil, err
-		}
+	if err := setDatumChannel(&datum, td.Description, td.Unit); err != nil {
+		return nil, err
+	}
 
-		data.TelemetryDatumMap = datumMap
+	ordinal, ok := api.GranPrix_value[td.GranPrix]
+	if !ok {
+		return nil, fmt.Errorf("invalid gran prix enum: %v", td.GranPrix)
+	}
+	datum.GranPrix = api.GranPrix(ordinal)
 
+	ordinal, ok = api.Track_value[td.Track]
+	if !ok {
+		return nil, fmt.Errorf("invalid track enum: %v", td.Track)
 	}
+	datum.Track = api.Track(ordinal)
 
-	return &data, nil
+	ordinal, ok = api.Constructor_value[td.Constructor]
+	if !ok {
+		return nil, fmt.Errorf("invalid constructor enum: %v", td.Constructor)
+	}
+	datum.Constructor = api.Constructor(ordinal)
 
+	return &datum, nil
 }
@@ -0,0 +1,478 @@
+package models
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/uuid"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/bburch01/FOTAAS/internal/app/telemetry/alarm"
+)
+
+// ErrAlarmThresholdNotFound is returned when there is no alarm threshold with the requested uuid.
+var ErrAlarmThresholdNotFound = errors.New("alarm threshold not found")
+
+// ErrAlarmThresholdConflict is returned when an alarm threshold with the same datum description
+// and overrides already exists.
+var ErrAlarmThresholdConflict = errors.New("an alarm threshold with the same datum description and overrides already exists")
+
+// alarmThresholdRow is an alarm threshold as persisted. An empty constructor or track, or a car
+// number of 0, means that the threshold is not overridden by it.
+type alarmThresholdRow struct {
+	ID               string
+	Description      string
+	Constructor      string
+	CarNumber        int32
+	Track            string
+	HighAlarmEnabled bool
+	HighAlarmValue   float64
+	LowAlarmEnabled  bool
+	LowAlarmValue    float64
+}
+
+func newAlarmThresholdRow(t *api.AlarmThreshold) alarmThresholdRow {
+	row := alarmThresholdRow{ID: t.Uuid, Description: t.DatumDescription.String(), HighAlarmEnabled: t.HighAlarmEnabled,
+		HighAlarmValue: t.HighAlarmValue, LowAlarmEnabled: t.LowAlarmEnabled, LowAlarmValue: t.LowAlarmValue}
+	if t.OverrideBy.Constructor {
+		row.Constructor = t.Constructor.String()
+	}
+	if t.OverrideBy.CarNumber {
+		row.CarNumber = t.CarNumber
+	}
+	if t.OverrideBy.Track {
+		row.Track = t.Track.String()
+	}
+	return row
+}
+
+// sameScope reports whether row and other are for the same datum description and overrides.
+func (row *alarmThresholdRow) sameScope(other alarmThresholdRow) bool {
+	return row.Description == other.Description && row.Constructor == other.Constructor &&
+		row.CarNumber == other.CarNumber && row.Track == other.Track
+}
+
+func (row *alarmThresholdRow) toProto() (*api.AlarmThreshold, error) {
+
+	t := api.AlarmThreshold{Uuid: row.ID, OverrideBy: &api.AlarmThreshold_OverrideBy{},
+		HighAlarmEnabled: row.HighAlarmEnabled, HighAlarmValue: row.HighAlarmValue,
+		LowAlarmEnabled: row.LowAlarmEnabled, LowAlarmValue: row.LowAlarmValue}
+
+	ordinal, ok := api.TelemetryDatumDescription_value[row.Description]
+	if !ok {
+		return nil, fmt.Errorf("invalid telemetry datum description enum: %v", row.Description)
+	}
+	t.DatumDescription = api.TelemetryDatumDescription(ordinal)
+
+	if row.Constructor != "" {
+		if ordinal, ok = api.Constructor_value[row.Constructor]; !ok {
+			return nil, fmt.Errorf("invalid constructor enum: %v", row.Constructor)
+		}
+		t.OverrideBy.Constructor = true
+		t.Constructor = api.Constructor(ordinal)
+	}
+
+	if row.CarNumber != 0 {
+		t.OverrideBy.CarNumber = true
+		t.CarNumber = row.CarNumber
+	}
+
+	if row.Track != "" {
+		if ordinal, ok = api.Track_value[row.Track]; !ok {
+			return nil, fmt.Errorf("invalid track enum: %v", row.Track)
+		}
+		t.OverrideBy.Track = true
+		t.Track = api.Track(ordinal)
+	}
+
+	return &t, nil
+}
+
+// normalizeAlarmThreshold validates t and returns a copy of it with the fields that are not
+// overridden zeroed.
+func normalizeAlarmThreshold(t *api.AlarmThreshold) (*api.AlarmThreshold, error) {
+
+	if t == nil {
+		return nil, errors.New("alarm threshold is required")
+	}
+
+	n := proto.Clone(t).(*api.AlarmThreshold)
+	if n.OverrideBy == nil {
+		n.OverrideBy = &api.AlarmThreshold_OverrideBy{}
+	}
+
+	if _, ok := api.TelemetryDatumDescription_name[int32(n.DatumDescription)]; !ok {
+		return nil, fmt.Errorf("invalid telemetry datum description: %v", n.DatumDescription)
+	}
+
+	if n.OverrideBy.Constructor {
+		if _, ok := api.Constructor_name[int32(n.Constructor)]; !ok {
+			return nil, fmt.Errorf("invalid constructor: %v", n.Constructor)
+		}
+	} else {
+		n.Constructor = 0
+	}
+
+	if n.OverrideBy.CarNumber {
+		if n.CarNumber <= 0 {
+			return nil, fmt.Errorf("invalid car number %v, must be greater than 0", n.CarNumber)
+		}
+	} else {
+		n.CarNumber = 0
+	}
+
+	if n.OverrideBy.Track {
+		if _, ok := api.Track_name[int32(n.Track)]; !ok {
+			return nil, fmt.Errorf("invalid track: %v", n.Track)
+		}
+	} else {
+		n.Track = 0
+	}
+
+	if !n.HighAlarmEnabled {
+		n.HighAlarmValue = 0
+	}
+	if !n.LowAlarmEnabled {
+		n.LowAlarmValue = 0
+	}
+	if n.HighAlarmEnabled && n.LowAlarmEnabled && n.LowAlarmValue >= n.HighAlarmValue {
+		return nil, fmt.Errorf("invalid alarm threshold, low alarm value %v must be less than high alarm value %v",
+			n.LowAlarmValue, n.HighAlarmValue)
+	}
+
+	return n, nil
+}
+
+// CreateAlarmThreshold validates t and adds it to the alarm threshold registry with a new uuid.
+func CreateAlarmThreshold(t *api.AlarmThreshold) (*api.AlarmThreshold, error) {
+
+	n, err := normalizeAlarmThreshold(t)
+	if err != nil {
+		return nil, err
+	}
+	n.Uuid = uuid.New().String()
+
+	if err = store.CreateAlarmThreshold(n); err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+// RetrieveAlarmThreshold retrieves the alarm threshold with uuid id.
+func RetrieveAlarmThreshold(id string) (*api.AlarmThreshold, error) {
+	return store.RetrieveAlarmThreshold(id)
+}
+
+// RetrieveAlarmThresholds retrieves the alarm thresholds for descriptions (all of them if
+// descriptions is empty) ordered by datum description and then from least to most specific.
+func RetrieveAlarmThresholds(descriptions []api.TelemetryDatumDescription) ([]*api.AlarmThreshold, error) {
+
+	all, err := store.RetrieveAlarmThresholds()
+	if err != nil {
+		return nil, err
+	}
+
+	var thresholds []*api.AlarmThreshold
+	for _, v := range all {
+		if len(descriptions) > 0 && !containsDescription(descriptions, v.DatumDescription) {
+			continue
+		}
+		thresholds = append(thresholds, v)
+	}
+
+	sort.Slice(thresholds, func(i, j int) bool {
+		a, b := thresholds[i], thresholds[j]
+		if a.DatumDescription != b.DatumDescription {
+			return a.DatumDescription < b.DatumDescription
+		}
+		if alarm.Specificity(a) != alarm.Specificity(b) {
+			return alarm.Specificity(a) < alarm.Specificity(b)
+		}
+		if a.Constructor != b.Constructor {
+			return a.Constructor < b.Constructor
+		}
+		if a.CarNumber != b.CarNumber {
+			return a.CarNumber < b.CarNumber
+		}
+		return a.Track < b.Track
+	})
+
+	return thresholds, nil
+}
+
+func containsDescription(descriptions []api.TelemetryDatumDescription, description api.TelemetryDatumDescription) bool {
+	for _, v := range descriptions {
+		if v == description {
+			return true
+		}
+	}
+	return false
+}
+
+// UpdateAlarmThreshold validates t and replaces the alarm threshold with uuid t.Uuid with it.
+func UpdateAlarmThreshold(t *api.AlarmThreshold) (*api.AlarmThreshold, error) {
+
+	n, err := normalizeAlarmThreshold(t)
+	if err != nil {
+		return nil, err
+	}
+	if n.Uuid == "" {
+		return nil, errors.New("alarm threshold uuid is required")
+	}
+
+	if err = store.UpdateAlarmThreshold(n); err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+// DeleteAlarmThreshold deletes the alarm threshold with uuid id.
+func DeleteAlarmThreshold(id string) error {
+	return store.DeleteAlarmThreshold(id)
+}
+
+// SeedAlarmThresholds adds thresholds to the alarm threshold registry if the registry is empty and
+// returns the number of thresholds added.
+func SeedAlarmThresholds(thresholds []*api.AlarmThreshold) (int, error) {
+
+	existing, err := store.RetrieveAlarmThresholds()
+	if err != nil {
+		return 0, err
+	}
+	if len(existing) > 0 {
+		return 0, nil
+	}
+
+	for i, v := range thresholds {
+		if _, err = CreateAlarmThreshold(v); err != nil {
+			return i, err
+		}
+	}
+
+	return len(thresholds), nil
+}
+
+const alarmThresholdSelectColumns = `id, description, constructor, car_number, track, high_alarm_enabled, high_alarm_value,
+	low_alarm_enabled, low_alarm_value`
+
+func scanAlarmThreshold(scanner interface{ Scan(...interface{}) error }) (*api.AlarmThreshold, error) {
+	var row alarmThresholdRow
+	if err := scanner.Scan(&row.ID, &row.Description, &row.Constructor, &row.CarNumber, &row.Track,
+		&row.HighAlarmEnabled, &row.HighAlarmValue, &row.LowAlarmEnabled, &row.LowAlarmValue); err != nil {
+		return nil, err
+	}
+	return row.toProto()
+}
+
+// alarmThresholdConflicts reports whether an alarm threshold other than row (by id) has the same
+// description and overrides as row.
+func alarmThresholdConflicts(tx *sql.Tx, row alarmThresholdRow) (bool, error) {
+	var id string
+	err := tx.QueryRow(`select id from alarm_threshold where description = ? and constructor = ? and car_number = ?
+		and track = ? and id <> ?`, row.Description, row.Constructor, row.CarNumber, row.Track, row.ID).Scan(&id)
+	switch {
+	case err == sql.ErrNoRows:
+		return false, nil
+	case err != nil:
+		return false, err
+	default:
+		return true, nil
+	}
+}
+
+func (s *sqlStore) CreateAlarmThreshold(t *api.AlarmThreshold) error {
+
+	row := newAlarmThresholdRow(t)
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	conflict, err := alarmThresholdConflicts(tx, row)
+	if err != nil {
+		return err
+	}
+	if conflict {
+		return ErrAlarmThresholdConflict
+	}
+
+	if _, err = tx.Exec(`INSERT INTO alarm_threshold (id, description, constructor, car_number, track, high_alarm_enabled,
+		high_alarm_value, low_alarm_enabled, low_alarm_value) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`, row.ID, row.Description,
+		row.Constructor, row.CarNumber, row.Track, row.HighAlarmEnabled, row.HighAlarmValue, row.LowAlarmEnabled,
+		row.LowAlarmValue); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *sqlStore) RetrieveAlarmThreshold(id string) (*api.AlarmThreshold, error) {
+
+	t, err := scanAlarmThreshold(s.db.QueryRow("select "+alarmThresholdSelectColumns+" from alarm_threshold where id = ?", id))
+	if err == sql.ErrNoRows {
+		return nil, ErrAlarmThresholdNotFound
+	}
+
+	return t, err
+}
+
+func (s *sqlStore) RetrieveAlarmThresholds() ([]*api.AlarmThreshold, error) {
+
+	rows, err := s.db.Query("select " + alarmThresholdSelectColumns + " from alarm_threshold")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var thresholds []*api.AlarmThreshold
+	for rows.Next() {
+		t, err := scanAlarmThreshold(rows)
+		if err != nil {
+			return nil, err
+		}
+		thresholds = append(thresholds, t)
+	}
+
+	return thresholds, rows.Err()
+}
+
+func (s *sqlStore) UpdateAlarmThreshold(t *api.AlarmThreshold) error {
+
+	row := newAlarmThresholdRow(t)
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Check existence with a select, mysql reports 0 affected rows for an update that changes nothing.
+	var id string
+	err = tx.QueryRow("select id from alarm_threshold where id = ?", row.ID).Scan(&id)
+	switch {
+	case err == sql.ErrNoRows:
+		return ErrAlarmThresholdNotFound
+	case err != nil:
+		return err
+	}
+
+	conflict, err := alarmThresholdConflicts(tx, row)
+	if err != nil {
+		return err
+	}
+	if conflict {
+		return ErrAlarmThresholdConflict
+	}
+
+	if _, err = tx.Exec(`UPDATE alarm_threshold SET description = ?, constructor = ?, car_number = ?, track = ?,
+		high_alarm_enabled = ?, high_alarm_value = ?, low_alarm_enabled = ?, low_alarm_value = ? WHERE id = ?`,
+		row.Description, row.Constructor, row.CarNumber, row.Track, row.HighAlarmEnabled, row.HighAlarmValue,
+		row.LowAlarmEnabled, row.LowAlarmValue, row.ID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *sqlStore) DeleteAlarmThreshold(id string) error {
+
+	result, err := s.db.Exec("delete from alarm_threshold where id = ?", id)
+	if err != nil {
+		return err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return ErrAlarmThresholdNotFound
+	}
+
+	return nil
+}
+
+func (s *memoryStore) CreateAlarmThreshold(t *api.AlarmThreshold) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.alarmThresholdConflicts(t) {
+		return ErrAlarmThresholdConflict
+	}
+	s.thresholds[t.Uuid] = proto.Clone(t).(*api.AlarmThreshold)
+
+	return nil
+}
+
+func (s *memoryStore) RetrieveAlarmThreshold(id string) (*api.AlarmThreshold, error) {
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	t, ok := s.thresholds[id]
+	if !ok {
+		return nil, ErrAlarmThresholdNotFound
+	}
+
+	return proto.Clone(t).(*api.AlarmThreshold), nil
+}
+
+func (s *memoryStore) RetrieveAlarmThresholds() ([]*api.AlarmThreshold, error) {
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	thresholds := make([]*api.AlarmThreshold, 0, len(s.thresholds))
+	for _, v := range s.thresholds {
+		thresholds = append(thresholds, proto.Clone(v).(*api.AlarmThreshold))
+	}
+
+	return thresholds, nil
+}
+
+func (s *memoryStore) UpdateAlarmThreshold(t *api.AlarmThreshold) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.thresholds[t.Uuid]; !ok {
+		return ErrAlarmThresholdNotFound
+	}
+	if s.alarmThresholdConflicts(t) {
+		return ErrAlarmThresholdConflict
+	}
+	s.thresholds[t.Uuid] = proto.Clone(t).(*api.AlarmThreshold)
+
+	return nil
+}
+
+func (s *memoryStore) DeleteAlarmThreshold(id string) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.thresholds[id]; !ok {
+		return ErrAlarmThresholdNotFound
+	}
+	delete(s.thresholds, id)
+
+	return nil
+}
+
+func (s *memoryStore) alarmThresholdConflicts(t *api.AlarmThreshold) bool {
+	row := newAlarmThresholdRow(t)
+	for _, v := range s.thresholds {
+		if v.Uuid != t.Uuid && row.sameScope(newAlarmThresholdRow(v)) {
+			return true
+		}
+	}
+	return false
+}
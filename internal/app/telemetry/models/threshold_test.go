@@ -0,0 +1,101 @@
+package models
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/bburch01/FOTAAS/api"
+	"github.com/bburch01/FOTAAS/internal/app/telemetry/alarm"
+)
+
+func TestAlarmThresholdRegistry(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "fotaas-columnar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, s := range []TelemetryStore{newMemoryStore(), newTestSQLiteStore(t), newTestColumnarStore(t, dir, 0)} {
+		testAlarmThresholdRegistry(t, s)
+	}
+}
+
+func testAlarmThresholdRegistry(t *testing.T, s TelemetryStore) {
+
+	saved := store
+	store = s
+	defer func() { store = saved }()
+
+	count, err := SeedAlarmThresholds(alarm.DefaultAlarmThresholds())
+	if err != nil || count != len(alarm.DefaultThresholds) {
+		t.Errorf("expected %v seeded alarm thresholds, got %v with error: %v", len(alarm.DefaultThresholds), count, err)
+	}
+	if count, err = SeedAlarmThresholds(alarm.DefaultAlarmThresholds()); err != nil || count != 0 {
+		t.Errorf("expected a populated registry not to be seeded again, got %v with error: %v", count, err)
+	}
+
+	override := &api.AlarmThreshold{DatumDescription: api.TelemetryDatumDescription_BRAKE_TEMP_FL,
+		OverrideBy: &api.AlarmThreshold_OverrideBy{Constructor: true, Track: true}, Constructor: api.Constructor_FERRARI,
+		CarNumber: 16, Track: api.Track_MONTE_CARLO, HighAlarmEnabled: true, HighAlarmValue: 1200.0}
+
+	created, err := CreateAlarmThreshold(override)
+	if err != nil {
+		t.Error("failed to create alarm threshold with error: ", err)
+		t.FailNow()
+	}
+	if created.Uuid == "" || created.CarNumber != 0 {
+		t.Errorf("expected a uuid and the car number (not an override) to be cleared, got %v", created)
+	}
+
+	if _, err = CreateAlarmThreshold(override); err != ErrAlarmThresholdConflict {
+		t.Errorf("expected ErrAlarmThresholdConflict, got %v", err)
+	}
+
+	retrieved, err := RetrieveAlarmThreshold(created.Uuid)
+	if err != nil {
+		t.Error("failed to retrieve alarm threshold with error: ", err)
+		t.FailNow()
+	}
+	if !retrieved.OverrideBy.Constructor || !retrieved.OverrideBy.Track || retrieved.OverrideBy.CarNumber ||
+		retrieved.Constructor != api.Constructor_FERRARI || retrieved.Track != api.Track_MONTE_CARLO ||
+		retrieved.HighAlarmValue != 1200.0 {
+		t.Errorf("retrieved alarm threshold does not match the created one: %v", retrieved)
+	}
+
+	thresholds, err := RetrieveAlarmThresholds([]api.TelemetryDatumDescription{api.TelemetryDatumDescription_BRAKE_TEMP_FL})
+	if err != nil || len(thresholds) != 2 || thresholds[1].Uuid != created.Uuid {
+		t.Errorf("expected the default and the override BRAKE_TEMP_FL thresholds (least specific first), got %v with error: %v",
+			thresholds, err)
+	}
+
+	retrieved.HighAlarmValue = 1250.0
+	if _, err = UpdateAlarmThreshold(retrieved); err != nil {
+		t.Error("failed to update alarm threshold with error: ", err)
+	}
+	if retrieved, err = RetrieveAlarmThreshold(created.Uuid); err != nil || retrieved.HighAlarmValue != 1250.0 {
+		t.Errorf("expected the updated high alarm value, got %v with error: %v", retrieved, err)
+	}
+
+	// Updating the override to the scope of the default threshold is a conflict.
+	retrieved.OverrideBy = &api.AlarmThreshold_OverrideBy{}
+	if _, err = UpdateAlarmThreshold(retrieved); err != ErrAlarmThresholdConflict {
+		t.Errorf("expected ErrAlarmThresholdConflict, got %v", err)
+	}
+
+	if _, err = CreateAlarmThreshold(&api.AlarmThreshold{DatumDescription: api.TelemetryDatumDescription_SPEED,
+		HighAlarmEnabled: true, HighAlarmValue: 100.0, LowAlarmEnabled: true, LowAlarmValue: 200.0}); err == nil {
+		t.Error("expected a low alarm value above the high alarm value to fail validation")
+	}
+
+	if err = DeleteAlarmThreshold(created.Uuid); err != nil {
+		t.Error("failed to delete alarm threshold with error: ", err)
+	}
+	if err = DeleteAlarmThreshold(created.Uuid); err != ErrAlarmThresholdNotFound {
+		t.Errorf("expected ErrAlarmThresholdNotFound, got %v", err)
+	}
+	if _, err = RetrieveAlarmThreshold(created.Uuid); err != ErrAlarmThresholdNotFound {
+		t.Errorf("expected ErrAlarmThresholdNotFound, got %v", err)
+	}
+}
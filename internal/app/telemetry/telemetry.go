@@ -1,7 +1,11 @@
 package telemetry
 
 import (
+	"fmt"
+
 	"github.com/bburch01/FOTAAS/api"
+	"github.com/bburch01/FOTAAS/internal/app/telemetry/channel"
+	"github.com/google/uuid"
 )
 
 type AlarmMode int
@@ -19,8 +23,6 @@ type TelemetryDatumParameters struct {
 	Unit           api.TelemetryDatumUnit
 	RangeLowValue  float64
 	RangeHighValue float64
-	HighAlarmValue float64
-	LowAlarmValue  float64
 }
 
 type SimulatedTelemetryData struct {
@@ -32,6 +34,33 @@ type SimulatedTelemetryData struct {
 }
 
 type AlarmParams struct {
-	Desc api.TelemetryDatumDescription
-	Mode AlarmMode
+	Desc  api.TelemetryDatumDescription
+	Mode  AlarmMode
+	Level float64
+}
+
+// Validate checks that datum has a valid uuid in canonical form, a valid lap position when it has lap information, and
+// is of a channel of channels in the unit of that channel, see channel.Registry.Resolve. It is
+// applied by the telemetry service at ingest and by the clients that want to reject bad datum
+// before transmitting them.
+func Validate(datum *api.TelemetryDatum, channels *channel.Registry) error {
+
+	// Check the uuid for valid format. Only the canonical lower case form is accepted, so that every
+	// telemetry store keys a datum by the same string (the columnar store stores the uuid as 16 bytes
+	// and can only return its canonical form).
+	id, err := uuid.Parse(datum.Uuid)
+	if err != nil {
+		return err
+	}
+	if id.String() != datum.Uuid {
+		return fmt.Errorf("invalid telemetry datum uuid %v, must be in the canonical form %v", datum.Uuid, id.String())
+	}
+
+	// Lap, sector and lap distance are 0 when not known.
+	if datum.Lap < 0 || datum.Sector < 0 || datum.Sector > 3 || datum.LapDistance < 0 {
+		return fmt.Errorf("invalid telemetry datum lap position, lap %v sector %v lap distance %v",
+			datum.Lap, datum.Sector, datum.LapDistance)
+	}
+
+	return channels.Resolve(datum)
 }
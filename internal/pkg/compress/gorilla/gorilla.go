@@ -0,0 +1,465 @@
+// Package gorilla implements the time series compression of Facebook's Gorilla paper
+// (http://www.vldb.org/pvldb/vol8/p1816-teller.pdf): timestamps are stored as delta-of-deltas and
+// float values as the XOR of consecutive values.
+//
+// Timestamps are nanoseconds, so the delta-of-delta buckets are wider than the paper's (which are
+// sized for second precision). A series sampled at a fixed rate still stores each timestamp in a
+// single bit.
+package gorilla
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/bits"
+)
+
+// ErrCorrupt is returned by a decoder when its input ends before the encoded samples.
+var ErrCorrupt = errors.New("gorilla: corrupt encoded series")
+
+// The delta-of-delta buckets, each is a control bit prefix followed by a signed value of the
+// bucket's width. A delta-of-delta of 0 is a single 0 bit and anything wider than the last bucket
+// is stored in full after a 1111 prefix.
+var dodBuckets = []struct {
+	prefix     uint64
+	prefixBits int
+	valueBits  int
+}{
+	{0x2, 2, 14},
+	{0x6, 3, 24},
+	{0xe, 4, 36},
+}
+
+// Encoder compresses a series of (timestamp, value) samples. The samples are expected in timestamp
+// order but any order can be encoded, it just compresses less well.
+type Encoder struct {
+	w     bitWriter
+	count int
+	times timeEncoder
+	vals  floatEncoder
+}
+
+// NewEncoder creates an empty encoder.
+func NewEncoder() *Encoder {
+	return &Encoder{}
+}
+
+// Append adds a sample with timestamp t (e.g. unix nanoseconds) and value v.
+func (e *Encoder) Append(t int64, v float64) {
+	e.times.encode(&e.w, t)
+	e.vals.encode(&e.w, v)
+	e.count++
+}
+
+// Len returns the number of samples appended.
+func (e *Encoder) Len() int {
+	return e.count
+}
+
+// Bytes returns the encoded series, prefixed by its sample count.
+func (e *Encoder) Bytes() []byte {
+	return withCount(e.count, e.w.buf)
+}
+
+// Decoder iterates over the samples of a series encoded by an Encoder.
+type Decoder struct {
+	r     bitReader
+	count int
+	times timeDecoder
+	vals  floatDecoder
+	t     int64
+	v     float64
+	err   error
+}
+
+// NewDecoder creates a decoder for the encoded series b.
+func NewDecoder(b []byte) *Decoder {
+	count, body, err := splitCount(b)
+	return &Decoder{r: bitReader{buf: body}, count: count, err: err}
+}
+
+// Next advances to the next sample and reports whether there is one. At the end of the series, or
+// on error, it returns false.
+func (d *Decoder) Next() bool {
+	if d.err != nil || d.count == 0 {
+		return false
+	}
+	if d.t, d.err = d.times.decode(&d.r); d.err != nil {
+		return false
+	}
+	if d.v, d.err = d.vals.decode(&d.r); d.err != nil {
+		return false
+	}
+	d.count--
+	return true
+}
+
+// At returns the current sample.
+func (d *Decoder) At() (int64, float64) {
+	return d.t, d.v
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (d *Decoder) Err() error {
+	return d.err
+}
+
+// FloatEncoder compresses a series of float values without timestamps, e.g. a column of values
+// that share the timestamps of another series.
+type FloatEncoder struct {
+	w     bitWriter
+	count int
+	vals  floatEncoder
+}
+
+// NewFloatEncoder creates an empty float encoder.
+func NewFloatEncoder() *FloatEncoder {
+	return &FloatEncoder{}
+}
+
+// Append adds v to the series.
+func (e *FloatEncoder) Append(v float64) {
+	e.vals.encode(&e.w, v)
+	e.count++
+}
+
+// Len returns the number of values appended.
+func (e *FloatEncoder) Len() int {
+	return e.count
+}
+
+// Bytes returns the encoded series, prefixed by its value count.
+func (e *FloatEncoder) Bytes() []byte {
+	return withCount(e.count, e.w.buf)
+}
+
+// FloatDecoder iterates over the values of a series encoded by a FloatEncoder.
+type FloatDecoder struct {
+	r     bitReader
+	count int
+	vals  floatDecoder
+	v     float64
+	err   error
+}
+
+// NewFloatDecoder creates a decoder for the encoded series b.
+func NewFloatDecoder(b []byte) *FloatDecoder {
+	count, body, err := splitCount(b)
+	return &FloatDecoder{r: bitReader{buf: body}, count: count, err: err}
+}
+
+// Next advances to the next value and reports whether there is one.
+func (d *FloatDecoder) Next() bool {
+	if d.err != nil || d.count == 0 {
+		return false
+	}
+	if d.v, d.err = d.vals.decode(&d.r); d.err != nil {
+		return false
+	}
+	d.count--
+	return true
+}
+
+// At returns the current value.
+func (d *FloatDecoder) At() float64 {
+	return d.v
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (d *FloatDecoder) Err() error {
+	return d.err
+}
+
+func withCount(count int, body []byte) []byte {
+	b := make([]byte, binary.MaxVarintLen64, binary.MaxVarintLen64+len(body))
+	n := binary.PutUvarint(b, uint64(count))
+	return append(b[:n], body...)
+}
+
+func splitCount(b []byte) (int, []byte, error) {
+	count, n := binary.Uvarint(b)
+	if n <= 0 || count > uint64(len(b)-n)*8 {
+		return 0, nil, ErrCorrupt
+	}
+	return int(count), b[n:], nil
+}
+
+type timeEncoder struct {
+	count int
+	t     int64
+	delta int64
+}
+
+func (e *timeEncoder) encode(w *bitWriter, t int64) {
+
+	if e.count == 0 {
+		w.writeBits(uint64(t), 64)
+		e.t = t
+		e.count++
+		return
+	}
+
+	delta := t - e.t
+	dod := delta - e.delta
+	e.t, e.delta = t, delta
+	e.count++
+
+	if dod == 0 {
+		w.writeBit(false)
+		return
+	}
+	for _, b := range dodBuckets {
+		if fitsSigned(dod, b.valueBits) {
+			w.writeBits(b.prefix, b.prefixBits)
+			w.writeBits(uint64(dod), b.valueBits)
+			return
+		}
+	}
+	w.writeBits(0xf, 4)
+	w.writeBits(uint64(dod), 64)
+}
+
+func fitsSigned(v int64, n int) bool {
+	return v >= -(1<<uint(n-1)) && v < 1<<uint(n-1)
+}
+
+type timeDecoder struct {
+	count int
+	t     int64
+	delta int64
+}
+
+func (d *timeDecoder) decode(r *bitReader) (int64, error) {
+
+	if d.count == 0 {
+		v, err := r.readBits(64)
+		if err != nil {
+			return 0, err
+		}
+		d.t = int64(v)
+		d.count++
+		return d.t, nil
+	}
+
+	// The control bits are up to four 1 bits terminated by a 0 bit.
+	var ones int
+	for ones < 4 {
+		bit, err := r.readBit()
+		if err != nil {
+			return 0, err
+		}
+		if !bit {
+			break
+		}
+		ones++
+	}
+
+	var dod int64
+	switch {
+	case ones == 0:
+	case ones < 4:
+		n := dodBuckets[ones-1].valueBits
+		v, err := r.readBits(n)
+		if err != nil {
+			return 0, err
+		}
+		dod = signExtend(v, n)
+	default:
+		v, err := r.readBits(64)
+		if err != nil {
+			return 0, err
+		}
+		dod = int64(v)
+	}
+
+	d.delta += dod
+	d.t += d.delta
+	d.count++
+
+	return d.t, nil
+}
+
+func signExtend(v uint64, n int) int64 {
+	shift := uint(64 - n)
+	return int64(v<<shift) >> shift
+}
+
+type floatEncoder struct {
+	count    int
+	v        uint64
+	window   bool
+	leading  int
+	trailing int
+}
+
+func (e *floatEncoder) encode(w *bitWriter, f float64) {
+
+	v := math.Float64bits(f)
+
+	if e.count == 0 {
+		w.writeBits(v, 64)
+		e.v = v
+		e.count++
+		return
+	}
+
+	xor := v ^ e.v
+	e.v = v
+	e.count++
+
+	if xor == 0 {
+		w.writeBit(false)
+		return
+	}
+	w.writeBit(true)
+
+	leading := bits.LeadingZeros64(xor)
+	trailing := bits.TrailingZeros64(xor)
+	// The leading zero count is stored in 5 bits.
+	if leading > 31 {
+		leading = 31
+	}
+
+	// Reuse the previous meaningful bit window when the xor fits in it.
+	if e.window && leading >= e.leading && trailing >= e.trailing {
+		w.writeBit(false)
+		w.writeBits(xor>>uint(e.trailing), 64-e.leading-e.trailing)
+		return
+	}
+
+	e.window, e.leading, e.trailing = true, leading, trailing
+	significant := 64 - leading - trailing
+
+	w.writeBit(true)
+	w.writeBits(uint64(leading), 5)
+	// A significant bit count of 64 does not fit in 6 bits and is stored as 0, a count of 0 is
+	// impossible since xor is not 0.
+	w.writeBits(uint64(significant&0x3f), 6)
+	w.writeBits(xor>>uint(trailing), significant)
+}
+
+type floatDecoder struct {
+	count    int
+	v        uint64
+	leading  int
+	trailing int
+}
+
+func (d *floatDecoder) decode(r *bitReader) (float64, error) {
+
+	if d.count == 0 {
+		v, err := r.readBits(64)
+		if err != nil {
+			return 0, err
+		}
+		d.v = v
+		d.count++
+		return math.Float64frombits(d.v), nil
+	}
+	d.count++
+
+	bit, err := r.readBit()
+	if err != nil {
+		return 0, err
+	}
+	if !bit {
+		return math.Float64frombits(d.v), nil
+	}
+
+	if bit, err = r.readBit(); err != nil {
+		return 0, err
+	}
+	if bit {
+		leading, err := r.readBits(5)
+		if err != nil {
+			return 0, err
+		}
+		significant, err := r.readBits(6)
+		if err != nil {
+			return 0, err
+		}
+		if significant == 0 {
+			significant = 64
+		}
+		d.leading = int(leading)
+		d.trailing = 64 - d.leading - int(significant)
+		if d.trailing < 0 {
+			return 0, ErrCorrupt
+		}
+	}
+
+	xor, err := r.readBits(64 - d.leading - d.trailing)
+	if err != nil {
+		return 0, err
+	}
+	d.v ^= xor << uint(d.trailing)
+
+	return math.Float64frombits(d.v), nil
+}
+
+// bitWriter appends bits to a byte slice, most significant bit first.
+type bitWriter struct {
+	buf []byte
+	// free is the number of unused low bits in the last byte of buf.
+	free int
+}
+
+func (w *bitWriter) writeBit(bit bool) {
+	if bit {
+		w.writeBits(1, 1)
+		return
+	}
+	w.writeBits(0, 1)
+}
+
+// writeBits writes the low n bits of v.
+func (w *bitWriter) writeBits(v uint64, n int) {
+	for n > 0 {
+		if w.free == 0 {
+			w.buf = append(w.buf, 0)
+			w.free = 8
+		}
+		take := n
+		if take > w.free {
+			take = w.free
+		}
+		chunk := (v >> uint(n-take)) & (1<<uint(take) - 1)
+		w.buf[len(w.buf)-1] |= byte(chunk << uint(w.free-take))
+		w.free -= take
+		n -= take
+	}
+}
+
+type bitReader struct {
+	buf []byte
+	// pos is the index of the next bit to read.
+	pos int
+}
+
+func (r *bitReader) readBit() (bool, error) {
+	v, err := r.readBits(1)
+	return v == 1, err
+}
+
+// readBits reads n bits into the low bits of the result.
+func (r *bitReader) readBits(n int) (uint64, error) {
+
+	if r.pos+n > len(r.buf)*8 {
+		return 0, ErrCorrupt
+	}
+
+	var v uint64
+	for n > 0 {
+		avail := 8 - r.pos%8
+		take := n
+		if take > avail {
+			take = avail
+		}
+		b := uint64(r.buf[r.pos/8]>>uint(avail-take)) & (1<<uint(take) - 1)
+		v = v<<uint(take) | b
+		r.pos += take
+		n -= take
+	}
+
+	return v, nil
+}
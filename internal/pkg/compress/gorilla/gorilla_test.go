@@ -0,0 +1,137 @@
+package gorilla
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// time1ms is a 1 ms sample interval in nanoseconds.
+const time1ms = 1000000
+
+func TestEncoderRoundTrip(t *testing.T) {
+
+	start := int64(1563145199999999999)
+
+	var times []int64
+	var values []float64
+
+	// A fixed 1 ms sample rate with a jittered stretch, a gap, a timestamp going backwards and
+	// values that repeat, drift and jump.
+	ts := start
+	for i := 0; i < 1000; i++ {
+		switch {
+		case i > 100 && i < 200:
+			ts += int64(time1ms + rand.Intn(10000) - 5000)
+		case i == 500:
+			ts += 3600 * 1e9
+		case i == 600:
+			ts -= 5 * time1ms
+		default:
+			ts += time1ms
+		}
+		times = append(times, ts)
+
+		v := 287.123456789 + float64(i/10)*0.25
+		switch {
+		case i == 300:
+			v = math.Inf(1)
+		case i == 301:
+			v = math.NaN()
+		case i == 302:
+			v = -0.0
+		case i > 700:
+			v = rand.NormFloat64() * 1e6
+		}
+		values = append(values, v)
+	}
+
+	e := NewEncoder()
+	for i := range times {
+		e.Append(times[i], values[i])
+	}
+	if e.Len() != len(times) {
+		t.Fatalf("got length %v, want %v", e.Len(), len(times))
+	}
+
+	d := NewDecoder(e.Bytes())
+	var i int
+	for d.Next() {
+		ts, v := d.At()
+		if ts != times[i] || math.Float64bits(v) != math.Float64bits(values[i]) {
+			t.Fatalf("sample %v: got (%v, %v), want (%v, %v)", i, ts, v, times[i], values[i])
+		}
+		i++
+	}
+	if d.Err() != nil {
+		t.Fatal(d.Err())
+	}
+	if i != len(times) {
+		t.Fatalf("got %v samples, want %v", i, len(times))
+	}
+}
+
+func TestEncoderCompression(t *testing.T) {
+
+	// A fixed rate series of a slowly changing value, the typical telemetry channel.
+	e := NewEncoder()
+	for i := 0; i < 10000; i++ {
+		e.Append(int64(i)*time1ms, 10000+float64(i/100))
+	}
+
+	// Uncompressed the samples are 16 bytes each.
+	if size := len(e.Bytes()); size > 10000*16/20 {
+		t.Errorf("got %v bytes for 10000 samples, want a compression ratio of at least 20", size)
+	}
+}
+
+func TestFloatEncoderRoundTrip(t *testing.T) {
+
+	values := []float64{49.327, 49.3270001, 49.3270001, 8.565, 0, -1.5e-300, math.MaxFloat64, 49.327}
+
+	e := NewFloatEncoder()
+	for _, v := range values {
+		e.Append(v)
+	}
+
+	d := NewFloatDecoder(e.Bytes())
+	var got []float64
+	for d.Next() {
+		got = append(got, d.At())
+	}
+	if d.Err() != nil {
+		t.Fatal(d.Err())
+	}
+	if len(got) != len(values) {
+		t.Fatalf("got %v values, want %v", len(got), len(values))
+	}
+	for i := range values {
+		if got[i] != values[i] {
+			t.Errorf("value %v: got %v, want %v", i, got[i], values[i])
+		}
+	}
+}
+
+func TestDecoderCorrupt(t *testing.T) {
+
+	e := NewEncoder()
+	for i := 0; i < 100; i++ {
+		e.Append(int64(i)*time1ms+int64(i%7), float64(i))
+	}
+	b := e.Bytes()
+
+	d := NewDecoder(b[:len(b)/2])
+	for d.Next() {
+	}
+	if d.Err() != ErrCorrupt {
+		t.Errorf("got %v, want ErrCorrupt", d.Err())
+	}
+
+	if d = NewDecoder(nil); d.Next() || d.Err() != ErrCorrupt {
+		t.Errorf("got %v, want ErrCorrupt for an empty input", d.Err())
+	}
+
+	if d = NewDecoder(NewEncoder().Bytes()); d.Next() || d.Err() != nil {
+		t.Errorf("got %v, want an empty series", d.Err())
+	}
+}
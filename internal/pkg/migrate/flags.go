@@ -0,0 +1,26 @@
+package migrate
+
+import (
+	"flag"
+)
+
+// Flags are the schema migration command line flags shared by the FOTAAS services.
+type Flags struct {
+	// Only applies the pending migrations and exits without starting the service.
+	Only bool
+	// DryRun lists the pending migrations and exits without applying them or starting the service.
+	DryRun bool
+}
+
+// RegisterFlags registers the -migrate-only and -migrate-dry-run flags with fs.
+func RegisterFlags(fs *flag.FlagSet) *Flags {
+	f := new(Flags)
+	fs.BoolVar(&f.Only, "migrate-only", false, "apply the pending schema migrations and exit")
+	fs.BoolVar(&f.DryRun, "migrate-dry-run", false, "list the pending schema migrations and exit")
+	return f
+}
+
+// Enabled reports whether the service should migrate (or list the pending migrations) and exit.
+func (f *Flags) Enabled() bool {
+	return f.Only || f.DryRun
+}
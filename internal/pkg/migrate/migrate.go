@@ -0,0 +1,140 @@
+// Package migrate applies the versioned schema migrations that are compiled into each FOTAAS
+// service. The migrations applied to a database are recorded in its schema_migrations table.
+package migrate
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// Migration is a single schema change. Its statements are executed in order, in a transaction,
+// and the migration is recorded as applied in the same transaction. Note that mysql implicitly
+// commits DDL statements, so a mysql migration should have a single DDL statement where possible.
+type Migration struct {
+	Version     int
+	Description string
+	Statements  []string
+}
+
+const createSchemaMigrations = `CREATE TABLE IF NOT EXISTS schema_migrations
+(
+  version INTEGER NOT NULL,
+  description VARCHAR(255) NOT NULL,
+  applied_at TIMESTAMP NOT NULL,
+  PRIMARY KEY (version)
+)`
+
+// validate checks that migrations are in ascending version order with no duplicate versions.
+func validate(migrations []Migration) error {
+	for i, m := range migrations {
+		if m.Version <= 0 {
+			return fmt.Errorf("invalid migration version %v, must be greater than 0", m.Version)
+		}
+		if i > 0 && m.Version <= migrations[i-1].Version {
+			return fmt.Errorf("migration version %v is out of order, it follows version %v", m.Version, migrations[i-1].Version)
+		}
+	}
+	return nil
+}
+
+// appliedVersions returns the versions recorded in the schema_migrations table, creating the table
+// if it does not exist.
+func appliedVersions(db *sql.DB) (map[int]bool, error) {
+
+	if _, err := db.Exec(createSchemaMigrations); err != nil {
+		return nil, fmt.Errorf("failed to create schema_migrations table with error: %v", err)
+	}
+
+	rows, err := db.Query("select version from schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := make(map[int]bool)
+	for rows.Next() {
+		var version int
+		if err = rows.Scan(&version); err != nil {
+			return nil, err
+		}
+		applied[version] = true
+	}
+
+	return applied, rows.Err()
+}
+
+// Pending returns the migrations that have not been applied to db, in the order they would be
+// applied. Pending creates the schema_migrations table if necessary but applies no migrations. It
+// is an error for db to have a migration applied that is not in migrations, that database was
+// migrated by a newer binary.
+func Pending(db *sql.DB, migrations []Migration) ([]Migration, error) {
+
+	if err := validate(migrations); err != nil {
+		return nil, err
+	}
+
+	applied, err := appliedVersions(db)
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[int]bool)
+	var pending []Migration
+	for _, m := range migrations {
+		known[m.Version] = true
+		if !applied[m.Version] {
+			pending = append(pending, m)
+		}
+	}
+
+	for version := range applied {
+		if !known[version] {
+			return nil, fmt.Errorf("database has unknown migration version %v applied, it was migrated by a newer release", version)
+		}
+	}
+
+	return pending, nil
+}
+
+// Apply applies the pending migrations to db in version order and returns the migrations applied.
+// Apply stops at the first migration that fails, the migrations applied before it stay applied.
+func Apply(db *sql.DB, migrations []Migration) ([]Migration, error) {
+
+	pending, err := Pending(db, migrations)
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []Migration
+	for _, m := range pending {
+		if err = apply(db, m); err != nil {
+			return applied, fmt.Errorf("migration %v (%v) failed with error: %v", m.Version, m.Description, err)
+		}
+		applied = append(applied, m)
+	}
+
+	return applied, nil
+}
+
+func apply(db *sql.DB, m Migration) error {
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, stmt := range m.Statements {
+		if _, err = tx.Exec(stmt); err != nil {
+			return err
+		}
+	}
+
+	if _, err = tx.Exec("INSERT INTO schema_migrations (version, description, applied_at) VALUES (?, ?, ?)",
+		m.Version, m.Description, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,93 @@
+package migrate
+
+import (
+	"database/sql"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+var testMigrations = []Migration{
+	{Version: 1, Description: "create widget", Statements: []string{
+		"CREATE TABLE widget (id INTEGER NOT NULL, PRIMARY KEY (id))"}},
+	{Version: 2, Description: "add widget name", Statements: []string{
+		"ALTER TABLE widget ADD COLUMN name TEXT NOT NULL DEFAULT ''"}},
+}
+
+func openTestDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Error("failed to open sqlite database with error: ", err)
+		t.FailNow()
+	}
+	db.SetMaxOpenConns(1)
+	return db
+}
+
+func TestApply(t *testing.T) {
+
+	db := openTestDB(t)
+	defer db.Close()
+
+	pending, err := Pending(db, testMigrations[:1])
+	if err != nil || len(pending) != 1 {
+		t.Errorf("expected 1 pending migration, got %v with error: %v", len(pending), err)
+	}
+
+	applied, err := Apply(db, testMigrations[:1])
+	if err != nil || len(applied) != 1 {
+		t.Errorf("expected 1 applied migration, got %v with error: %v", len(applied), err)
+	}
+
+	// A later release adds a migration, only that one is applied.
+	if pending, err = Pending(db, testMigrations); err != nil || len(pending) != 1 || pending[0].Version != 2 {
+		t.Errorf("expected migration 2 to be pending, got %v with error: %v", pending, err)
+	}
+	if applied, err = Apply(db, testMigrations); err != nil || len(applied) != 1 {
+		t.Errorf("expected 1 applied migration, got %v with error: %v", len(applied), err)
+	}
+	if _, err = db.Exec("INSERT INTO widget (id, name) VALUES (1, 'gear')"); err != nil {
+		t.Error("expected the migrated schema, insert failed with error: ", err)
+	}
+
+	if applied, err = Apply(db, testMigrations); err != nil || len(applied) != 0 {
+		t.Errorf("expected no migrations to be applied again, got %v with error: %v", len(applied), err)
+	}
+
+	// An older release does not know about migration 2.
+	if _, err = Pending(db, testMigrations[:1]); err == nil {
+		t.Error("expected an error for a database migrated by a newer release")
+	}
+}
+
+func TestApplyFailure(t *testing.T) {
+
+	db := openTestDB(t)
+	defer db.Close()
+
+	migrations := []Migration{testMigrations[0],
+		{Version: 2, Description: "broken", Statements: []string{"ALTER TABLE no_such_table ADD COLUMN name TEXT"}}}
+
+	applied, err := Apply(db, migrations)
+	if err == nil || len(applied) != 1 {
+		t.Errorf("expected migration 1 to be applied and migration 2 to fail, got %v with error: %v", len(applied), err)
+	}
+
+	pending, err := Pending(db, migrations)
+	if err != nil || len(pending) != 1 || pending[0].Version != 2 {
+		t.Errorf("expected the failed migration to remain pending, got %v with error: %v", pending, err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+
+	if err := validate([]Migration{testMigrations[1], testMigrations[0]}); err == nil {
+		t.Error("expected an error for out of order migrations")
+	}
+	if err := validate([]Migration{testMigrations[0], testMigrations[0]}); err == nil {
+		t.Error("expected an error for duplicate migration versions")
+	}
+	if err := validate([]Migration{{Version: 0}}); err == nil {
+		t.Error("expected an error for migration version 0")
+	}
+}